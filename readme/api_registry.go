@@ -1,6 +1,7 @@
 package readme
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -20,11 +21,18 @@ type APIRegistryService interface {
 	// to APISpecification.Create() with the UUID returned from this response.
 	Create(definition string, version ...string) (APIRegistrySaved, *APIResponse, error)
 
+	// CreateWithContext creates a new API registry on ReadMe using the provided context.
+	CreateWithContext(ctx context.Context, definition string, version ...string) (APIRegistrySaved, *APIResponse, error)
+
 	// Get retrieves an API definition from the ReadMe.com API registry with a provided UUID and
 	// returns it as a string.
 	//
 	// API Reference: https://docs.readme.com/main/reference/getapiregistry
 	Get(uuid string) (string, *APIResponse, error)
+
+	// GetWithContext retrieves an API definition from the ReadMe.com API registry using the provided
+	// context.
+	GetWithContext(ctx context.Context, uuid string) (string, *APIResponse, error)
 }
 
 // APIRegistryClient handles communication with the Registry related methods of the ReadMe.com API.
@@ -46,7 +54,15 @@ var _ APIRegistryService = &APIRegistryClient{}
 //
 // API Reference: https://docs.readme.com/main/reference/getapiregistry
 func (c APIRegistryClient) Get(uuid string) (string, *APIResponse, error) {
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	return c.GetWithContext(context.Background(), uuid)
+}
+
+// GetWithContext retrieves an API definition from the ReadMe.com API registry using the provided
+// context.
+func (c APIRegistryClient) GetWithContext(ctx context.Context, uuid string) (string, *APIResponse, error) {
+	ctx = withOperation(ctx, "APIRegistry", "Get")
+
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "GET",
 		Endpoint:     fmt.Sprintf("%s/%s", APIRegistryEndpoint, uuid),
 		UseAuth:      true,
@@ -72,13 +88,25 @@ func (c APIRegistryClient) Get(uuid string) (string, *APIResponse, error) {
 // A typical workflow will be to create the registry with this method and follow-up with a call to
 // APISpecification.Create() with the UUID returned from this response.
 func (c APIRegistryClient) Create(definition string, version ...string) (APIRegistrySaved, *APIResponse, error) {
+	return c.CreateWithContext(context.Background(), definition, version...)
+}
+
+// CreateWithContext creates a new API registry on ReadMe using the provided context.
+func (c APIRegistryClient) CreateWithContext(
+	ctx context.Context,
+	definition string,
+	version ...string,
+) (APIRegistrySaved, *APIResponse, error) {
+	ctx = withOperation(ctx, "APIRegistry", "Create")
+
 	var vers string
 	if len(version) > 0 {
 		vers = version[0]
 	}
 
 	response := APIRegistrySaved{}
-	_, apiResponse, err := c.client.APISpecification.UploadDefinition(
+	_, apiResponse, err := c.client.APISpecification.UploadDefinitionWithContext(
+		ctx,
 		"POST",
 		definition,
 		APIRegistryEndpoint,
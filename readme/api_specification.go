@@ -2,8 +2,10 @@ package readme
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
+	"iter"
 	"mime/multipart"
 	"strings"
 )
@@ -30,12 +32,22 @@ type APISpecificationService interface {
 	// API Reference: https://docs.readme.com/reference/uploadapispecification
 	Create(definition string, options ...RequestOptions) (APISpecificationSaved, *APIResponse, error)
 
+	// CreateWithContext creates a new API specification on ReadMe using the provided context.
+	CreateWithContext(
+		ctx context.Context,
+		definition string,
+		options ...RequestOptions,
+	) (APISpecificationSaved, *APIResponse, error)
+
 	// Delete an API Specification by ID.
 	// It returns true if it successfully deletes an API Specification.
 	//
 	// API Reference: https://docs.readme.com/reference/deleteapispecification
 	Delete(specID string) (bool, *APIResponse, error)
 
+	// DeleteWithContext deletes an API Specification by ID using the provided context.
+	DeleteWithContext(ctx context.Context, specID string) (bool, *APIResponse, error)
+
 	// Get a single API specification with a provided ID.
 	//
 	// Requesting a single API specification isn't included in the API. The client uses GetAll() to
@@ -46,11 +58,36 @@ type APISpecificationService interface {
 	// API Reference: https://docs.readme.com/reference/getapispecification
 	Get(specID string, options ...RequestOptions) (APISpecification, *APIResponse, error)
 
+	// GetWithContext retrieves a single API specification with a provided ID using the provided
+	// context.
+	GetWithContext(
+		ctx context.Context,
+		specID string,
+		options ...RequestOptions,
+	) (APISpecification, *APIResponse, error)
+
 	// GetAll retrieves and returns all API specifications on ReadMe.com.
 	//
 	// API Reference: https://docs.readme.com/reference/getapispecification
 	GetAll(...RequestOptions) ([]APISpecification, *APIResponse, error)
 
+	// GetAllWithContext retrieves and returns all API specifications on ReadMe.com using the provided
+	// context.
+	//
+	// The context is checked before each page is requested.
+	GetAllWithContext(ctx context.Context, options ...RequestOptions) ([]APISpecification, *APIResponse, error)
+
+	// All returns an iterator over all API specifications, requesting each page as the iteration progresses.
+	//
+	// No further pages are requested once the iteration stops. An error ends the iteration.
+	All(ctx context.Context, options ...RequestOptions) iter.Seq2[APISpecification, error]
+
+	// Pages returns an iterator over each page of API specifications, including the page metadata,
+	// requesting each page as the iteration progresses.
+	//
+	// No further pages are requested once the iteration stops. An error ends the iteration.
+	Pages(ctx context.Context, options ...RequestOptions) iter.Seq2[Page[APISpecification], error]
+
 	// Update an existing API specification on ReadMe by uploading a specification definition
 	// provided as a JSON string or by associating an existing definition in the API registry by
 	// providing a registry UUID as a parameter.
@@ -64,10 +101,21 @@ type APISpecificationService interface {
 	// API Reference: https://docs.readme.com/reference/updateapispecification
 	Update(specID, definition string) (APISpecificationSaved, *APIResponse, error)
 
+	// UpdateWithContext updates an existing API specification on ReadMe using the provided context.
+	UpdateWithContext(ctx context.Context, specID, definition string) (APISpecificationSaved, *APIResponse, error)
+
 	// UploadDefinition uploads an API specification definition by making a request that submits
 	// form data with the specification definition provided as a string.
 	// APISpecification.Create() should be used in most cases instead of calling this directly.
 	UploadDefinition(method, content, url, version string, response interface{}) (interface{}, *APIResponse, error)
+
+	// UploadDefinitionWithContext uploads an API specification definition as form data using the
+	// provided context.
+	UploadDefinitionWithContext(
+		ctx context.Context,
+		method, content, url, version string,
+		response interface{},
+	) (interface{}, *APIResponse, error)
 }
 
 // APISpecificationClient handles communication with the API specification related methods of the
@@ -101,9 +149,21 @@ type APISpecificationSaved struct {
 //
 // API Reference: https://docs.readme.com/reference/getapispecification
 func (c APISpecificationClient) GetAll(options ...RequestOptions) ([]APISpecification, *APIResponse, error) {
-	var results []APISpecification
+	return c.GetAllWithContext(context.Background(), options...)
+}
+
+// GetAllWithContext retrieves and returns all API specifications on ReadMe.com using the provided
+// context.
+//
+// The context is checked before each page is requested.
+func (c APISpecificationClient) GetAllWithContext(
+	ctx context.Context,
+	options ...RequestOptions,
+) ([]APISpecification, *APIResponse, error) {
+	ctx = withOperation(ctx, "APISpecification", "GetAll")
+
 	opts := parseRequestOptions(options)
-	apiResponse, err := c.client.fetchAllPages(APISpecificationEndpoint, opts, &results)
+	results, apiResponse, err := fetchAllPages[APISpecification](ctx, c.client, APISpecificationEndpoint, opts)
 	if err != nil {
 		return results, apiResponse, fmt.Errorf("unable to retrieve specifications: %w", err)
 	}
@@ -115,6 +175,35 @@ func (c APISpecificationClient) GetAll(options ...RequestOptions) ([]APISpecific
 	return results, apiResponse, nil
 }
 
+// All returns an iterator over all API specifications, requesting each page as the iteration progresses.
+//
+// No further pages are requested once the iteration stops. An error ends the iteration.
+func (c APISpecificationClient) All(
+	ctx context.Context,
+	options ...RequestOptions,
+) iter.Seq2[APISpecification, error] {
+	ctx = withOperation(ctx, "APISpecification", "All")
+
+	opts := parseRequestOptions(options)
+
+	return fetchItems[APISpecification](ctx, c.client, APISpecificationEndpoint, opts)
+}
+
+// Pages returns an iterator over each page of API specifications, including the page metadata,
+// requesting each page as the iteration progresses.
+//
+// No further pages are requested once the iteration stops. An error ends the iteration.
+func (c APISpecificationClient) Pages(
+	ctx context.Context,
+	options ...RequestOptions,
+) iter.Seq2[Page[APISpecification], error] {
+	ctx = withOperation(ctx, "APISpecification", "Pages")
+
+	opts := parseRequestOptions(options)
+
+	return fetchPages[APISpecification](ctx, c.client, APISpecificationEndpoint, opts)
+}
+
 // Get a single API specification with a provided ID.
 //
 // Requesting a single API specification isn't included in the API. The client uses GetAll() to
@@ -124,9 +213,21 @@ func (c APISpecificationClient) GetAll(options ...RequestOptions) ([]APISpecific
 //
 // See https://docs.readme.com/reference/getapispecification
 func (c APISpecificationClient) Get(specID string, options ...RequestOptions) (APISpecification, *APIResponse, error) {
-	specifications, apiResponse, err := c.GetAll(options...)
+	return c.GetWithContext(context.Background(), specID, options...)
+}
+
+// GetWithContext retrieves a single API specification with a provided ID using the provided
+// context.
+func (c APISpecificationClient) GetWithContext(
+	ctx context.Context,
+	specID string,
+	options ...RequestOptions,
+) (APISpecification, *APIResponse, error) {
+	ctx = withOperation(ctx, "APISpecification", "Get")
+
+	specifications, apiResponse, err := c.GetAllWithContext(ctx, options...)
 	if err != nil {
-		return APISpecification{}, apiResponse, fmt.Errorf("unable to retrieve API specifications")
+		return APISpecification{}, apiResponse, fmt.Errorf("unable to retrieve API specifications: %w", err)
 	}
 
 	for _, specification := range specifications {
@@ -135,7 +236,7 @@ func (c APISpecificationClient) Get(specID string, options ...RequestOptions) (A
 		}
 	}
 
-	return APISpecification{}, apiResponse, fmt.Errorf("API specification not found")
+	return APISpecification{}, apiResponse, fmt.Errorf("API specification %w", ErrNotFound)
 }
 
 // Create a new API specification on ReadMe by uploading a specification definition provided as a
@@ -153,13 +254,24 @@ func (c APISpecificationClient) Create(
 	definition string,
 	options ...RequestOptions,
 ) (APISpecificationSaved, *APIResponse, error) {
+	return c.CreateWithContext(context.Background(), definition, options...)
+}
+
+// CreateWithContext creates a new API specification on ReadMe using the provided context.
+func (c APISpecificationClient) CreateWithContext(
+	ctx context.Context,
+	definition string,
+	options ...RequestOptions,
+) (APISpecificationSaved, *APIResponse, error) {
+	ctx = withOperation(ctx, "APISpecification", "Create")
+
 	version := ""
 
 	if len(options) > 0 {
 		version = options[0].Version
 	}
 
-	created, apiResponse, err := c.createOrUpdateSpec("POST", definition, version)
+	created, apiResponse, err := c.createOrUpdateSpec(ctx, "POST", definition, version)
 	if err != nil {
 		return APISpecificationSaved{}, apiResponse, err
 	}
@@ -181,7 +293,17 @@ func (c APISpecificationClient) Create(
 func (c APISpecificationClient) Update(
 	specID, definition string,
 ) (APISpecificationSaved, *APIResponse, error) {
-	updated, apiResponse, err := c.createOrUpdateSpec("PUT", definition, "", specID)
+	return c.UpdateWithContext(context.Background(), specID, definition)
+}
+
+// UpdateWithContext updates an existing API specification on ReadMe using the provided context.
+func (c APISpecificationClient) UpdateWithContext(
+	ctx context.Context,
+	specID, definition string,
+) (APISpecificationSaved, *APIResponse, error) {
+	ctx = withOperation(ctx, "APISpecification", "Update")
+
+	updated, apiResponse, err := c.createOrUpdateSpec(ctx, "PUT", definition, "", specID)
 	if err != nil {
 		return APISpecificationSaved{}, apiResponse, err
 	}
@@ -194,7 +316,14 @@ func (c APISpecificationClient) Update(
 //
 // API Reference: https://docs.readme.com/reference/deleteapispecification
 func (c APISpecificationClient) Delete(specID string) (bool, *APIResponse, error) {
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	return c.DeleteWithContext(context.Background(), specID)
+}
+
+// DeleteWithContext deletes an API Specification by ID using the provided context.
+func (c APISpecificationClient) DeleteWithContext(ctx context.Context, specID string) (bool, *APIResponse, error) {
+	ctx = withOperation(ctx, "APISpecification", "Delete")
+
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "DELETE",
 		Endpoint:     fmt.Sprintf("%s/%s", APISpecificationEndpoint, specID),
 		UseAuth:      true,
@@ -214,6 +343,7 @@ func (c APISpecificationClient) Delete(specID string) (bool, *APIResponse, error
 //
 // The `specID` parameter is required if updating.
 func (c APISpecificationClient) createOrUpdateSpec(
+	ctx context.Context,
 	method, definition, version string,
 	specID ...string,
 ) (*APISpecificationSaved, *APIResponse, error) {
@@ -231,9 +361,9 @@ func (c APISpecificationClient) createOrUpdateSpec(
 
 	isUUID, uuid := ParseUUID(definition)
 	if isUUID {
-		_, apiResponse, err = c.createOrUpdateWithUUID(method, url, uuid, version, response)
+		_, apiResponse, err = c.createOrUpdateWithUUID(ctx, method, url, uuid, version, response)
 	} else {
-		_, apiResponse, err = c.UploadDefinition(method, definition, url, version, response)
+		_, apiResponse, err = c.uploadDefinition(ctx, method, definition, url, version, response)
 	}
 
 	return response, apiResponse, err
@@ -246,6 +376,28 @@ func (c APISpecificationClient) createOrUpdateSpec(
 func (c APISpecificationClient) UploadDefinition(
 	method, definition, url, version string,
 	response interface{},
+) (interface{}, *APIResponse, error) {
+	return c.UploadDefinitionWithContext(context.Background(), method, definition, url, version, response)
+}
+
+// UploadDefinitionWithContext uploads an API specification definition as form data using the
+// provided context.
+func (c APISpecificationClient) UploadDefinitionWithContext(
+	ctx context.Context,
+	method, definition, url, version string,
+	response interface{},
+) (interface{}, *APIResponse, error) {
+	ctx = withOperation(ctx, "APISpecification", "UploadDefinition")
+
+	return c.uploadDefinition(ctx, method, definition, url, version, response)
+}
+
+// uploadDefinition uploads an API specification definition as form data, recording the request as
+// the operation set in the context, such as "Create".
+func (c APISpecificationClient) uploadDefinition(
+	ctx context.Context,
+	method, definition, url, version string,
+	response interface{},
 ) (interface{}, *APIResponse, error) {
 	data := strings.NewReader(definition)
 
@@ -266,7 +418,7 @@ func (c APISpecificationClient) UploadDefinition(
 		return nil, nil, fmt.Errorf("unable to close writer: %w", err)
 	}
 
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:         method,
 		Endpoint:       url,
 		UseAuth:        true,
@@ -287,6 +439,7 @@ func (c APISpecificationClient) UploadDefinition(
 // NOTE: creating an API specification definition using this method is an *undocumented* feature of
 // the API.
 func (c APISpecificationClient) createOrUpdateWithUUID(
+	ctx context.Context,
 	method, url, uuid, version string,
 	response interface{},
 ) (interface{}, *APIResponse, error) {
@@ -300,7 +453,7 @@ func (c APISpecificationClient) createOrUpdateWithUUID(
 		Response:       &response,
 		RequestOptions: RequestOptions{Version: version},
 	}
-	apiResponse, err := c.client.APIRequest(apiRequest)
+	apiResponse, err := c.client.APIRequestWithContext(ctx, apiRequest)
 
 	return response, apiResponse, err
 }
@@ -193,7 +193,7 @@ func Test_APISpecifications_GetAll_Paginated(t *testing.T) {
 			JSON(testdata.APISpecifications)
 		defer gock.Off()
 
-		expect := "unable to parse link header - invalid format"
+		expect := "unable to parse link header 'invalid'"
 
 		// Act
 		_, _, err := TestClient.APISpecification.GetAll()
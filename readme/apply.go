@@ -1,6 +1,7 @@
 package readme
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -18,10 +19,16 @@ type ApplyService interface {
 	// API Reference: https://docs.readme.com/main/reference/applytoreadme
 	Apply(application Application) (ApplyResponse, *APIResponse, error)
 
+	// ApplyWithContext applies for an open role at ReadMe using the provided context.
+	ApplyWithContext(ctx context.Context, application Application) (ApplyResponse, *APIResponse, error)
+
 	// Get a list of open roles at ReadMe.
 	//
 	// API Reference: https://docs.readme.com/main/reference/getopenroles
 	Get() ([]OpenRole, *APIResponse, error)
+
+	// GetWithContext retrieves a list of open roles at ReadMe using the provided context.
+	GetWithContext(ctx context.Context) ([]OpenRole, *APIResponse, error)
 }
 
 // ApplyClient handles communication with the Apply related methods of the ReadMe.com API.
@@ -81,8 +88,15 @@ type ApplyResponse struct {
 //
 // API Reference: https://docs.readme.com/main/reference/getopenroles
 func (c ApplyClient) Get() ([]OpenRole, *APIResponse, error) {
+	return c.GetWithContext(context.Background())
+}
+
+// GetWithContext retrieves a list of open roles at ReadMe using the provided context.
+func (c ApplyClient) GetWithContext(ctx context.Context) ([]OpenRole, *APIResponse, error) {
+	ctx = withOperation(ctx, "Apply", "Get")
+
 	response := []OpenRole{}
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "GET",
 		Endpoint:     ApplyEndpoint,
 		UseAuth:      true,
@@ -97,13 +111,23 @@ func (c ApplyClient) Get() ([]OpenRole, *APIResponse, error) {
 //
 // API Reference: https://docs.readme.com/main/reference/applytoreadme
 func (c ApplyClient) Apply(application Application) (ApplyResponse, *APIResponse, error) {
+	return c.ApplyWithContext(context.Background(), application)
+}
+
+// ApplyWithContext applies for an open role at ReadMe using the provided context.
+func (c ApplyClient) ApplyWithContext(
+	ctx context.Context,
+	application Application,
+) (ApplyResponse, *APIResponse, error) {
+	ctx = withOperation(ctx, "Apply", "Apply")
+
 	payload, err := json.Marshal(application)
 	if err != nil {
 		return ApplyResponse{}, &APIResponse{}, fmt.Errorf("unable to parse application: %w", err)
 	}
 
 	response := ApplyResponse{}
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "POST",
 		Endpoint:     ApplyEndpoint,
 		UseAuth:      true,
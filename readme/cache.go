@@ -0,0 +1,369 @@
+package readme
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheConfig configures caching of API responses.
+//
+// Responses to GET requests with a 200 status code are cached, keyed by the request URL, the API
+// key and the x-readme-version header. A cached response is returned without making a request
+// until its TTL expires. After that, the request is sent with the If-None-Match and
+// If-Modified-Since headers when the cached response has an ETag or Last-Modified header, and the
+// cached response is returned again when the API responds with a 304 status code.
+//
+// A successful POST, PUT or DELETE request removes the cached responses of the resource it
+// changes, such as every cached "/categories" response after a category is updated. The cached
+// responses of related resources are removed too: a change to a doc removes the "/categories"
+// responses, which list the docs of each category, a change to a category removes the "/docs"
+// responses and an API specification upload removes both. Searching for docs doesn't remove any
+// cached response.
+type CacheConfig struct {
+	// Store holds the cached responses.
+	Store CacheStore
+	// TTL is how long a cached response is returned without contacting the API. A zero TTL
+	// revalidates every cached response with the API.
+	TTL time.Duration
+}
+
+// CacheStore stores the API responses cached by a Client.
+//
+// A store may be shared between clients. Implementations must be safe for concurrent use.
+type CacheStore interface {
+	// Get returns the cached response for a key, if any.
+	Get(key string) (*CachedResponse, bool)
+
+	// Set stores the response for a key.
+	Set(key string, response *CachedResponse)
+
+	// DeletePrefix removes the cached responses with keys that start with the prefix.
+	DeletePrefix(prefix string)
+}
+
+// CachedResponse is an API response stored in a CacheStore.
+type CachedResponse struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"statusCode"`
+	// Header lists the HTTP headers of the response.
+	Header http.Header `json:"header"`
+	// Body is the response body.
+	Body []byte `json:"body"`
+	// StoredAt is the time the response was received or last revalidated.
+	StoredAt time.Time `json:"storedAt"`
+}
+
+// apiResponse returns an APIResponse for a cached response.
+func (r *CachedResponse) apiResponse(request *APIRequest, httpRequest *http.Request) *APIResponse {
+	// The body is copied so a caller that changes it doesn't change the cached response.
+	body := bytes.Clone(r.Body)
+
+	return &APIResponse{
+		Body: body,
+		HTTPResponse: &http.Response{
+			Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+			StatusCode:    r.StatusCode,
+			Header:        r.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       httpRequest,
+		},
+		Request: request,
+	}
+}
+
+// relatedResources lists the resources whose cached responses are removed along with those of a
+// changed resource, keyed by the changed resource.
+var relatedResources = map[string][]string{
+	// Uploading an API specification creates or renames its reference category and its docs.
+	"api-specification": {"categories", "docs"},
+	// Deleting a category deletes its docs.
+	"categories": {"docs"},
+	// The docs of a category are listed by "/categories/{slug}/docs".
+	"docs": {"categories"},
+}
+
+// readOnlyEndpoints lists the endpoints requested with POST that don't change a resource.
+var readOnlyEndpoints = []string{
+	strings.TrimPrefix(DocEndpoint, "/") + "/search",
+}
+
+// cache is the Handler middleware that returns cached responses and invalidates them after
+// changes.
+func (c *Client) cache(next Handler) Handler {
+	return func(ctx context.Context, request *APIRequest, httpRequest *http.Request) (*APIResponse, error) {
+		resource := c.resource(httpRequest.URL.Path)
+		prefix := c.cachePrefix(httpRequest, resource)
+
+		if httpRequest.Method != http.MethodGet {
+			response, err := next(ctx, request, httpRequest)
+			if err == nil && response.HTTPResponse.StatusCode < http.StatusBadRequest &&
+				!slices.Contains(readOnlyEndpoints, c.endpointPath(httpRequest.URL.Path)) {
+				c.CacheConfig.Store.DeletePrefix(prefix)
+				for _, related := range relatedResources[resource] {
+					c.CacheConfig.Store.DeletePrefix(c.cachePrefix(httpRequest, related))
+				}
+			}
+
+			return response, err
+		}
+
+		key := prefix + httpRequest.Header.Get("x-readme-version") + " " + httpRequest.URL.String()
+		cached, ok := c.CacheConfig.Store.Get(key)
+		if ok && time.Since(cached.StoredAt) < c.CacheConfig.TTL {
+			return cached.apiResponse(request, httpRequest), nil
+		}
+
+		if ok {
+			if etag := cached.Header.Get("ETag"); etag != "" {
+				httpRequest.Header.Set("If-None-Match", etag)
+			}
+			if lastModified := cached.Header.Get("Last-Modified"); lastModified != "" {
+				httpRequest.Header.Set("If-Modified-Since", lastModified)
+			}
+		}
+
+		response, err := next(ctx, request, httpRequest)
+		if err != nil {
+			return response, err
+		}
+
+		switch {
+		case ok && response.HTTPResponse.StatusCode == http.StatusNotModified:
+			revalidated := *cached
+			revalidated.StoredAt = time.Now()
+			c.CacheConfig.Store.Set(key, &revalidated)
+
+			return revalidated.apiResponse(request, httpRequest), nil
+		case response.HTTPResponse.StatusCode == http.StatusOK:
+			c.CacheConfig.Store.Set(key, &CachedResponse{
+				StatusCode: response.HTTPResponse.StatusCode,
+				Header:     response.HTTPResponse.Header.Clone(),
+				Body:       bytes.Clone(response.Body),
+				StoredAt:   time.Now(),
+			})
+		}
+
+		return response, nil
+	}
+}
+
+// cachePrefix returns the prefix of the cache keys for a resource requested with a request's
+// credentials, which is made of a hash of the credentials and the first segment of the endpoint,
+// such as "categories".
+func (c *Client) cachePrefix(httpRequest *http.Request, resource string) string {
+	credentials := sha256.Sum256([]byte(httpRequest.Header.Get("Authorization")))
+
+	return hex.EncodeToString(credentials[:8]) + " " + resource + " "
+}
+
+// MemoryCache is a CacheStore that holds responses in memory, removing the least recently used
+// responses when it's full.
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	order      *list.List
+}
+
+// memoryCacheEntry is an element of the MemoryCache's list of entries.
+type memoryCacheEntry struct {
+	key      string
+	response *CachedResponse
+}
+
+// Ensure the implementations satisfy the expected interfaces.
+var (
+	_ CacheStore = &MemoryCache{}
+	_ CacheStore = &FileCache{}
+)
+
+// NewMemoryCache returns a MemoryCache that holds up to `maxEntries` responses. The number of
+// responses isn't limited when `maxEntries` is 0.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		order:      list.New(),
+	}
+}
+
+// Get returns the cached response for a key and marks it as the most recently used.
+func (m *MemoryCache) Get(key string) (*CachedResponse, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	element, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	m.order.MoveToFront(element)
+
+	return element.Value.(*memoryCacheEntry).response, true
+}
+
+// Set stores the response for a key, removing the least recently used response if the cache is
+// full.
+func (m *MemoryCache) Set(key string, response *CachedResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if element, ok := m.entries[key]; ok {
+		element.Value.(*memoryCacheEntry).response = response
+		m.order.MoveToFront(element)
+
+		return
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryCacheEntry{key: key, response: response})
+
+	if m.maxEntries > 0 && m.order.Len() > m.maxEntries {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// DeletePrefix removes the cached responses with keys that start with the prefix.
+func (m *MemoryCache) DeletePrefix(prefix string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key, element := range m.entries {
+		if strings.HasPrefix(key, prefix) {
+			m.order.Remove(element)
+			delete(m.entries, key)
+		}
+	}
+}
+
+// Len returns the number of cached responses.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.order.Len()
+}
+
+// FileCache is a CacheStore that holds responses in files in a directory, so they can be reused
+// between runs.
+//
+// Each response is stored in a JSON file named after a hash of its key. Responses that can't be read
+// or written are treated as not cached.
+type FileCache struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// fileCacheEntry is the content of a FileCache file.
+type fileCacheEntry struct {
+	Key      string          `json:"key"`
+	Response *CachedResponse `json:"response"`
+}
+
+// NewFileCache returns a FileCache that stores responses in the directory, creating it if it
+// doesn't exist.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("unable to create cache directory: %w", err)
+	}
+
+	return &FileCache{dir: dir}, nil
+}
+
+// Get returns the cached response for a key.
+func (f *FileCache) Get(key string) (*CachedResponse, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	entry, err := f.read(f.path(key))
+	if err != nil || entry.Key != key {
+		return nil, false
+	}
+
+	return entry.Response, true
+}
+
+// Set stores the response for a key.
+func (f *FileCache) Set(key string, response *CachedResponse) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	data, err := json.Marshal(fileCacheEntry{Key: key, Response: response})
+	if err != nil {
+		return
+	}
+
+	// Write to a temporary file first so a partially written file is never read.
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	err = errors.Join(err, tmp.Close())
+	if err == nil {
+		err = os.Rename(tmp.Name(), f.path(key))
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
+
+// DeletePrefix removes the cached responses with keys that start with the prefix.
+func (f *FileCache) DeletePrefix(prefix string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	paths, err := filepath.Glob(filepath.Join(f.dir, "*.json"))
+	if err != nil {
+		return
+	}
+
+	for _, path := range paths {
+		entry, err := f.read(path)
+		if err != nil || strings.HasPrefix(entry.Key, prefix) {
+			_ = os.Remove(path)
+		}
+	}
+}
+
+// path returns the path of the file for a key.
+func (f *FileCache) path(key string) string {
+	hash := sha256.Sum256([]byte(key))
+
+	return filepath.Join(f.dir, hex.EncodeToString(hash[:])+".json")
+}
+
+// read reads a cache file.
+func (f *FileCache) read(path string) (fileCacheEntry, error) {
+	var entry fileCacheEntry
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return entry, fmt.Errorf("unable to read cache file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return entry, fmt.Errorf("unable to parse cache file: %w", err)
+	}
+
+	if entry.Response == nil {
+		return entry, errors.New("cache file has no response")
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,291 @@
+package readme_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+// newCacheServer returns a test server that responds with categories, or an empty list of docs for
+// the docs of a category, with an ETag and records the requests it receives.
+func newCacheServer(t *testing.T) (*httptest.Server, *[]*http.Request) {
+	t.Helper()
+
+	var mu sync.Mutex
+	requests := []*http.Request{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r)
+		mu.Unlock()
+
+		if r.Method == http.MethodGet && r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		w.Header().Set("ETag", `"v1"`)
+		if strings.HasPrefix(r.URL.Path, readme.CategoryEndpoint+"/") && strings.HasSuffix(r.URL.Path, "/docs") {
+			_, _ = w.Write([]byte("[]"))
+
+			return
+		}
+		if r.URL.Path == readme.CategoryEndpoint {
+			w.Header().Set("link", `<>; rel="next", <>; rel="prev", <>; rel="last"`)
+			_, _ = w.Write([]byte(testdata.ToJSON(testdata.Categories)))
+
+			return
+		}
+		_, _ = w.Write([]byte(testdata.ToJSON(testdata.Categories[0])))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func Test_Client_Cache(t *testing.T) {
+	t.Run("when a response is cached", func(t *testing.T) {
+		// Arrange
+		server, requests := newCacheServer(t)
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL),
+			readme.WithCache(readme.NewMemoryCache(10), time.Minute))
+
+		// Act
+		first, _, firstErr := client.Category.Get("test")
+		second, apiResponse, secondErr := client.Category.Get("test")
+
+		// Assert
+		assert.NoError(t, firstErr, "it does not return an error")
+		assert.NoError(t, secondErr, "it does not return an error")
+		assert.Len(t, *requests, 1, "it returns the cached response without making a request")
+		assert.Equal(t, first, second, "it returns the cached category")
+		assert.Equal(t, 200, apiResponse.HTTPResponse.StatusCode, "it returns the cached status code")
+	})
+
+	t.Run("when the TTL has expired", func(t *testing.T) {
+		// Arrange
+		server, requests := newCacheServer(t)
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL),
+			readme.WithCache(readme.NewMemoryCache(10), 0))
+
+		// Act
+		_, _, _ = client.Category.Get("test")
+		got, apiResponse, err := client.Category.Get("test")
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Len(t, *requests, 2, "it revalidates the cached response")
+		assert.Equal(t, `"v1"`, (*requests)[1].Header.Get("If-None-Match"), "it sends a conditional request")
+		assert.Equal(t, testdata.Categories[0], got, "it returns the cached category when it's not modified")
+		assert.Equal(t, 200, apiResponse.HTTPResponse.StatusCode, "it returns the cached status code")
+	})
+
+	t.Run("when the resource is changed", func(t *testing.T) {
+		// Arrange
+		server, requests := newCacheServer(t)
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL),
+			readme.WithCache(readme.NewMemoryCache(10), time.Minute))
+
+		// Act
+		_, _, _ = client.Category.GetAll()
+		_, _, _ = client.Category.Get("test")
+		_, _, updateErr := client.Category.Update("test", readme.CategoryParams{Title: "Test", Type: "guide"})
+		_, _, _ = client.Category.GetAll()
+		_, _, _ = client.Category.Get("test")
+
+		// Assert
+		assert.NoError(t, updateErr, "it does not return an error")
+		assert.Len(t, *requests, 5, "it removes the cached responses of the resource")
+		assert.Empty(t, (*requests)[4].Header.Get("If-None-Match"), "it doesn't revalidate a removed response")
+	})
+
+	t.Run("when a doc is changed", func(t *testing.T) {
+		// Arrange
+		server, requests := newCacheServer(t)
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL),
+			readme.WithCache(readme.NewMemoryCache(10), time.Minute))
+
+		// Act
+		_, _, _ = client.Category.GetDocs("test")
+		_, _, updateErr := client.Doc.Update("test", readme.DocParams{Title: "Test", Category: "test"})
+		_, _, _ = client.Category.GetDocs("test")
+
+		// Assert
+		assert.NoError(t, updateErr, "it does not return an error")
+		assert.Len(t, *requests, 3, "it removes the cached docs of the categories")
+		assert.Empty(t, (*requests)[2].Header.Get("If-None-Match"), "it doesn't revalidate a removed response")
+	})
+
+	t.Run("when an API specification is uploaded", func(t *testing.T) {
+		// Arrange
+		server, requests := newCacheServer(t)
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL),
+			readme.WithCache(readme.NewMemoryCache(10), time.Minute))
+
+		// Act
+		_, _, _ = client.Category.GetAll()
+		_, _, _ = client.Doc.Get("test")
+		_, _, _ = client.APISpecification.Create(`{"openapi": "3.0.0"}`)
+		_, _, _ = client.Category.GetAll()
+		_, _, _ = client.Doc.Get("test")
+
+		// Assert
+		assert.Len(t, *requests, 5, "it removes the cached categories and docs")
+	})
+
+	t.Run("when a category is deleted", func(t *testing.T) {
+		// Arrange
+		server, requests := newCacheServer(t)
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL),
+			readme.WithCache(readme.NewMemoryCache(10), time.Minute))
+
+		// Act
+		_, _, _ = client.Doc.Get("test")
+		_, _, _ = client.Category.Delete("test")
+		_, _, _ = client.Doc.Get("test")
+
+		// Assert
+		assert.Len(t, *requests, 3, "it removes the cached docs")
+	})
+
+	t.Run("when a cached body is changed", func(t *testing.T) {
+		// Arrange
+		server, _ := newCacheServer(t)
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL),
+			readme.WithCache(readme.NewMemoryCache(10), time.Minute))
+		_, first, _ := client.Category.Get("test")
+		clear(first.Body)
+
+		// Act
+		got, second, err := client.Category.Get("test")
+		clear(second.Body)
+		third, _, thirdErr := client.Category.Get("test")
+
+		// Assert
+		assert.NoError(t, errors.Join(err, thirdErr), "it does not return an error")
+		assert.Equal(t, testdata.Categories[0], got, "it stores a copy of the body")
+		assert.Equal(t, testdata.Categories[0], third, "it returns a copy of the cached body")
+	})
+
+	t.Run("when docs are searched", func(t *testing.T) {
+		// Arrange
+		server, requests := newCacheServer(t)
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL),
+			readme.WithCache(readme.NewMemoryCache(10), time.Minute))
+
+		// Act
+		_, _, _ = client.Doc.Get("test")
+		_, _, searchErr := client.Doc.Search("test")
+		_, _, _ = client.Doc.Get("test")
+
+		// Assert
+		assert.NoError(t, searchErr, "it does not return an error")
+		assert.Len(t, *requests, 2, "it keeps the cached docs")
+	})
+
+	t.Run("when requests use different versions and API keys", func(t *testing.T) {
+		// Arrange
+		server, requests := newCacheServer(t)
+		cache := readme.NewMemoryCache(10)
+		first, _ := readme.NewClient("first", readme.WithAPIURL(server.URL), readme.WithCache(cache, time.Minute))
+		second, _ := readme.NewClient("second", readme.WithAPIURL(server.URL), readme.WithCache(cache, time.Minute))
+
+		// Act
+		_, _, _ = first.Category.Get("test", readme.RequestOptions{Version: "1.0"})
+		_, _, _ = first.Category.Get("test", readme.RequestOptions{Version: "2.0"})
+		_, _, _ = second.Category.Get("test", readme.RequestOptions{Version: "1.0"})
+		_, _, _ = first.Category.Get("test", readme.RequestOptions{Version: "1.0"})
+
+		// Assert
+		assert.Len(t, *requests, 3, "it caches responses for each version and API key")
+		assert.Equal(t, 3, cache.Len(), "it stores a response for each version and API key")
+	})
+
+	t.Run("when the store is nil", func(t *testing.T) {
+		// Act
+		_, err := readme.NewClient("test", readme.WithCache(nil, time.Minute))
+
+		// Assert
+		assert.ErrorContains(t, err, "cache store must not be nil", "it returns an error")
+	})
+}
+
+func Test_MemoryCache(t *testing.T) {
+	// Arrange
+	cache := readme.NewMemoryCache(2)
+	response := &readme.CachedResponse{StatusCode: 200}
+
+	// Act
+	cache.Set("a", response)
+	cache.Set("b", response)
+	_, _ = cache.Get("a")
+	cache.Set("c", response)
+
+	// Assert
+	_, hasA := cache.Get("a")
+	_, hasB := cache.Get("b")
+	_, hasC := cache.Get("c")
+	assert.True(t, hasA, "it keeps the recently used response")
+	assert.False(t, hasB, "it removes the least recently used response")
+	assert.True(t, hasC, "it stores the new response")
+
+	cache.DeletePrefix("a")
+	assert.Equal(t, 1, cache.Len(), "it removes the responses with the prefix")
+}
+
+func Test_FileCache(t *testing.T) {
+	t.Run("when responses are stored", func(t *testing.T) {
+		// Arrange
+		dir := filepath.Join(t.TempDir(), "cache")
+		cache, err := readme.NewFileCache(dir)
+		assert.NoError(t, err, "it does not return an error")
+		response := &readme.CachedResponse{
+			StatusCode: 200,
+			Header:     http.Header{"Etag": []string{`"v1"`}},
+			Body:       []byte(`{"title":"Test"}`),
+			StoredAt:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		}
+
+		// Act
+		cache.Set("docs a", response)
+		cache.Set("docs b", response)
+		cache.Set("categories a", response)
+		reopened, _ := readme.NewFileCache(dir)
+
+		// Assert
+		got, ok := reopened.Get("docs a")
+		assert.True(t, ok, "it returns the stored response")
+		assert.Equal(t, response, got, "it returns the stored response")
+
+		reopened.DeletePrefix("docs ")
+		_, hasDoc := reopened.Get("docs b")
+		_, hasCategory := reopened.Get("categories a")
+		assert.False(t, hasDoc, "it removes the responses with the prefix")
+		assert.True(t, hasCategory, "it keeps other responses")
+	})
+
+	t.Run("when a file is corrupted", func(t *testing.T) {
+		// Arrange
+		dir := t.TempDir()
+		cache, _ := readme.NewFileCache(dir)
+		cache.Set("docs a", &readme.CachedResponse{StatusCode: 200})
+		paths, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+		_ = os.WriteFile(paths[0], []byte("invalid"), 0o600)
+
+		// Act
+		_, ok := cache.Get("docs a")
+
+		// Assert
+		assert.False(t, ok, "it treats the response as not cached")
+	})
+}
@@ -1,9 +1,11 @@
 package readme
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
 )
 
 // CategoryEndpoint is the ReadMe API endpoint for categories.
@@ -33,11 +35,22 @@ type CategoryService interface {
 	// API Reference: https://docs.readme.com/main/reference/createcategory
 	Create(response any, params CategoryParams, options ...RequestOptions) (*APIResponse, error)
 
+	// CreateWithContext creates a new category in ReadMe using the provided context.
+	CreateWithContext(
+		ctx context.Context,
+		response any,
+		params CategoryParams,
+		options ...RequestOptions,
+	) (*APIResponse, error)
+
 	// Delete an existing category in ReadMe.
 	//
 	// API Reference: https://docs.readme.com/reference/deletecategory
 	Delete(slug string, options ...RequestOptions) (bool, *APIResponse, error)
 
+	// DeleteWithContext deletes an existing category in ReadMe using the provided context.
+	DeleteWithContext(ctx context.Context, slug string, options ...RequestOptions) (bool, *APIResponse, error)
+
 	// Get a single category on ReadMe.com.
 	//
 	// The `category` parameter may be a slug or category ID prefixed with "id:".
@@ -47,16 +60,45 @@ type CategoryService interface {
 	// API Reference: https://docs.readme.com/reference/getcategory
 	Get(category string, options ...RequestOptions) (Category, *APIResponse, error)
 
+	// GetWithContext retrieves a single category on ReadMe.com using the provided context.
+	//
+	// The context is also used for the lookup of all categories when `category` is an ID.
+	GetWithContext(ctx context.Context, category string, options ...RequestOptions) (Category, *APIResponse, error)
+
 	// GetAll retrieves and returns all categories on ReadMe.com.
 	//
 	// API Reference: https://docs.readme.com/reference/getcategories
 	GetAll(options ...RequestOptions) ([]Category, *APIResponse, error)
 
+	// GetAllWithContext retrieves and returns all categories on ReadMe.com using the provided context.
+	//
+	// The context is checked before each page is requested.
+	GetAllWithContext(ctx context.Context, options ...RequestOptions) ([]Category, *APIResponse, error)
+
+	// All returns an iterator over all categories, requesting each page as the iteration progresses.
+	//
+	// No further pages are requested once the iteration stops. An error ends the iteration.
+	All(ctx context.Context, options ...RequestOptions) iter.Seq2[Category, error]
+
+	// Pages returns an iterator over each page of categories, including the page metadata, requesting each
+	// page as the iteration progresses.
+	//
+	// No further pages are requested once the iteration stops. An error ends the iteration.
+	Pages(ctx context.Context, options ...RequestOptions) iter.Seq2[Page[Category], error]
+
 	// GetDocs a list of docs metadata for a category on ReadMe.
 	//
 	// API Reference: https://docs.readme.com/main/reference/getcategorydocs
 	GetDocs(slug string, options ...RequestOptions) ([]CategoryDocs, *APIResponse, error)
 
+	// GetDocsWithContext retrieves a list of docs metadata for a category on ReadMe using the provided
+	// context.
+	GetDocsWithContext(
+		ctx context.Context,
+		slug string,
+		options ...RequestOptions,
+	) ([]CategoryDocs, *APIResponse, error)
+
 	// Update an existing category in ReadMe.
 	//
 	// Note that Update() returns a Category struct type while Create() returns
@@ -64,6 +106,14 @@ type CategoryService interface {
 	//
 	// API Reference: https://docs.readme.com/main/reference/updatecategory
 	Update(slug string, params CategoryParams, options ...RequestOptions) (Category, *APIResponse, error)
+
+	// UpdateWithContext updates an existing category in ReadMe using the provided context.
+	UpdateWithContext(
+		ctx context.Context,
+		slug string,
+		params CategoryParams,
+		options ...RequestOptions,
+	) (Category, *APIResponse, error)
 }
 
 // CategoryClient handles communication with the categories related methods of the ReadMe.com API.
@@ -177,9 +227,20 @@ func validCategoryType(categoryType string) bool {
 //
 // API Reference: https://docs.readme.com/reference/getcategories
 func (c CategoryClient) GetAll(options ...RequestOptions) ([]Category, *APIResponse, error) {
-	var results []Category
+	return c.GetAllWithContext(context.Background(), options...)
+}
+
+// GetAllWithContext retrieves and returns all categories on ReadMe.com using the provided context.
+//
+// The context is checked before each page is requested.
+func (c CategoryClient) GetAllWithContext(
+	ctx context.Context,
+	options ...RequestOptions,
+) ([]Category, *APIResponse, error) {
+	ctx = withOperation(ctx, "Category", "GetAll")
+
 	opts := parseRequestOptions(options)
-	apiResponse, err := c.client.fetchAllPages(CategoryEndpoint, opts, &results)
+	results, apiResponse, err := fetchAllPages[Category](ctx, c.client, CategoryEndpoint, opts)
 	if err != nil {
 		return results, apiResponse, fmt.Errorf("unable to retrieve categories: %w", err)
 	}
@@ -191,6 +252,25 @@ func (c CategoryClient) GetAll(options ...RequestOptions) ([]Category, *APIRespo
 	return results, apiResponse, nil
 }
 
+// All returns an iterator over all categories, requesting each page as the iteration progresses.
+//
+// No further pages are requested once the iteration stops. An error ends the iteration.
+func (c CategoryClient) All(ctx context.Context, options ...RequestOptions) iter.Seq2[Category, error] {
+	ctx = withOperation(ctx, "Category", "All")
+
+	return fetchItems[Category](ctx, c.client, CategoryEndpoint, parseRequestOptions(options))
+}
+
+// Pages returns an iterator over each page of categories, including the page metadata, requesting each
+// page as the iteration progresses.
+//
+// No further pages are requested once the iteration stops. An error ends the iteration.
+func (c CategoryClient) Pages(ctx context.Context, options ...RequestOptions) iter.Seq2[Page[Category], error] {
+	ctx = withOperation(ctx, "Category", "Pages")
+
+	return fetchPages[Category](ctx, c.client, CategoryEndpoint, parseRequestOptions(options))
+}
+
 // Get a single category on ReadMe.com.
 //
 // The `category` parameter may be a slug or category ID prefixed with "id:".
@@ -199,28 +279,63 @@ func (c CategoryClient) GetAll(options ...RequestOptions) ([]Category, *APIRespo
 //
 // API Reference: https://docs.readme.com/reference/getcategory
 func (c CategoryClient) Get(category string, options ...RequestOptions) (Category, *APIResponse, error) {
-	categoryResponse := Category{}
+	return c.GetWithContext(context.Background(), category, options...)
+}
+
+// GetWithContext retrieves a single category on ReadMe.com using the provided context.
+//
+// The context is also used for the lookup of all categories when `category` is an ID.
+func (c CategoryClient) GetWithContext(
+	ctx context.Context,
+	category string,
+	options ...RequestOptions,
+) (Category, *APIResponse, error) {
+	ctx = withOperation(ctx, "Category", "Get")
+
+	ctx, span := c.client.startSpan(ctx, SpanStart{
+		Name: "readme.Category.Get",
+		Kind: SpanKindInternal,
+	})
 
 	opts := RequestOptions{}
 	if len(options) > 0 {
 		opts = options[0]
 	}
 
+	categoryResponse, apiResponse, err := c.get(ctx, category, opts)
+	span.End(SpanEnd{Err: err})
+
+	return categoryResponse, apiResponse, err
+}
+
+// get retrieves a single category on ReadMe.com, looking up its slug first when `category` is an
+// ID.
+func (c CategoryClient) get(ctx context.Context, category string, opts RequestOptions) (Category, *APIResponse, error) {
+	categoryResponse := Category{}
+
 	isID, paramID := ParseID(category)
 	if isID {
-		category = ""
-
-		// Get all categories.
-		categories, apiResponse, err := c.GetAll(opts)
+		var lookupResponse *APIResponse
+		var err error
+		scope := c.client.resolverScope(categoryResolverKind, opts.Version)
+
+		// Get all categories and find a match by ID, unless the ID has already been resolved.
+		category, _, lookupResponse, err = c.client.ResolverCache.resolve(ctx, scope, paramID, "",
+			func(ctx context.Context) (map[string]string, *APIResponse, error) {
+				categories, apiResponse, err := c.GetAllWithContext(ctx, opts)
+				if err != nil {
+					return nil, apiResponse, err
+				}
+
+				slugs := map[string]string{}
+				for _, cat := range categories {
+					slugs[cat.ID] = cat.Slug
+				}
+
+				return slugs, apiResponse, nil
+			})
 		if err != nil {
-			return categoryResponse, apiResponse, err
-		}
-
-		// Find a match by ID and return it.
-		for _, cat := range categories {
-			if cat.ID == paramID {
-				category = cat.Slug
-			}
+			return categoryResponse, lookupResponse, err
 		}
 	}
 
@@ -237,7 +352,7 @@ func (c CategoryClient) Get(category string, options ...RequestOptions) (Categor
 		RequestOptions: opts,
 	}
 
-	apiResponse, err := c.client.APIRequest(apiRequest)
+	apiResponse, err := c.client.APIRequestWithContext(ctx, apiRequest)
 
 	return categoryResponse, apiResponse, err
 }
@@ -246,6 +361,18 @@ func (c CategoryClient) Get(category string, options ...RequestOptions) (Categor
 //
 // API Reference: https://docs.readme.com/main/reference/getcategorydocs
 func (c CategoryClient) GetDocs(slug string, options ...RequestOptions) ([]CategoryDocs, *APIResponse, error) {
+	return c.GetDocsWithContext(context.Background(), slug, options...)
+}
+
+// GetDocsWithContext retrieves a list of docs metadata for a category on ReadMe using the provided
+// context.
+func (c CategoryClient) GetDocsWithContext(
+	ctx context.Context,
+	slug string,
+	options ...RequestOptions,
+) ([]CategoryDocs, *APIResponse, error) {
+	ctx = withOperation(ctx, "Category", "GetDocs")
+
 	var response []CategoryDocs
 
 	apiRequest := &APIRequest{
@@ -260,7 +387,7 @@ func (c CategoryClient) GetDocs(slug string, options ...RequestOptions) ([]Categ
 		apiRequest.RequestOptions = options[0]
 	}
 
-	apiResponse, err := c.client.APIRequest(apiRequest)
+	apiResponse, err := c.client.APIRequestWithContext(ctx, apiRequest)
 
 	return response, apiResponse, err
 }
@@ -273,6 +400,18 @@ func (c CategoryClient) Create(
 	params CategoryParams,
 	options ...RequestOptions,
 ) (*APIResponse, error) {
+	return c.CreateWithContext(context.Background(), response, params, options...)
+}
+
+// CreateWithContext creates a new category in ReadMe using the provided context.
+func (c CategoryClient) CreateWithContext(
+	ctx context.Context,
+	response any,
+	params CategoryParams,
+	options ...RequestOptions,
+) (*APIResponse, error) {
+	ctx = withOperation(ctx, "Category", "Create")
+
 	if !validCategoryType(params.Type) {
 		return nil, fmt.Errorf("type must be 'guide' or 'reference'")
 	}
@@ -296,7 +435,7 @@ func (c CategoryClient) Create(
 		apiRequest.RequestOptions = options[0]
 	}
 
-	apiResponse, err := c.client.APIRequest(apiRequest)
+	apiResponse, err := c.client.APIRequestWithContext(ctx, apiRequest)
 
 	return apiResponse, err
 }
@@ -309,6 +448,18 @@ func (c CategoryClient) Update(
 	params CategoryParams,
 	options ...RequestOptions,
 ) (Category, *APIResponse, error) {
+	return c.UpdateWithContext(context.Background(), slug, params, options...)
+}
+
+// UpdateWithContext updates an existing category in ReadMe using the provided context.
+func (c CategoryClient) UpdateWithContext(
+	ctx context.Context,
+	slug string,
+	params CategoryParams,
+	options ...RequestOptions,
+) (Category, *APIResponse, error) {
+	ctx = withOperation(ctx, "Category", "Update")
+
 	if !validCategoryType(params.Type) {
 		return Category{}, nil, fmt.Errorf("type must be 'guide' or 'reference'")
 	}
@@ -333,7 +484,11 @@ func (c CategoryClient) Update(
 		apiRequest.RequestOptions = options[0]
 	}
 
-	apiResponse, err := c.client.APIRequest(apiRequest)
+	apiResponse, err := c.client.APIRequestWithContext(ctx, apiRequest)
+	if err == nil {
+		scope := c.client.resolverScope(categoryResolverKind, apiRequest.RequestOptions.Version)
+		c.client.ResolverCache.update(scope, response.ID, slug, response.Slug)
+	}
 
 	return response, apiResponse, err
 }
@@ -342,6 +497,17 @@ func (c CategoryClient) Update(
 //
 // API Reference: https://docs.readme.com/reference/deletecategory
 func (c CategoryClient) Delete(slug string, options ...RequestOptions) (bool, *APIResponse, error) {
+	return c.DeleteWithContext(context.Background(), slug, options...)
+}
+
+// DeleteWithContext deletes an existing category in ReadMe using the provided context.
+func (c CategoryClient) DeleteWithContext(
+	ctx context.Context,
+	slug string,
+	options ...RequestOptions,
+) (bool, *APIResponse, error) {
+	ctx = withOperation(ctx, "Category", "Delete")
+
 	apiRequest := &APIRequest{
 		Method:       "DELETE",
 		Endpoint:     fmt.Sprintf("%s/%s", CategoryEndpoint, slug),
@@ -352,10 +518,13 @@ func (c CategoryClient) Delete(slug string, options ...RequestOptions) (bool, *A
 		apiRequest.RequestOptions = options[0]
 	}
 
-	apiResponse, err := c.client.APIRequest(apiRequest)
+	apiResponse, err := c.client.APIRequestWithContext(ctx, apiRequest)
 	if err != nil {
 		return false, apiResponse, err
 	}
 
+	scope := c.client.resolverScope(categoryResolverKind, apiRequest.RequestOptions.Version)
+	c.client.ResolverCache.update(scope, "", slug, "")
+
 	return true, apiResponse, nil
 }
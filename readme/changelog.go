@@ -1,8 +1,10 @@
 package readme
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 )
 
 // ChangelogEndpoint is ReadMe API Endpoint for changelogs.
@@ -17,25 +19,53 @@ type ChangelogService interface {
 	// API Reference: https://docs.readme.com/main/reference/createchangelog
 	Create(params ChangelogParams) (Changelog, *APIResponse, error)
 
+	// CreateWithContext creates a new changelog in ReadMe using the provided context.
+	CreateWithContext(ctx context.Context, params ChangelogParams) (Changelog, *APIResponse, error)
+
 	// Delete a changelog in ReadMe.
 	//
 	// API Reference: https://docs.readme.com/main/reference/deletechangelog
 	Delete(slug string) (bool, *APIResponse, error)
 
+	// DeleteWithContext deletes a changelog in ReadMe using the provided context.
+	DeleteWithContext(ctx context.Context, slug string) (bool, *APIResponse, error)
+
 	// Get a changelog from ReadMe.
 	//
 	// API Reference: https://docs.readme.com/main/reference/getchangelogs
 	Get(slug string) (Changelog, *APIResponse, error)
 
+	// GetWithContext retrieves a single changelog from ReadMe using the provided context.
+	GetWithContext(ctx context.Context, slug string) (Changelog, *APIResponse, error)
+
 	// GetAll retrieves a list of changelogs from ReadMe.
 	//
 	// API Reference: https://docs.readme.com/main/reference/getchangelogs
 	GetAll(options ...RequestOptions) ([]Changelog, *APIResponse, error)
 
+	// GetAllWithContext retrieves a list of changelogs from ReadMe using the provided context.
+	//
+	// The context is checked before each page is requested.
+	GetAllWithContext(ctx context.Context, options ...RequestOptions) ([]Changelog, *APIResponse, error)
+
+	// All returns an iterator over all changelogs, requesting each page as the iteration progresses.
+	//
+	// No further pages are requested once the iteration stops. An error ends the iteration.
+	All(ctx context.Context, options ...RequestOptions) iter.Seq2[Changelog, error]
+
+	// Pages returns an iterator over each page of changelogs, including the page metadata, requesting each
+	// page as the iteration progresses.
+	//
+	// No further pages are requested once the iteration stops. An error ends the iteration.
+	Pages(ctx context.Context, options ...RequestOptions) iter.Seq2[Page[Changelog], error]
+
 	// Update an existing changelog in ReadMe.
 	//
 	// API Reference: https://docs.readme.com/main/reference/updatechangelog
 	Update(slug string, params ChangelogParams) (Changelog, *APIResponse, error)
+
+	// UpdateWithContext updates an existing changelog in ReadMe using the provided context.
+	UpdateWithContext(ctx context.Context, slug string, params ChangelogParams) (Changelog, *APIResponse, error)
 }
 
 // ChangelogClient handles communication with the docs related methods of the ReadMe.com API.
@@ -99,9 +129,20 @@ func validChangelogType(changelogType string) bool {
 //
 // API Reference: https://docs.readme.com/main/reference/getchangelogs
 func (c ChangelogClient) GetAll(options ...RequestOptions) ([]Changelog, *APIResponse, error) {
-	var results []Changelog
+	return c.GetAllWithContext(context.Background(), options...)
+}
+
+// GetAllWithContext retrieves a list of changelogs from ReadMe using the provided context.
+//
+// The context is checked before each page is requested.
+func (c ChangelogClient) GetAllWithContext(
+	ctx context.Context,
+	options ...RequestOptions,
+) ([]Changelog, *APIResponse, error) {
+	ctx = withOperation(ctx, "Changelog", "GetAll")
+
 	opts := parseRequestOptions(options)
-	apiResponse, err := c.client.fetchAllPages(ChangelogEndpoint, opts, &results)
+	results, apiResponse, err := fetchAllPages[Changelog](ctx, c.client, ChangelogEndpoint, opts)
 	if err != nil {
 		return nil, apiResponse, fmt.Errorf("unable to retrieve changelogs: %w", err)
 	}
@@ -113,10 +154,36 @@ func (c ChangelogClient) GetAll(options ...RequestOptions) ([]Changelog, *APIRes
 	return results, apiResponse, err
 }
 
+// All returns an iterator over all changelogs, requesting each page as the iteration progresses.
+//
+// No further pages are requested once the iteration stops. An error ends the iteration.
+func (c ChangelogClient) All(ctx context.Context, options ...RequestOptions) iter.Seq2[Changelog, error] {
+	ctx = withOperation(ctx, "Changelog", "All")
+
+	return fetchItems[Changelog](ctx, c.client, ChangelogEndpoint, parseRequestOptions(options))
+}
+
+// Pages returns an iterator over each page of changelogs, including the page metadata, requesting each
+// page as the iteration progresses.
+//
+// No further pages are requested once the iteration stops. An error ends the iteration.
+func (c ChangelogClient) Pages(ctx context.Context, options ...RequestOptions) iter.Seq2[Page[Changelog], error] {
+	ctx = withOperation(ctx, "Changelog", "Pages")
+
+	return fetchPages[Changelog](ctx, c.client, ChangelogEndpoint, parseRequestOptions(options))
+}
+
 // Get retrieves a single changelog from ReadMe.
 //
 // API Reference: https://docs.readme.com/main/reference/getchangelog
 func (c ChangelogClient) Get(slug string) (Changelog, *APIResponse, error) {
+	return c.GetWithContext(context.Background(), slug)
+}
+
+// GetWithContext retrieves a single changelog from ReadMe using the provided context.
+func (c ChangelogClient) GetWithContext(ctx context.Context, slug string) (Changelog, *APIResponse, error) {
+	ctx = withOperation(ctx, "Changelog", "Get")
+
 	response := Changelog{}
 	apiRequest := &APIRequest{
 		Method:       "GET",
@@ -126,7 +193,7 @@ func (c ChangelogClient) Get(slug string) (Changelog, *APIResponse, error) {
 		Response:     &response,
 	}
 
-	apiResponse, err := c.client.APIRequest(apiRequest)
+	apiResponse, err := c.client.APIRequestWithContext(ctx, apiRequest)
 
 	return response, apiResponse, err
 }
@@ -135,6 +202,16 @@ func (c ChangelogClient) Get(slug string) (Changelog, *APIResponse, error) {
 //
 // API Reference: https://docs.readme.com/main/reference/createchangelog
 func (c ChangelogClient) Create(params ChangelogParams) (Changelog, *APIResponse, error) {
+	return c.CreateWithContext(context.Background(), params)
+}
+
+// CreateWithContext creates a new changelog in ReadMe using the provided context.
+func (c ChangelogClient) CreateWithContext(
+	ctx context.Context,
+	params ChangelogParams,
+) (Changelog, *APIResponse, error) {
+	ctx = withOperation(ctx, "Changelog", "Create")
+
 	if params.Title == "" {
 		return Changelog{}, nil, fmt.Errorf("title must be provided")
 	}
@@ -148,7 +225,7 @@ func (c ChangelogClient) Create(params ChangelogParams) (Changelog, *APIResponse
 	}
 
 	response := Changelog{}
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "POST",
 		Endpoint:     ChangelogEndpoint,
 		UseAuth:      true,
@@ -165,6 +242,17 @@ func (c ChangelogClient) Create(params ChangelogParams) (Changelog, *APIResponse
 //
 // API Reference: https://docs.readme.com/main/reference/updatechangelog
 func (c ChangelogClient) Update(slug string, params ChangelogParams) (Changelog, *APIResponse, error) {
+	return c.UpdateWithContext(context.Background(), slug, params)
+}
+
+// UpdateWithContext updates an existing changelog in ReadMe using the provided context.
+func (c ChangelogClient) UpdateWithContext(
+	ctx context.Context,
+	slug string,
+	params ChangelogParams,
+) (Changelog, *APIResponse, error) {
+	ctx = withOperation(ctx, "Changelog", "Update")
+
 	if params.Title == "" {
 		return Changelog{}, nil, fmt.Errorf("title must be provided")
 	}
@@ -178,7 +266,7 @@ func (c ChangelogClient) Update(slug string, params ChangelogParams) (Changelog,
 	}
 
 	response := Changelog{}
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "PUT",
 		Endpoint:     fmt.Sprintf("%s/%s", ChangelogEndpoint, slug),
 		UseAuth:      true,
@@ -195,7 +283,14 @@ func (c ChangelogClient) Update(slug string, params ChangelogParams) (Changelog,
 //
 // API Reference: https://docs.readme.com/main/reference/deletechangelog
 func (c ChangelogClient) Delete(slug string) (bool, *APIResponse, error) {
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	return c.DeleteWithContext(context.Background(), slug)
+}
+
+// DeleteWithContext deletes a changelog in ReadMe using the provided context.
+func (c ChangelogClient) DeleteWithContext(ctx context.Context, slug string) (bool, *APIResponse, error) {
+	ctx = withOperation(ctx, "Changelog", "Delete")
+
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "DELETE",
 		Endpoint:     fmt.Sprintf("%s/%s", ChangelogEndpoint, slug),
 		UseAuth:      true,
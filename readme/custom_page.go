@@ -1,8 +1,10 @@
 package readme
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 )
 
 // CustomPageEndpoint is the ReadMe API Endpoint for custom pages.
@@ -15,16 +17,25 @@ type CustomPageService interface {
 	// API Reference: https://docs.readme.com/main/reference/createcustompage
 	Create(params CustomPageParams) (CustomPage, *APIResponse, error)
 
+	// CreateWithContext creates a new custom page in ReadMe using the provided context.
+	CreateWithContext(ctx context.Context, params CustomPageParams) (CustomPage, *APIResponse, error)
+
 	// Delete a custom page in ReadMe.
 	//
 	// API Reference: https://docs.readme.com/reference/deletecustompages
 	Delete(slug string) (bool, *APIResponse, error)
 
+	// DeleteWithContext deletes a custom page in ReadMe using the provided context.
+	DeleteWithContext(ctx context.Context, slug string) (bool, *APIResponse, error)
+
 	// Get a single custom page's data from ReadMe.
 	//
 	// API Reference: https://docs.readme.com/main/reference/getcustompage
 	Get(slug string) (CustomPage, *APIResponse, error)
 
+	// GetWithContext retrieves a single custom page's data from ReadMe using the provided context.
+	GetWithContext(ctx context.Context, slug string) (CustomPage, *APIResponse, error)
+
 	// GetAll retrieves a list of custom pages and their data from ReadMe.
 	//
 	// Pagination options may be specified with the `options` parameter.
@@ -32,10 +43,30 @@ type CustomPageService interface {
 	// API Reference: https://docs.readme.com/main/reference/getcustompages
 	GetAll(options ...RequestOptions) ([]CustomPage, *APIResponse, error)
 
+	// GetAllWithContext retrieves a list of custom pages and their data from ReadMe using the provided
+	// context.
+	//
+	// The context is checked before each page is requested.
+	GetAllWithContext(ctx context.Context, options ...RequestOptions) ([]CustomPage, *APIResponse, error)
+
+	// All returns an iterator over all custom pages, requesting each page as the iteration progresses.
+	//
+	// No further pages are requested once the iteration stops. An error ends the iteration.
+	All(ctx context.Context, options ...RequestOptions) iter.Seq2[CustomPage, error]
+
+	// Pages returns an iterator over each page of custom pages, including the page metadata, requesting each
+	// page as the iteration progresses.
+	//
+	// No further pages are requested once the iteration stops. An error ends the iteration.
+	Pages(ctx context.Context, options ...RequestOptions) iter.Seq2[Page[CustomPage], error]
+
 	// Update an existing custom page in ReadMe.
 	//
 	// API Reference: https://docs.readme.com/main/reference/updatecustompage
 	Update(slug string, params CustomPageParams) (CustomPage, *APIResponse, error)
+
+	// UpdateWithContext updates an existing custom page in ReadMe using the provided context.
+	UpdateWithContext(ctx context.Context, slug string, params CustomPageParams) (CustomPage, *APIResponse, error)
 }
 
 // CustomPageClient handles communication with the custom page related methods of the ReadMe API.
@@ -86,9 +117,21 @@ type CustomPageParams struct {
 //
 // API Reference: https://docs.readme.com/main/reference/getcustompages
 func (c CustomPageClient) GetAll(options ...RequestOptions) ([]CustomPage, *APIResponse, error) {
-	var results []CustomPage
+	return c.GetAllWithContext(context.Background(), options...)
+}
+
+// GetAllWithContext retrieves a list of custom pages and their data from ReadMe using the provided
+// context.
+//
+// The context is checked before each page is requested.
+func (c CustomPageClient) GetAllWithContext(
+	ctx context.Context,
+	options ...RequestOptions,
+) ([]CustomPage, *APIResponse, error) {
+	ctx = withOperation(ctx, "CustomPage", "GetAll")
+
 	opts := parseRequestOptions(options)
-	apiResponse, err := c.client.fetchAllPages(CustomPageEndpoint, opts, &results)
+	results, apiResponse, err := fetchAllPages[CustomPage](ctx, c.client, CustomPageEndpoint, opts)
 	if err != nil {
 		return nil, apiResponse, err
 	}
@@ -100,13 +143,39 @@ func (c CustomPageClient) GetAll(options ...RequestOptions) ([]CustomPage, *APIR
 	return results, apiResponse, nil
 }
 
+// All returns an iterator over all custom pages, requesting each page as the iteration progresses.
+//
+// No further pages are requested once the iteration stops. An error ends the iteration.
+func (c CustomPageClient) All(ctx context.Context, options ...RequestOptions) iter.Seq2[CustomPage, error] {
+	ctx = withOperation(ctx, "CustomPage", "All")
+
+	return fetchItems[CustomPage](ctx, c.client, CustomPageEndpoint, parseRequestOptions(options))
+}
+
+// Pages returns an iterator over each page of custom pages, including the page metadata, requesting each
+// page as the iteration progresses.
+//
+// No further pages are requested once the iteration stops. An error ends the iteration.
+func (c CustomPageClient) Pages(ctx context.Context, options ...RequestOptions) iter.Seq2[Page[CustomPage], error] {
+	ctx = withOperation(ctx, "CustomPage", "Pages")
+
+	return fetchPages[CustomPage](ctx, c.client, CustomPageEndpoint, parseRequestOptions(options))
+}
+
 // Get a single custom page's data from ReadMe.
 //
 // API Reference: https://docs.readme.com/main/reference/getcustompage
 func (c CustomPageClient) Get(slug string) (CustomPage, *APIResponse, error) {
+	return c.GetWithContext(context.Background(), slug)
+}
+
+// GetWithContext retrieves a single custom page's data from ReadMe using the provided context.
+func (c CustomPageClient) GetWithContext(ctx context.Context, slug string) (CustomPage, *APIResponse, error) {
+	ctx = withOperation(ctx, "CustomPage", "Get")
+
 	customPage := CustomPage{}
 
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "GET",
 		Endpoint:     fmt.Sprintf("%s/%s", CustomPageEndpoint, slug),
 		UseAuth:      true,
@@ -121,13 +190,23 @@ func (c CustomPageClient) Get(slug string) (CustomPage, *APIResponse, error) {
 //
 // API Reference: https://docs.readme.com/main/reference/createcustompage
 func (c CustomPageClient) Create(params CustomPageParams) (CustomPage, *APIResponse, error) {
+	return c.CreateWithContext(context.Background(), params)
+}
+
+// CreateWithContext creates a new custom page in ReadMe using the provided context.
+func (c CustomPageClient) CreateWithContext(
+	ctx context.Context,
+	params CustomPageParams,
+) (CustomPage, *APIResponse, error) {
+	ctx = withOperation(ctx, "CustomPage", "Create")
+
 	payload, err := json.Marshal(params)
 	if err != nil {
 		return CustomPage{}, nil, fmt.Errorf("unable to marshal request: %w", err)
 	}
 
 	response := CustomPage{}
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "POST",
 		Endpoint:     CustomPageEndpoint,
 		UseAuth:      true,
@@ -144,13 +223,24 @@ func (c CustomPageClient) Create(params CustomPageParams) (CustomPage, *APIRespo
 //
 // API Reference: https://docs.readme.com/main/reference/updatecustompage
 func (c CustomPageClient) Update(slug string, params CustomPageParams) (CustomPage, *APIResponse, error) {
+	return c.UpdateWithContext(context.Background(), slug, params)
+}
+
+// UpdateWithContext updates an existing custom page in ReadMe using the provided context.
+func (c CustomPageClient) UpdateWithContext(
+	ctx context.Context,
+	slug string,
+	params CustomPageParams,
+) (CustomPage, *APIResponse, error) {
+	ctx = withOperation(ctx, "CustomPage", "Update")
+
 	payload, err := json.Marshal(params)
 	if err != nil {
 		return CustomPage{}, nil, fmt.Errorf("unable to marshal request: %w", err)
 	}
 
 	response := CustomPage{}
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "PUT",
 		Endpoint:     fmt.Sprintf("%s/%s", CustomPageEndpoint, slug),
 		UseAuth:      true,
@@ -167,7 +257,14 @@ func (c CustomPageClient) Update(slug string, params CustomPageParams) (CustomPa
 //
 // API Reference: https://docs.readme.com/reference/deletecustompages
 func (c CustomPageClient) Delete(slug string) (bool, *APIResponse, error) {
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	return c.DeleteWithContext(context.Background(), slug)
+}
+
+// DeleteWithContext deletes a custom page in ReadMe using the provided context.
+func (c CustomPageClient) DeleteWithContext(ctx context.Context, slug string) (bool, *APIResponse, error) {
+	ctx = withOperation(ctx, "CustomPage", "Delete")
+
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "DELETE",
 		Endpoint:     fmt.Sprintf("%s/%s", CustomPageEndpoint, slug),
 		UseAuth:      true,
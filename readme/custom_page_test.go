@@ -31,7 +31,22 @@ func Test_CustomPages_GetAll(t *testing.T) {
 			mockResponseStatus: 200,
 			mockHeaders: map[string]string{
 				"Link":          `</custompages?page=2>; rel="next", <>; rel="prev", <>; rel="last"`,
-				"x-total-count": "3",
+				"x-total-count": "1",
+			},
+			mockResponseBody: testdata.CustomPages,
+			expectError:      false,
+			expectedResult:   testdata.CustomPages,
+		},
+		{
+			name: "when there is no next page",
+			reqOpts: readme.RequestOptions{
+				PerPage: 100,
+				Page:    1,
+			},
+			mockResponseStatus: 200,
+			mockHeaders: map[string]string{
+				"Link":          `<>; rel="next", <>; rel="prev", <>; rel="last"`,
+				"x-total-count": "1",
 			},
 			mockResponseBody: testdata.CustomPages,
 			expectError:      false,
@@ -1,6 +1,7 @@
 package readme
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -19,11 +20,17 @@ type DocService interface {
 	// API Reference: https://docs.readme.com/main/reference/createdoc
 	Create(params DocParams, options ...RequestOptions) (Doc, *APIResponse, error)
 
+	// CreateWithContext creates a new doc in ReadMe using the provided context.
+	CreateWithContext(ctx context.Context, params DocParams, options ...RequestOptions) (Doc, *APIResponse, error)
+
 	// Delete a doc in ReadMe.
 	//
 	// API Reference: https://docs.readme.com/reference/deletedoc
 	Delete(slug string, options ...RequestOptions) (bool, *APIResponse, error)
 
+	// DeleteWithContext deletes a doc in ReadMe using the provided context.
+	DeleteWithContext(ctx context.Context, slug string, options ...RequestOptions) (bool, *APIResponse, error)
+
 	// Get a doc from ReadMe.
 	//
 	// The `doc` parameter may be a slug or doc ID prefixed with "id:".
@@ -37,15 +44,36 @@ type DocService interface {
 	//   - https://docs.readme.com/main/reference/getproductiondoc
 	Get(doc string, options ...RequestOptions) (Doc, *APIResponse, error)
 
+	// GetWithContext retrieves a doc from ReadMe using the provided context.
+	//
+	// The context is also used for the search request when `doc` is an ID.
+	GetWithContext(ctx context.Context, doc string, options ...RequestOptions) (Doc, *APIResponse, error)
+
 	// Search for docs that match the search query parameter.
 	//
 	// API Reference: https://docs.readme.com/main/reference/searchdocs
 	Search(query string, options ...RequestOptions) ([]DocSearchResult, *APIResponse, error)
 
+	// SearchWithContext searches for docs that match the search query parameter using the provided
+	// context.
+	SearchWithContext(
+		ctx context.Context,
+		query string,
+		options ...RequestOptions,
+	) ([]DocSearchResult, *APIResponse, error)
+
 	// Update an existing doc in ReadMe.
 	//
 	// API Reference: https://docs.readme.com/main/reference/updatedoc
 	Update(slug string, params DocParams, options ...RequestOptions) (Doc, *APIResponse, error)
+
+	// UpdateWithContext updates an existing doc in ReadMe using the provided context.
+	UpdateWithContext(
+		ctx context.Context,
+		slug string,
+		params DocParams,
+		options ...RequestOptions,
+	) (Doc, *APIResponse, error)
 }
 
 // DocClient handles communication with the docs related methods of the ReadMe.com API.
@@ -202,9 +230,13 @@ type DocParams struct {
 	CategorySlug string `json:"categorySlug"`
 	// Error is an error code for docs with the type set to "error".
 	Error DocErrorObject `json:"error,omitempty"`
+	// Excerpt is a short summary of the page shown below its title.
+	Excerpt string `json:"excerpt,omitempty"`
 	// Hidden toggles visibility for the doc.
 	// API default is true.
 	Hidden *bool `json:"hidden"`
+	// Metadata sets the title and description of the page used by search engines and link previews.
+	Metadata *DocMetadataParams `json:"metadata,omitempty"`
 	// Order sets the position of the page in the project sidebar.
 	// API default is 999.
 	Order *int `json:"order"`
@@ -222,6 +254,15 @@ type DocParams struct {
 	Type string `json:"type,omitempty"`
 }
 
+// DocMetadataParams represents the metadata of a doc when creating or updating it.
+type DocMetadataParams struct {
+	// Description is the description of the page used by search engines and link previews.
+	Description string `json:"description,omitempty"`
+	// Title is the title of the page used by search engines and link previews. The doc's title is
+	// used when this is empty.
+	Title string `json:"title,omitempty"`
+}
+
 // DocErrorObject represents the 'error' key in a doc response.
 type DocErrorObject struct {
 	Code string `json:"code"`
@@ -291,6 +332,19 @@ type DocSearchResultSnippetValue struct {
 //   - https://docs.readme.com/main/reference/getdoc
 //   - https://docs.readme.com/main/reference/getproductiondoc
 func (c DocClient) Get(doc string, options ...RequestOptions) (Doc, *APIResponse, error) {
+	return c.GetWithContext(context.Background(), doc, options...)
+}
+
+// GetWithContext retrieves a doc from ReadMe using the provided context.
+//
+// The context is also used for the search request when `doc` is an ID.
+func (c DocClient) GetWithContext(
+	ctx context.Context,
+	doc string,
+	options ...RequestOptions,
+) (Doc, *APIResponse, error) {
+	ctx = withOperation(ctx, "Doc", "Get")
+
 	response := Doc{}
 
 	opts := RequestOptions{}
@@ -300,24 +354,33 @@ func (c DocClient) Get(doc string, options ...RequestOptions) (Doc, *APIResponse
 
 	isID, paramID := ParseID(doc)
 	if isID {
-		// Reset the doc query. A matching search result will set this to its slug.
-		doc = ""
-
-		// Search all docs.
-		docs, apiResponse, err := c.Search(paramID, opts)
+		var lookupResponse *APIResponse
+		var err error
+		scope := c.client.resolverScope(docResolverKind, opts.Version)
+
+		// Search all docs and find a match by ID, unless the ID has already been resolved.
+		doc, _, lookupResponse, err = c.client.ResolverCache.resolve(ctx, scope, paramID, paramID,
+			func(ctx context.Context) (map[string]string, *APIResponse, error) {
+				docs, apiResponse, err := c.SearchWithContext(ctx, paramID, opts)
+				if err != nil {
+					return nil, apiResponse, err
+				}
+
+				slugs := map[string]string{}
+				for _, docResult := range docs {
+					if docResult.ReferenceID == paramID {
+						slugs[paramID] = docResult.Slug
+					}
+				}
+
+				return slugs, apiResponse, nil
+			})
 		if err != nil {
-			return response, apiResponse, err
-		}
-
-		// Find a match by ID and return it.
-		for _, docResult := range docs {
-			if docResult.ReferenceID == paramID {
-				doc = docResult.Slug
-			}
+			return response, lookupResponse, err
 		}
 
 		if doc == "" {
-			return response, nil, fmt.Errorf("no doc found matching id %s (is it hidden?)", paramID)
+			return response, nil, fmt.Errorf("no doc found matching id %s (is it hidden?): %w", paramID, ErrNotFound)
 		}
 	}
 
@@ -338,7 +401,7 @@ func (c DocClient) Get(doc string, options ...RequestOptions) (Doc, *APIResponse
 		apiRequest.Endpoint = fmt.Sprintf("%s/production", apiRequest.Endpoint)
 	}
 
-	apiResponse, err := c.client.APIRequest(apiRequest)
+	apiResponse, err := c.client.APIRequestWithContext(ctx, apiRequest)
 
 	return response, apiResponse, err
 }
@@ -347,6 +410,17 @@ func (c DocClient) Get(doc string, options ...RequestOptions) (Doc, *APIResponse
 //
 // API Reference: https://docs.readme.com/main/reference/createdoc
 func (c DocClient) Create(params DocParams, options ...RequestOptions) (Doc, *APIResponse, error) {
+	return c.CreateWithContext(context.Background(), params, options...)
+}
+
+// CreateWithContext creates a new doc in ReadMe using the provided context.
+func (c DocClient) CreateWithContext(
+	ctx context.Context,
+	params DocParams,
+	options ...RequestOptions,
+) (Doc, *APIResponse, error) {
+	ctx = withOperation(ctx, "Doc", "Create")
+
 	if params.Title == "" {
 		return Doc{}, nil, fmt.Errorf("doc title is required")
 	}
@@ -375,7 +449,7 @@ func (c DocClient) Create(params DocParams, options ...RequestOptions) (Doc, *AP
 		apiRequest.RequestOptions = options[0]
 	}
 
-	apiResponse, err := c.client.APIRequest(apiRequest)
+	apiResponse, err := c.client.APIRequestWithContext(ctx, apiRequest)
 
 	return response, apiResponse, err
 }
@@ -384,6 +458,18 @@ func (c DocClient) Create(params DocParams, options ...RequestOptions) (Doc, *AP
 //
 // API Reference: https://docs.readme.com/main/reference/updatedoc
 func (c DocClient) Update(slug string, params DocParams, options ...RequestOptions) (Doc, *APIResponse, error) {
+	return c.UpdateWithContext(context.Background(), slug, params, options...)
+}
+
+// UpdateWithContext updates an existing doc in ReadMe using the provided context.
+func (c DocClient) UpdateWithContext(
+	ctx context.Context,
+	slug string,
+	params DocParams,
+	options ...RequestOptions,
+) (Doc, *APIResponse, error) {
+	ctx = withOperation(ctx, "Doc", "Update")
+
 	if params.Title == "" {
 		return Doc{}, nil, fmt.Errorf("doc title is required")
 	}
@@ -412,7 +498,11 @@ func (c DocClient) Update(slug string, params DocParams, options ...RequestOptio
 		apiRequest.RequestOptions = options[0]
 	}
 
-	apiResponse, err := c.client.APIRequest(apiRequest)
+	apiResponse, err := c.client.APIRequestWithContext(ctx, apiRequest)
+	if err == nil {
+		scope := c.client.resolverScope(docResolverKind, apiRequest.RequestOptions.Version)
+		c.client.ResolverCache.update(scope, response.ID, slug, response.Slug)
+	}
 
 	return response, apiResponse, err
 }
@@ -421,6 +511,17 @@ func (c DocClient) Update(slug string, params DocParams, options ...RequestOptio
 //
 // API Reference: https://docs.readme.com/reference/deletedoc
 func (c DocClient) Delete(slug string, options ...RequestOptions) (bool, *APIResponse, error) {
+	return c.DeleteWithContext(context.Background(), slug, options...)
+}
+
+// DeleteWithContext deletes a doc in ReadMe using the provided context.
+func (c DocClient) DeleteWithContext(
+	ctx context.Context,
+	slug string,
+	options ...RequestOptions,
+) (bool, *APIResponse, error) {
+	ctx = withOperation(ctx, "Doc", "Delete")
+
 	apiRequest := &APIRequest{
 		Method:       "DELETE",
 		Endpoint:     fmt.Sprintf("%s/%s", DocEndpoint, slug),
@@ -432,11 +533,13 @@ func (c DocClient) Delete(slug string, options ...RequestOptions) (bool, *APIRes
 		apiRequest.Version = options[0].Version
 	}
 
-	apiResponse, err := c.client.APIRequest(apiRequest)
+	apiResponse, err := c.client.APIRequestWithContext(ctx, apiRequest)
 	if err != nil {
 		return false, apiResponse, err
 	}
 
+	c.client.ResolverCache.update(c.client.resolverScope(docResolverKind, apiRequest.Version), "", slug, "")
+
 	return true, apiResponse, nil
 }
 
@@ -444,6 +547,18 @@ func (c DocClient) Delete(slug string, options ...RequestOptions) (bool, *APIRes
 //
 // API Reference: https://docs.readme.com/main/reference/searchdocs
 func (c DocClient) Search(query string, options ...RequestOptions) ([]DocSearchResult, *APIResponse, error) {
+	return c.SearchWithContext(context.Background(), query, options...)
+}
+
+// SearchWithContext searches for docs that match the search query parameter using the provided
+// context.
+func (c DocClient) SearchWithContext(
+	ctx context.Context,
+	query string,
+	options ...RequestOptions,
+) ([]DocSearchResult, *APIResponse, error) {
+	ctx = withOperation(ctx, "Doc", "Search")
+
 	results := DocSearchResults{}
 	apiRequest := &APIRequest{
 		Method:       "POST",
@@ -457,7 +572,7 @@ func (c DocClient) Search(query string, options ...RequestOptions) ([]DocSearchR
 		apiRequest.Version = options[0].Version
 	}
 
-	apiResponse, err := c.client.APIRequest(apiRequest)
+	apiResponse, err := c.client.APIRequestWithContext(ctx, apiRequest)
 
 	return results.Results, apiResponse, err
 }
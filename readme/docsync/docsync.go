@@ -0,0 +1,456 @@
+// Package docsync syncs a directory of markdown files with YAML front matter to docs in ReadMe.
+//
+// Each file with the ".md" or ".markdown" extension describes a doc: its front matter sets the
+// doc's title, category and other fields (see FrontMatter) and the rest of the file is its body.
+// The doc's slug is the name of the file without its extension. ReadMe generates the slug of a new
+// doc from its title and the API doesn't accept another one, so the file must be named after that
+// slug, such as "getting-started.md" for a doc titled "Getting Started". A file is reported as
+// failed when the slug of the doc created for it doesn't match, since the doc wouldn't be found on
+// later syncs.
+//
+// Sync the files in a directory and print what was done:
+//
+//	report, err := docsync.Sync(ctx, client, "docs", docsync.Options{
+//		Category: "documentation",
+//		Prune:    true,
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	for _, result := range report.Results {
+//		fmt.Println(result.Path, result.Slug, result.Action)
+//	}
+//
+//	if err := report.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+package docsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// Action is what was done to a doc during a sync.
+type Action string
+
+const (
+	// ActionCreated means the doc didn't exist and was created.
+	ActionCreated Action = "created"
+	// ActionUpdated means the doc changed and was updated.
+	ActionUpdated Action = "updated"
+	// ActionUnchanged means the doc was already up to date.
+	ActionUnchanged Action = "unchanged"
+	// ActionDeleted means the doc wasn't in the directory and was deleted.
+	ActionDeleted Action = "deleted"
+	// ActionFailed means the file couldn't be read or the doc couldn't be synced.
+	ActionFailed Action = "failed"
+)
+
+// Options configures a sync.
+type Options struct {
+	// Version is the project version to sync the docs to. The stable version is used when this is
+	// empty.
+	Version string
+	// Category is the slug of the category of the docs that don't set one in their front matter.
+	Category string
+	// Prune deletes the docs in the synced categories that don't have a file in the directory.
+	Prune bool
+}
+
+// Result is the outcome of syncing a file or pruning a doc.
+type Result struct {
+	// Path is the slash-separated path of the file, relative to the synced directory. This is empty
+	// for deleted docs.
+	Path string
+	// Slug is the slug of the doc.
+	Slug string
+	// Action is what was done to the doc.
+	Action Action
+	// Hash is the hash of the file's content returned by File.Hash. This is empty for deleted docs
+	// and for files that couldn't be read.
+	Hash string
+	// Err is the reason the action failed.
+	Err error
+}
+
+// Report is the outcome of a sync, with a result for every file followed by a result for every
+// pruned doc.
+type Report struct {
+	Results []Result
+}
+
+// Count returns the number of results with an action.
+func (r *Report) Count(action Action) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Action == action {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Err returns the errors of the failed results joined together, or nil when nothing failed.
+func (r *Report) Err() error {
+	var errs []error
+	for _, result := range r.Results {
+		if result.Err != nil {
+			errs = append(errs, result.Err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Sync syncs the markdown files in a directory and its subdirectories to docs.
+func Sync(ctx context.Context, client *readme.Client, dir string, options Options) (*Report, error) {
+	return SyncFS(ctx, client, os.DirFS(dir), options)
+}
+
+// SyncFS syncs the markdown files in a file system to docs.
+//
+// Parent docs are synced before their children. A file that can't be read or synced is reported
+// as failed without stopping the sync, but an error is returned when the file system can't be
+// walked or the context is done, along with the results so far.
+func SyncFS(ctx context.Context, client *readme.Client, fsys fs.FS, options Options) (*Report, error) {
+	local, err := readFiles(fsys, options)
+	if err != nil {
+		return nil, err
+	}
+
+	syncer := &syncer{
+		client:      client,
+		options:     readme.RequestOptions{Version: options.Version},
+		categoryIDs: map[string]string{},
+		docIDs:      map[string]string{},
+	}
+	report := &Report{Results: local.failed}
+
+	for _, file := range local.files {
+		if err := ctx.Err(); err != nil {
+			return report, fmt.Errorf("unable to sync docs: %w", err)
+		}
+
+		result := syncer.sync(ctx, file)
+		local.slugs[result.Slug] = true
+		report.Results = append(report.Results, result)
+	}
+
+	slices.SortStableFunc(report.Results, func(a, b Result) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	if !options.Prune {
+		return report, nil
+	}
+
+	for _, category := range local.categories {
+		if err := ctx.Err(); err != nil {
+			return report, fmt.Errorf("unable to sync docs: %w", err)
+		}
+
+		report.Results = append(report.Results, syncer.prune(ctx, category, local.slugs)...)
+	}
+
+	return report, nil
+}
+
+// localFiles is the markdown files read from a file system.
+type localFiles struct {
+	// files is the files to sync, with parent docs before their children.
+	files []*File
+	// failed is the results of the files that can't be synced.
+	failed []Result
+	// slugs is the slugs of every file, including the files that can't be synced.
+	slugs map[string]bool
+	// categories is the slugs of the categories of the files, sorted.
+	categories []string
+}
+
+// readFiles reads and parses the markdown files in a file system. Hidden files and directories are
+// skipped.
+func readFiles(fsys fs.FS, options Options) (*localFiles, error) {
+	local := &localFiles{slugs: map[string]bool{}}
+	var files []*File
+
+	err := fs.WalkDir(fsys, ".", func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if filePath != "." && strings.HasPrefix(entry.Name(), ".") {
+			if entry.IsDir() {
+				return fs.SkipDir
+			}
+
+			return nil
+		}
+		if entry.IsDir() || !isMarkdown(filePath) {
+			return nil
+		}
+
+		file, err := readFile(fsys, filePath, options)
+		if err != nil {
+			slug := fileSlug(filePath)
+			local.slugs[slug] = true
+			local.failed = append(local.failed, Result{Path: filePath, Slug: slug, Action: ActionFailed, Err: err})
+
+			return nil
+		}
+
+		if local.slugs[file.Slug()] {
+			local.failed = append(local.failed, failed(file,
+				fmt.Errorf("%s: another file has the slug '%s'", filePath, file.Slug())))
+
+			return nil
+		}
+
+		local.slugs[file.Slug()] = true
+		files = append(files, file)
+		if !slices.Contains(local.categories, file.FrontMatter.CategorySlug) {
+			local.categories = append(local.categories, file.FrontMatter.CategorySlug)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to read docs: %w", err)
+	}
+
+	var cyclic []*File
+	local.files, cyclic = orderFiles(files)
+	for _, file := range cyclic {
+		local.failed = append(local.failed, failed(file,
+			fmt.Errorf("%s: the parent docs of '%s' form a cycle", file.Path, file.Slug())))
+	}
+	slices.Sort(local.categories)
+
+	return local, nil
+}
+
+// readFile reads and parses a markdown file, setting the default category when it doesn't set one.
+func readFile(fsys fs.FS, filePath string, options Options) (*File, error) {
+	data, err := fs.ReadFile(fsys, filePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", filePath, err)
+	}
+
+	file, err := ParseFile(filePath, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if file.FrontMatter.CategorySlug == "" {
+		file.FrontMatter.CategorySlug = options.Category
+	}
+	if file.FrontMatter.CategorySlug == "" {
+		return nil, fmt.Errorf("%s: the front matter doesn't set a category and there's no default", filePath)
+	}
+
+	return file, nil
+}
+
+// isMarkdown returns whether a file has a markdown extension.
+func isMarkdown(filePath string) bool {
+	ext := strings.ToLower(path.Ext(filePath))
+
+	return ext == ".md" || ext == ".markdown"
+}
+
+// orderFiles sorts files so parent docs come before their children. The files whose parent docs
+// form a cycle, and their children, are returned separately.
+func orderFiles(files []*File) ([]*File, []*File) {
+	bySlug := map[string]*File{}
+	for _, file := range files {
+		bySlug[file.Slug()] = file
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	state := map[*File]int{}
+	inCycle := map[*File]bool{}
+	var ordered, cyclic []*File
+
+	var visit func(file *File) bool
+	visit = func(file *File) bool {
+		switch state[file] {
+		case visiting:
+			return false
+		case visited:
+			return !inCycle[file]
+		}
+
+		state[file] = visiting
+		isOrdered := true
+		if parent, ok := bySlug[file.FrontMatter.ParentDocSlug]; ok {
+			isOrdered = visit(parent)
+		}
+		state[file] = visited
+
+		if isOrdered {
+			ordered = append(ordered, file)
+		} else {
+			inCycle[file] = true
+			cyclic = append(cyclic, file)
+		}
+
+		return isOrdered
+	}
+
+	for _, file := range files {
+		visit(file)
+	}
+
+	return ordered, cyclic
+}
+
+// failed returns the result of a file that failed to sync.
+func failed(file *File, err error) Result {
+	return Result{Path: file.Path, Slug: file.Slug(), Action: ActionFailed, Hash: file.Hash(), Err: err}
+}
+
+// syncer syncs files to docs, caching the IDs of categories and docs.
+type syncer struct {
+	client  *readme.Client
+	options readme.RequestOptions
+	// categoryIDs maps the slugs of categories to their IDs.
+	categoryIDs map[string]string
+	// docIDs maps the slugs of docs to their IDs.
+	docIDs map[string]string
+}
+
+// sync creates or updates the doc of a file, unless it's up to date.
+func (s *syncer) sync(ctx context.Context, file *File) Result {
+	result := Result{Path: file.Path, Slug: file.Slug(), Hash: file.Hash()}
+
+	categoryID, err := s.categoryID(ctx, file.FrontMatter.CategorySlug)
+	if err != nil {
+		return failed(file, fmt.Errorf("%s: unable to get category '%s': %w", file.Path,
+			file.FrontMatter.CategorySlug, err))
+	}
+
+	var parentDocID string
+	if parent := file.FrontMatter.ParentDocSlug; parent != "" {
+		parentDocID, err = s.docID(ctx, parent)
+		if err != nil {
+			return failed(file, fmt.Errorf("%s: unable to get parent doc '%s': %w", file.Path, parent, err))
+		}
+	}
+
+	doc, _, err := s.client.Doc.GetWithContext(ctx, result.Slug, s.options)
+	switch {
+	case errors.Is(err, readme.ErrNotFound):
+		result.Action = ActionCreated
+		doc, _, err = s.client.Doc.CreateWithContext(ctx, file.Params(), s.options)
+	case err != nil:
+		// The error is returned below.
+	case hash(file.remoteContent(doc, categoryID, parentDocID)) == result.Hash:
+		result.Action = ActionUnchanged
+	default:
+		result.Action = ActionUpdated
+		doc, _, err = s.client.Doc.UpdateWithContext(ctx, result.Slug, file.Params(), s.options)
+	}
+	if err != nil {
+		return failed(file, fmt.Errorf("%s: unable to sync doc '%s': %w", file.Path, result.Slug, err))
+	}
+
+	// The doc wouldn't be found by the file's slug on the next sync, so a duplicate would be
+	// created. The result keeps the created doc's slug so it isn't pruned.
+	if result.Action == ActionCreated && doc.Slug != result.Slug {
+		result.Action = ActionFailed
+		result.Err = fmt.Errorf("%s: ReadMe created doc '%s' with the slug '%s', rename the file to match it",
+			file.Path, result.Slug, doc.Slug)
+		result.Slug = doc.Slug
+
+		return result
+	}
+
+	s.docIDs[result.Slug] = doc.ID
+	s.docIDs[doc.Slug] = doc.ID
+	result.Slug = doc.Slug
+
+	return result
+}
+
+// categoryID returns the ID of a category.
+func (s *syncer) categoryID(ctx context.Context, slug string) (string, error) {
+	if id, ok := s.categoryIDs[slug]; ok {
+		return id, nil
+	}
+
+	category, _, err := s.client.Category.GetWithContext(ctx, slug, s.options)
+	if err != nil {
+		return "", fmt.Errorf("unable to get category: %w", err)
+	}
+	s.categoryIDs[slug] = category.ID
+
+	return category.ID, nil
+}
+
+// docID returns the ID of a doc.
+func (s *syncer) docID(ctx context.Context, slug string) (string, error) {
+	if id, ok := s.docIDs[slug]; ok {
+		return id, nil
+	}
+
+	doc, _, err := s.client.Doc.GetWithContext(ctx, slug, s.options)
+	if err != nil {
+		return "", fmt.Errorf("unable to get doc: %w", err)
+	}
+	s.docIDs[slug] = doc.ID
+
+	return doc.ID, nil
+}
+
+// prune deletes the docs in a category that don't have a file, deleting children before their
+// parents. A doc with a child that has a file is kept.
+func (s *syncer) prune(ctx context.Context, category string, slugs map[string]bool) []Result {
+	docs, _, err := s.client.Category.GetDocsWithContext(ctx, category, s.options)
+	if err != nil {
+		return []Result{{
+			Action: ActionFailed,
+			Err:    fmt.Errorf("unable to get the docs in category '%s': %w", category, err),
+		}}
+	}
+
+	var results []Result
+
+	// visit deletes the docs without a file and returns whether any of them are kept, so parents
+	// with kept children aren't deleted along with them.
+	var visit func(docs []readme.CategoryDocs) bool
+	visit = func(docs []readme.CategoryDocs) bool {
+		kept := false
+		for _, doc := range docs {
+			if visit(doc.Children) || slugs[doc.Slug] {
+				kept = true
+
+				continue
+			}
+
+			result := Result{Slug: doc.Slug, Action: ActionDeleted}
+			if _, _, err := s.client.Doc.DeleteWithContext(ctx, doc.Slug, s.options); err != nil {
+				result.Action = ActionFailed
+				result.Err = fmt.Errorf("unable to delete doc '%s': %w", doc.Slug, err)
+			}
+			results = append(results, result)
+		}
+
+		return kept
+	}
+	visit(docs)
+
+	return results
+}
@@ -0,0 +1,287 @@
+package docsync_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/readme/docsync"
+	"github.com/liveoaklabs/readme-api-go-client/tests/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testOverview = `---
+title: Overview
+order: 1
+hidden: false
+---
+
+Welcome to the docs.
+`
+	testInstallation = `---
+title: Installation
+parentDocSlug: overview
+excerpt: Install the project.
+metadata:
+  title: Installing the Project
+  description: How to install the project.
+---
+Run the installer.
+`
+)
+
+// newTestClient returns a client backed by fake services with a "documentation" category.
+func newTestClient(t *testing.T) *readme.Client {
+	t.Helper()
+
+	client, _ := mocks.NewFake(t)
+	_, err := client.Category.Create(&readme.CategorySaved{},
+		readme.CategoryParams{Title: "Documentation", Type: "guide"})
+	assert.NoError(t, err, "it creates the category")
+
+	return client
+}
+
+func Test_ParseFile(t *testing.T) {
+	t.Run("when the file has front matter", func(t *testing.T) {
+		// Act
+		file, err := docsync.ParseFile("guides/installation.md", []byte(testInstallation))
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, "installation", file.Slug(), "it uses the file name as the slug")
+		assert.Equal(t, readme.DocParams{
+			Body:    "Run the installer.",
+			Excerpt: "Install the project.",
+			Metadata: &readme.DocMetadataParams{
+				Title:       "Installing the Project",
+				Description: "How to install the project.",
+			},
+			ParentDocSlug: "overview",
+			Title:         "Installation",
+		}, file.Params(), "it returns the doc parameters")
+		assert.Len(t, file.Hash(), 64, "it returns a SHA-256 hash")
+	})
+
+	t.Run("when the file has no body", func(t *testing.T) {
+		// Act
+		file, err := docsync.ParseFile("guides/home.md", []byte("---\ntitle: Home\n---\n"))
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, "home", file.Slug(), "it uses the name of the file as the slug")
+		assert.Empty(t, file.Body, "it returns an empty body")
+	})
+
+	t.Run("when the body changes", func(t *testing.T) {
+		// Arrange
+		file, _ := docsync.ParseFile("overview.md", []byte(testOverview))
+		changed, _ := docsync.ParseFile("overview.md", []byte(testOverview+"More text.\n"))
+
+		// Assert
+		assert.NotEqual(t, file.Hash(), changed.Hash(), "it returns a different hash")
+	})
+
+	t.Run("when the file is invalid", func(t *testing.T) {
+		tests := map[string]string{
+			"without front matter":       "# Overview\n",
+			"with unclosed front matter": "---\ntitle: Overview\n",
+			"with an unknown field":      "---\ntitle: Overview\ncolor: blue\n---\n",
+			"with a slug":                "---\ntitle: Overview\nslug: intro\n---\n",
+			"without a title":            "---\norder: 1\n---\n",
+		}
+
+		for name, data := range tests {
+			// Act
+			_, err := docsync.ParseFile("overview.md", []byte(data))
+
+			// Assert
+			assert.ErrorContains(t, err, "overview.md", "it returns an error for a file "+name)
+		}
+	})
+}
+
+func Test_SyncFS(t *testing.T) {
+	t.Run("when the docs don't exist", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t)
+		fsys := fstest.MapFS{
+			"overview.md":             {Data: []byte(testOverview)},
+			"guides/installation.md":  {Data: []byte(testInstallation)},
+			"guides/notes.txt":        {Data: []byte("Not a doc.")},
+			".drafts/upcoming.md":     {Data: []byte("---\ntitle: Upcoming\n---\n")},
+			"guides/.hidden-draft.md": {Data: []byte("---\ntitle: Draft\n---\n")},
+		}
+
+		// Act
+		report, err := docsync.SyncFS(context.Background(), client, fsys, docsync.Options{Category: "documentation"})
+		docs, _, docsErr := client.Category.GetDocs("documentation")
+		doc, _, docErr := client.Doc.Get("installation")
+
+		// Assert
+		assert.NoError(t, errors.Join(err, report.Err(), docsErr, docErr), "it does not return an error")
+		assert.Equal(t, []docsync.Action{docsync.ActionCreated, docsync.ActionCreated},
+			actions(report), "it creates the docs")
+		assert.Equal(t, "guides/installation.md", report.Results[0].Path, "it sorts the results by path")
+		assert.Len(t, docs, 1, "it creates the parent doc at the top level")
+		assert.Equal(t, "installation", docs[0].Children[0].Slug, "it creates the child doc under its parent")
+		assert.Equal(t, "Run the installer.", doc.Body, "it sets the body")
+		assert.Equal(t, "Installing the Project", doc.Metadata.Title, "it sets the metadata")
+	})
+
+	t.Run("when the docs are synced again", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t)
+		fsys := fstest.MapFS{
+			"overview.md":            {Data: []byte(testOverview)},
+			"guides/installation.md": {Data: []byte(testInstallation)},
+		}
+		options := docsync.Options{Category: "documentation"}
+		_, _ = docsync.SyncFS(context.Background(), client, fsys, options)
+
+		// Act
+		unchanged, unchangedErr := docsync.SyncFS(context.Background(), client, fsys, options)
+		fsys["overview.md"] = &fstest.MapFile{Data: []byte(testOverview + "\nRead the guides.\n")}
+		updated, updatedErr := docsync.SyncFS(context.Background(), client, fsys, options)
+		doc, _, docErr := client.Doc.Get("overview")
+
+		// Assert
+		assert.NoError(t, errors.Join(unchangedErr, updatedErr, docErr), "it does not return an error")
+		assert.Equal(t, 2, unchanged.Count(docsync.ActionUnchanged), "it doesn't update unchanged docs")
+		assert.Equal(t, []docsync.Action{docsync.ActionUnchanged, docsync.ActionUpdated},
+			actions(updated), "it only updates the changed doc")
+		assert.Equal(t, "Welcome to the docs.\n\nRead the guides.", doc.Body, "it updates the body")
+		assert.Equal(t, 2, doc.Revision, "it updates the doc once")
+	})
+
+	t.Run("when the body in ReadMe has surrounding whitespace", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t)
+		order, hidden := 1, false
+		_, _, createErr := client.Doc.Create(readme.DocParams{
+			Title:        "Overview",
+			Body:         "Welcome to the docs.\n\n",
+			CategorySlug: "documentation",
+			Order:        &order,
+			Hidden:       &hidden,
+		})
+		fsys := fstest.MapFS{"overview.md": {Data: []byte(testOverview)}}
+
+		// Act
+		report, err := docsync.SyncFS(context.Background(), client, fsys, docsync.Options{Category: "documentation"})
+
+		// Assert
+		assert.NoError(t, errors.Join(createErr, err, report.Err()), "it does not return an error")
+		assert.Equal(t, []docsync.Action{docsync.ActionUnchanged}, actions(report), "it doesn't update the doc")
+	})
+
+	t.Run("when ReadMe generates a different slug", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t)
+		fsys := fstest.MapFS{"intro.md": {Data: []byte(testOverview)}}
+
+		// Act
+		report, err := docsync.SyncFS(context.Background(), client, fsys, docsync.Options{
+			Category: "documentation",
+			Prune:    true,
+		})
+		docs, _, docsErr := client.Category.GetDocs("documentation")
+
+		// Assert
+		assert.NoError(t, errors.Join(err, docsErr), "it does not return an error")
+		assert.Equal(t, []docsync.Action{docsync.ActionFailed}, actions(report), "it reports the file as failed")
+		assert.ErrorContains(t, report.Err(), "ReadMe created doc 'intro' with the slug 'overview'",
+			"it returns an error for the slug")
+		assert.Equal(t, "overview", report.Results[0].Slug, "it returns the slug of the created doc")
+		assert.Len(t, docs, 1, "it doesn't prune the created doc")
+	})
+
+	t.Run("when docs are pruned", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t)
+		stale, _, _ := client.Doc.Create(readme.DocParams{Title: "Stale", CategorySlug: "documentation"})
+		_, _, _ = client.Doc.Create(readme.DocParams{Title: "Other", CategorySlug: "documentation"})
+		_, _, _ = client.Doc.Create(readme.DocParams{
+			Title:         "Stale Child",
+			CategorySlug:  "documentation",
+			ParentDocSlug: stale.Slug,
+		})
+		fsys := fstest.MapFS{
+			"overview.md": {Data: []byte(testOverview)},
+			"other.md":    {Data: []byte("---\ntitle: Other\n---\n")},
+		}
+
+		// Act
+		report, err := docsync.SyncFS(context.Background(), client, fsys, docsync.Options{
+			Category: "documentation",
+			Prune:    true,
+		})
+		docs, _, docsErr := client.Category.GetDocs("documentation")
+
+		// Assert
+		assert.NoError(t, errors.Join(err, report.Err(), docsErr), "it does not return an error")
+		assert.Equal(t, []docsync.Result{
+			{Slug: "stale-child", Action: docsync.ActionDeleted},
+			{Slug: "stale", Action: docsync.ActionDeleted},
+		}, report.Results[2:], "it deletes the docs without a file, children first")
+		assert.Len(t, docs, 2, "it keeps the docs with a file")
+	})
+
+	t.Run("when files can't be synced", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t)
+		fsys := fstest.MapFS{
+			"a.md":         {Data: []byte("---\ntitle: A\nparentDocSlug: b\n---\n")},
+			"b.md":         {Data: []byte("---\ntitle: B\nparentDocSlug: a\n---\n")},
+			"broken.md":    {Data: []byte("# Broken\n")},
+			"guides/c.md":  {Data: []byte("---\ntitle: C\n---\n")},
+			"other/c.md":   {Data: []byte("---\ntitle: C\n---\n")},
+			"reference.md": {Data: []byte("---\ntitle: Reference\ncategorySlug: reference\n---\n")},
+		}
+
+		// Act
+		report, err := docsync.SyncFS(context.Background(), client, fsys, docsync.Options{Category: "documentation"})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, []docsync.Action{
+			docsync.ActionFailed,
+			docsync.ActionFailed,
+			docsync.ActionFailed,
+			docsync.ActionCreated,
+			docsync.ActionFailed,
+			docsync.ActionFailed,
+		}, actions(report), "it reports the files that failed")
+		assert.ErrorIs(t, report.Err(), readme.ErrNotFound, "it returns the API errors")
+		assert.ErrorContains(t, report.Err(), "form a cycle", "it returns an error for cyclic parent docs")
+		assert.ErrorContains(t, report.Err(), "another file has the slug 'c'",
+			"it returns an error for duplicate slugs")
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		fsys := fstest.MapFS{"overview.md": {Data: []byte(testOverview)}}
+
+		// Act
+		_, err := docsync.SyncFS(ctx, client, fsys, docsync.Options{Category: "documentation"})
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled, "it returns the context's error")
+	})
+}
+
+// actions returns the action of every result in a report.
+func actions(report *docsync.Report) []docsync.Action {
+	var actions []docsync.Action
+	for _, result := range report.Results {
+		actions = append(actions, result.Action)
+	}
+
+	return actions
+}
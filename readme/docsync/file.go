@@ -0,0 +1,216 @@
+package docsync
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"gopkg.in/yaml.v3"
+)
+
+// frontMatterDelimiter is the line that starts and ends the front matter of a file.
+const frontMatterDelimiter = "---"
+
+// FrontMatter is the YAML front matter at the top of a markdown file, between two "---" lines:
+//
+//	---
+//	title: Getting Started
+//	categorySlug: documentation
+//	parentDocSlug: overview
+//	order: 10
+//	hidden: false
+//	type: basic
+//	excerpt: Set up the project.
+//	metadata:
+//	  title: Getting Started with the Project
+//	  description: How to set up the project.
+//	---
+//
+// Only the title is required. The fields that aren't set aren't sent to the API, so they keep
+// their current values when a doc is updated and their API defaults when it's created.
+type FrontMatter struct {
+	// ID is the ID of the doc in ReadMe, which is set by exports. It's ignored when syncing.
+	ID string `yaml:"id,omitempty"`
+	// Title is the title of the doc.
+	Title string `yaml:"title,omitempty"`
+	// CategorySlug is the slug of the doc's category. Options.Category is used when this is empty.
+	CategorySlug string `yaml:"categorySlug,omitempty"`
+	// ParentDocSlug is the slug of the doc's parent doc.
+	ParentDocSlug string `yaml:"parentDocSlug,omitempty"`
+	// Order is the position of the doc in the sidebar.
+	Order *int `yaml:"order,omitempty"`
+	// Hidden toggles the visibility of the doc.
+	Hidden *bool `yaml:"hidden,omitempty"`
+	// Type is the type of the doc, such as "basic", "error" or "link".
+	Type string `yaml:"type,omitempty"`
+	// Excerpt is a short summary of the doc.
+	Excerpt string `yaml:"excerpt,omitempty"`
+	// Metadata is the title and description of the doc used by search engines and link previews.
+	Metadata *readme.DocMetadataParams `yaml:"metadata,omitempty"`
+}
+
+// File is a markdown file with YAML front matter that describes a doc.
+type File struct {
+	// Path is the slash-separated path of the file, relative to the synced directory.
+	Path string
+	// FrontMatter is the front matter of the file.
+	FrontMatter FrontMatter
+	// Body is the markdown after the front matter, without leading and trailing whitespace.
+	Body string
+}
+
+// content is the part of a doc that's compared to decide whether it changed.
+type content struct {
+	Title         string                    `json:"title"`
+	Body          string                    `json:"body,omitempty"`
+	CategorySlug  string                    `json:"categorySlug"`
+	ParentDocSlug string                    `json:"parentDocSlug,omitempty"`
+	Order         *int                      `json:"order,omitempty"`
+	Hidden        *bool                     `json:"hidden,omitempty"`
+	Type          string                    `json:"type,omitempty"`
+	Excerpt       string                    `json:"excerpt,omitempty"`
+	Metadata      *readme.DocMetadataParams `json:"metadata,omitempty"`
+}
+
+// ParseFile parses a markdown file with YAML front matter. The path is used for the slug and in
+// error messages.
+func ParseFile(filePath string, data []byte) (*File, error) {
+	lines := strings.SplitAfter(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	if strings.TrimSpace(lines[0]) != frontMatterDelimiter {
+		return nil, fmt.Errorf("%s: the file doesn't start with front matter", filePath)
+	}
+
+	end := -1
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == frontMatterDelimiter {
+			end = i
+
+			break
+		}
+	}
+	if end < 0 {
+		return nil, fmt.Errorf("%s: the front matter isn't closed with '%s'", filePath, frontMatterDelimiter)
+	}
+
+	file := &File{
+		Path: filePath,
+		Body: strings.TrimSpace(strings.Join(lines[end+1:], "")),
+	}
+
+	decoder := yaml.NewDecoder(strings.NewReader(strings.Join(lines[1:end], "")))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file.FrontMatter); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%s: invalid front matter: %w", filePath, err)
+	}
+
+	if file.FrontMatter.Title == "" {
+		return nil, fmt.Errorf("%s: the front matter doesn't set a title", filePath)
+	}
+
+	return file, nil
+}
+
+// Slug returns the slug of the doc, which is the name of the file without its extension.
+func (f *File) Slug() string {
+	return fileSlug(f.Path)
+}
+
+// Params returns the parameters to create or update the doc.
+func (f *File) Params() readme.DocParams {
+	return readme.DocParams{
+		Body:          f.Body,
+		CategorySlug:  f.FrontMatter.CategorySlug,
+		Excerpt:       f.FrontMatter.Excerpt,
+		Hidden:        f.FrontMatter.Hidden,
+		Metadata:      f.FrontMatter.Metadata,
+		Order:         f.FrontMatter.Order,
+		ParentDocSlug: f.FrontMatter.ParentDocSlug,
+		Title:         f.FrontMatter.Title,
+		Type:          f.FrontMatter.Type,
+	}
+}
+
+// Hash returns the hex-encoded SHA-256 hash of the doc's content, which changes when the body or a
+// field set in the front matter changes.
+func (f *File) Hash() string {
+	return hash(f.content())
+}
+
+// content returns the content of the doc.
+func (f *File) content() content {
+	return content{
+		Title:         f.FrontMatter.Title,
+		Body:          f.Body,
+		CategorySlug:  f.FrontMatter.CategorySlug,
+		ParentDocSlug: f.FrontMatter.ParentDocSlug,
+		Order:         f.FrontMatter.Order,
+		Hidden:        f.FrontMatter.Hidden,
+		Type:          f.FrontMatter.Type,
+		Excerpt:       f.FrontMatter.Excerpt,
+		Metadata:      f.FrontMatter.Metadata,
+	}
+}
+
+// remoteContent returns the content of a doc in ReadMe, limited to the fields set in the file so
+// both hash the same when the doc is up to date. The category and parent doc are replaced with the
+// file's slugs when their IDs match the IDs of the file's category and parent doc.
+func (f *File) remoteContent(doc readme.Doc, categoryID, parentDocID string) content {
+	local := f.content()
+	remote := content{
+		Title:         doc.Title,
+		CategorySlug:  doc.Category,
+		ParentDocSlug: doc.ParentDoc,
+	}
+
+	if doc.Category == categoryID {
+		remote.CategorySlug = local.CategorySlug
+	}
+	if local.ParentDocSlug == "" || doc.ParentDoc == parentDocID {
+		remote.ParentDocSlug = local.ParentDocSlug
+	}
+	if local.Body != "" {
+		remote.Body = strings.TrimSpace(doc.Body)
+	}
+	if local.Order != nil {
+		remote.Order = &doc.Order
+	}
+	if local.Hidden != nil {
+		remote.Hidden = &doc.Hidden
+	}
+	if local.Type != "" {
+		remote.Type = doc.Type
+	}
+	if local.Excerpt != "" {
+		remote.Excerpt = doc.Excerpt
+	}
+	if local.Metadata != nil {
+		remote.Metadata = &readme.DocMetadataParams{
+			Description: doc.Metadata.Description,
+			Title:       doc.Metadata.Title,
+		}
+	}
+
+	return remote
+}
+
+// hash returns the hex-encoded SHA-256 hash of the JSON encoding of a doc's content.
+func hash(c content) string {
+	// The content only has strings, numbers and booleans, so it always encodes.
+	encoded, _ := json.Marshal(c)
+	sum := sha256.Sum256(encoded)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// fileSlug returns the name of a file without its directory and extension.
+func fileSlug(filePath string) string {
+	name := path.Base(filePath)
+
+	return strings.TrimSuffix(name, path.Ext(name))
+}
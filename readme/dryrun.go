@@ -0,0 +1,140 @@
+package readme
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// dryRunBody is the body of the synthetic responses returned for planned operations.
+const dryRunBody = "{}"
+
+// dryRunBodies lists the bodies of the synthetic responses for operations that don't respond with a
+// JSON object.
+var dryRunBodies = map[operation]string{
+	{service: "Image", name: "Upload"}: `["", "", 0, 0, ""]`,
+}
+
+// readOnlyOperations lists the operations that use a POST request without changing anything, which
+// are sent to the API in dry-run mode.
+var readOnlyOperations = map[operation]bool{
+	{service: "Doc", name: "Search"}: true,
+}
+
+// DryRun records the POST, PUT and DELETE requests made by a Client as planned operations instead of
+// sending them to the API. GET requests and doc searches are still sent, so operations that look up
+// slugs, IDs and versions keep working.
+//
+// A planned request returns a synthetic response with the first of the request's expected status
+// codes and an empty body, such as an empty JSON object, so the structs returned by operations such
+// as Category.Create() are empty.
+//
+// A DryRun may be shared between clients and is safe for concurrent use.
+type DryRun struct {
+	mu         sync.Mutex
+	operations []PlannedOperation
+}
+
+// PlannedOperation is a request that would have been sent to the API if dry-run mode wasn't enabled.
+type PlannedOperation struct {
+	// Operation is the service method that made the request, such as "Category.Create".
+	Operation string `json:"operation,omitempty"`
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+	// Endpoint is the API endpoint of the request, or the full URL if the request doesn't have an
+	// endpoint.
+	Endpoint string `json:"endpoint"`
+	// Version is the project version the request applies to, if any.
+	Version string `json:"version,omitempty"`
+	// ContentType is the value of the request's Content-Type header, if any.
+	ContentType string `json:"contentType,omitempty"`
+	// Payload is the decoded JSON payload of the request. It's nil when the request doesn't have a
+	// payload or the payload isn't JSON, such as a multipart upload.
+	Payload interface{} `json:"payload,omitempty"`
+}
+
+// NewDryRun returns a DryRun without any planned operations.
+func NewDryRun() *DryRun {
+	return &DryRun{}
+}
+
+// Operations returns the planned operations in the order they were requested.
+func (d *DryRun) Operations() []PlannedOperation {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return append([]PlannedOperation(nil), d.operations...)
+}
+
+// Reset forgets the planned operations.
+func (d *DryRun) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.operations = nil
+}
+
+// plan records a planned operation.
+func (d *DryRun) plan(operation PlannedOperation) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.operations = append(d.operations, operation)
+}
+
+// dryRun is the Handler middleware that records mutating requests as planned operations and returns
+// synthetic responses for them.
+func (c *Client) dryRun(next Handler) Handler {
+	return func(ctx context.Context, request *APIRequest, httpRequest *http.Request) (*APIResponse, error) {
+		op := operationFromContext(ctx)
+		if httpRequest.Method == http.MethodGet || httpRequest.Method == http.MethodHead || readOnlyOperations[op] {
+			return next(ctx, request, httpRequest)
+		}
+
+		operation := PlannedOperation{
+			Method:      httpRequest.Method,
+			Endpoint:    request.Endpoint,
+			Version:     httpRequest.Header.Get("x-readme-version"),
+			ContentType: httpRequest.Header.Get("Content-Type"),
+		}
+		if op.service != "" {
+			operation.Operation = op.service + "." + op.name
+		}
+		if operation.Endpoint == "" {
+			operation.Endpoint = httpRequest.URL.String()
+		}
+		if len(request.Payload) > 0 && json.Valid(request.Payload) {
+			if err := json.Unmarshal(request.Payload, &operation.Payload); err != nil {
+				return nil, fmt.Errorf("unable to decode planned payload: %w", err)
+			}
+		}
+		c.DryRun.plan(operation)
+
+		body := dryRunBody
+		if operationBody, ok := dryRunBodies[op]; ok {
+			body = operationBody
+		}
+
+		statusCode := http.StatusOK
+		if len(request.OkStatusCode) > 0 {
+			statusCode = request.OkStatusCode[0]
+		}
+
+		return &APIResponse{
+			Body: []byte(body),
+			HTTPResponse: &http.Response{
+				Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+				StatusCode:    statusCode,
+				Header:        http.Header{"Content-Type": []string{"application/json"}},
+				Body:          io.NopCloser(strings.NewReader(body)),
+				ContentLength: int64(len(body)),
+				Request:       httpRequest,
+			},
+			Request: request,
+		}, nil
+	}
+}
@@ -0,0 +1,101 @@
+package readme_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Client_DryRun(t *testing.T) {
+	t.Run("when mutating operations are requested", func(t *testing.T) {
+		// Arrange
+		dryRun := readme.NewDryRun()
+		client, _ := readme.NewClient("test", readme.WithAPIURL(TestClientBaseURL), readme.WithDryRun(dryRun))
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint + "/" + testdata.Categories[0].Slug).
+			Reply(200).
+			JSON(testdata.Categories[0])
+		defer gock.Off()
+
+		// Act
+		category, _, getErr := client.Category.Get(testdata.Categories[0].Slug)
+		_, createErr := client.Category.Create(&readme.CategorySaved{},
+			readme.CategoryParams{Title: "Test", Type: "guide"}, readme.RequestOptions{Version: "1.0"})
+		_, deleteResponse, deleteErr := client.Category.Delete(category.Slug)
+
+		// Assert
+		assert.NoError(t, getErr, "it does not return an error")
+		assert.NoError(t, createErr, "it does not return an error")
+		assert.NoError(t, deleteErr, "it does not return an error")
+		assert.Equal(t, testdata.Categories[0], category, "it sends GET requests")
+		assert.True(t, gock.IsDone(), "it sends GET requests")
+		assert.False(t, gock.HasUnmatchedRequest(), "it doesn't send mutating requests")
+		assert.Equal(t, 204, deleteResponse.HTTPResponse.StatusCode, "it returns a synthetic response")
+		assert.Equal(t, []readme.PlannedOperation{
+			{
+				Operation:   "Category.Create",
+				Method:      "POST",
+				Endpoint:    readme.CategoryEndpoint,
+				Version:     "1.0",
+				ContentType: "application/json",
+				Payload:     map[string]interface{}{"title": "Test", "type": "guide"},
+			},
+			{
+				Operation: "Category.Delete",
+				Method:    "DELETE",
+				Endpoint:  readme.CategoryEndpoint + "/" + testdata.Categories[0].Slug,
+			},
+		}, dryRun.Operations(), "it records the planned operations")
+
+		dryRun.Reset()
+		assert.Empty(t, dryRun.Operations(), "it forgets the planned operations")
+	})
+
+	t.Run("when docs are searched", func(t *testing.T) {
+		// Arrange
+		dryRun := readme.NewDryRun()
+		client, _ := readme.NewClient("test", readme.WithAPIURL(TestClientBaseURL), readme.WithDryRun(dryRun))
+		gock.New(TestClient.APIURL).
+			Post(readme.DocEndpoint + "/search").
+			Reply(200).
+			JSON(testdata.DocSearchResults)
+		defer gock.Off()
+
+		// Act
+		results, _, err := client.Doc.Search("test")
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, testdata.DocSearchResults.Results, results, "it sends the search request")
+		assert.Empty(t, dryRun.Operations(), "it doesn't plan the search")
+	})
+
+	t.Run("when an image is uploaded", func(t *testing.T) {
+		// Arrange
+		dryRun := readme.NewDryRun()
+		client, _ := readme.NewClient("test", readme.WithDryRun(dryRun))
+		source, _ := base64.RawStdEncoding.DecodeString(
+			"iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNk+A8AAQUBAScY42YAAAAASUVORK5CYII")
+
+		// Act
+		_, _, err := client.Image.Upload(source, "test.png")
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Len(t, dryRun.Operations(), 1, "it records the planned upload")
+		assert.Contains(t, dryRun.Operations()[0].ContentType, "multipart/form-data", "it records the content type")
+		assert.Nil(t, dryRun.Operations()[0].Payload, "it doesn't decode the form data")
+	})
+
+	t.Run("when the dry run is nil", func(t *testing.T) {
+		// Act
+		_, err := readme.NewClient("test", readme.WithDryRun(nil))
+
+		// Assert
+		assert.ErrorContains(t, err, "dry run must not be nil", "it returns an error")
+	})
+}
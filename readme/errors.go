@@ -0,0 +1,88 @@
+package readme
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors for classifying API errors with errors.Is().
+//
+// An *APIError matches the sentinel that corresponds to its HTTP status code:
+//
+//	_, _, err := client.Doc.Get("missing-doc")
+//	if errors.Is(err, readme.ErrNotFound) {
+//		// Create the doc...
+//	}
+var (
+	// ErrNotFound matches API errors with a 404 status code.
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthorized matches API errors with a 401 or 403 status code.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrRateLimited matches API errors with a 429 status code.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrValidation matches API errors with a 400 or 422 status code.
+	ErrValidation = errors.New("validation failed")
+	// ErrServer matches API errors with a 5xx status code.
+	ErrServer = errors.New("server error")
+)
+
+// APIError is the error returned when the ReadMe API responds with an unexpected status code.
+//
+// Use errors.As() to retrieve the details of the error:
+//
+//	var apiErr *readme.APIError
+//	if errors.As(err, &apiErr) {
+//		fmt.Println(apiErr.StatusCode, apiErr.Response.Error, apiErr.Response.Suggestion)
+//	}
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Method is the HTTP method of the request.
+	Method string
+	// Endpoint is the API endpoint of the request.
+	Endpoint string
+	// RequestID is the unique identifier of the request from the x-request-id response header, if
+	// set.
+	RequestID string
+	// Response is the structured error decoded from the response body.
+	// The fields are empty if the body isn't a ReadMe API error, such as an HTML page returned by
+	// a gateway.
+	Response APIErrorResponse
+	// Body is the raw response body.
+	Body []byte
+}
+
+// Error returns the error message, including the status code, request and response body.
+//
+// The request ID and the ReadMe Metrics log URL from the error response are included when they're
+// set so the failing request can be referenced in support requests.
+func (e *APIError) Error() string {
+	message := fmt.Sprintf("ReadMe API Error: %v on %s %s: %s", e.StatusCode, e.Method, e.Endpoint, e.Body)
+	if e.RequestID != "" {
+		message += fmt.Sprintf(" (request ID: %s)", e.RequestID)
+	}
+	if e.Response.Docs != "" {
+		message += fmt.Sprintf(" (docs: %s)", e.Response.Docs)
+	}
+
+	return message
+}
+
+// Is reports whether the error matches one of the sentinel errors based on its status code.
+func (e *APIError) Is(target error) bool {
+	switch target { //nolint:errorlint // Sentinel errors are compared directly.
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	case ErrServer:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+
+	return false
+}
@@ -0,0 +1,124 @@
+package readme_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_APIError(t *testing.T) {
+	t.Run("when the API responds with a ReadMe error", func(t *testing.T) {
+		// Arrange
+		expect := readme.APIErrorResponse{
+			Docs:       "https://docs.readme.com/logs/6883d0ee-cf79-447a-826f-a48f7d5bdf5f",
+			Error:      "DOC_NOTFOUND",
+			Message:    "The doc with the slug 'missing' couldn't be found",
+			Suggestion: "Make sure you're using the correct slug.",
+		}
+		gock.New(TestClient.APIURL).
+			Get(readme.DocEndpoint+"/missing").
+			Reply(404).
+			SetHeader("x-request-id", "req-123").
+			JSON(expect)
+		defer gock.Off()
+
+		// Act
+		_, apiResponse, err := TestClient.Doc.Get("missing")
+
+		// Assert
+		var apiErr *readme.APIError
+		assert.True(t, errors.As(err, &apiErr), "it returns an *APIError")
+		assert.Equal(t, 404, apiErr.StatusCode, "it includes the status code")
+		assert.Equal(t, "GET", apiErr.Method, "it includes the request method")
+		assert.Equal(t, readme.DocEndpoint+"/missing", apiErr.Endpoint, "it includes the endpoint")
+		assert.Equal(t, expect, apiErr.Response, "it includes the decoded error response")
+		assert.Equal(t, "req-123", apiErr.RequestID, "it includes the request ID")
+		assert.ErrorContains(t, err, "(request ID: req-123) (docs: "+expect.Docs+")",
+			"it includes the request ID and docs URL in the message")
+		assert.Equal(t, "req-123", apiResponse.RequestID, "it returns the request ID")
+		assert.Equal(t, "6883d0ee-cf79-447a-826f-a48f7d5bdf5f", apiResponse.LogID, "it returns the log ID")
+		assert.ErrorIs(t, err, readme.ErrNotFound, "it matches ErrNotFound")
+		assert.NotErrorIs(t, err, readme.ErrUnauthorized, "it doesn't match other sentinels")
+	})
+
+	t.Run("when the API responds with an HTML page", func(t *testing.T) {
+		// Arrange
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(502).
+			BodyString("<html><body>Bad Gateway</body></html>")
+		defer gock.Off()
+
+		// Act
+		_, apiResponse, err := TestClient.Project.Get()
+
+		// Assert
+		var apiErr *readme.APIError
+		assert.True(t, errors.As(err, &apiErr), "it returns an *APIError")
+		assert.Equal(t, 502, apiErr.StatusCode, "it includes the status code")
+		assert.Equal(t, readme.APIErrorResponse{}, apiErr.Response, "it has an empty error response")
+		assert.Contains(t, string(apiErr.Body), "Bad Gateway", "it includes the raw body")
+		assert.ErrorIs(t, err, readme.ErrServer, "it matches ErrServer")
+		assert.Equal(t, 502, apiResponse.HTTPResponse.StatusCode, "it returns the API response")
+		assert.NotContains(t, err.Error(), "request ID", "it doesn't include an empty request ID")
+		assert.Empty(t, apiResponse.LogID, "it doesn't return a log ID")
+	})
+
+	t.Run("when an error occurs while paginating", func(t *testing.T) {
+		// Arrange
+		gock.New(TestClient.APIURL).
+			Get(readme.ChangelogEndpoint).
+			Reply(401).
+			JSON(readme.APIErrorResponse{Error: "APIKEY_NOTFOUND"})
+		defer gock.Off()
+
+		// Act
+		_, _, err := TestClient.Changelog.GetAll()
+
+		// Assert
+		var apiErr *readme.APIError
+		assert.True(t, errors.As(err, &apiErr), "it returns a wrapped *APIError")
+		assert.Equal(t, "APIKEY_NOTFOUND", apiErr.Response.Error, "it includes the error code")
+		assert.ErrorIs(t, err, readme.ErrUnauthorized, "it matches ErrUnauthorized")
+	})
+}
+
+func Test_APIError_Is(t *testing.T) {
+	testCases := []struct {
+		status int
+		expect error
+	}{
+		{400, readme.ErrValidation},
+		{401, readme.ErrUnauthorized},
+		{403, readme.ErrUnauthorized},
+		{404, readme.ErrNotFound},
+		{422, readme.ErrValidation},
+		{429, readme.ErrRateLimited},
+		{500, readme.ErrServer},
+		{503, readme.ErrServer},
+	}
+	sentinels := []error{
+		readme.ErrNotFound,
+		readme.ErrUnauthorized,
+		readme.ErrRateLimited,
+		readme.ErrValidation,
+		readme.ErrServer,
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("when status is %d", tc.status), func(t *testing.T) {
+			// Arrange
+			err := fmt.Errorf("wrapped: %w", &readme.APIError{StatusCode: tc.status})
+
+			// Assert
+			for _, sentinel := range sentinels {
+				assert.Equal(t, sentinel == tc.expect, errors.Is(err, sentinel),
+					"it only matches the expected sentinel")
+			}
+		})
+	}
+}
@@ -0,0 +1,374 @@
+// Package export writes the content of a ReadMe project to a directory of markdown files with YAML
+// front matter, so it can be committed to a repository and imported again.
+//
+// The directory has the following layout, where the docs of a category are nested under the
+// directories of their parent docs:
+//
+//	changelogs/<changelog>.md
+//	custom-pages/<custom page>.md
+//	versions/<version>/version.yaml
+//	versions/<version>/<category>/category.yaml
+//	versions/<version>/<category>/<doc>.md
+//	versions/<version>/<category>/<doc>/<child doc>.md
+//
+// The front matter of the docs is a docsync.FrontMatter, so the docs of a version can be imported
+// again by syncing its directory with the docsync package.
+//
+// Export a project to a directory:
+//
+//	files, err := export.Export(ctx, client, "project", export.Options{})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	fmt.Printf("exported %d files\n", len(files))
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/readme/docsync"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ChangelogsDir is the directory of the exported changelogs.
+	ChangelogsDir = "changelogs"
+	// CustomPagesDir is the directory of the exported custom pages.
+	CustomPagesDir = "custom-pages"
+	// VersionsDir is the directory of the exported versions.
+	VersionsDir = "versions"
+	// VersionFileName is the name of the file that describes a version in its directory.
+	VersionFileName = "version.yaml"
+	// CategoryFileName is the name of the file that describes a category in its directory.
+	CategoryFileName = "category.yaml"
+)
+
+// Options configures an export.
+type Options struct {
+	// Versions is the names of the versions to export. Every version is exported when this is
+	// empty.
+	Versions []string
+}
+
+// File is an exported file.
+type File struct {
+	// Path is the slash-separated path of the file, relative to the export directory.
+	Path string
+	// Data is the content of the file.
+	Data []byte
+}
+
+// Version is the content of a version's version.yaml file.
+type Version struct {
+	ID           string `yaml:"id"`
+	Version      string `yaml:"version"`
+	Codename     string `yaml:"codename,omitempty"`
+	ForkedFrom   string `yaml:"forkedFrom,omitempty"`
+	IsBeta       bool   `yaml:"isBeta"`
+	IsDeprecated bool   `yaml:"isDeprecated"`
+	IsHidden     bool   `yaml:"isHidden"`
+	IsStable     bool   `yaml:"isStable"`
+}
+
+// Category is the content of a category's category.yaml file.
+type Category struct {
+	ID    string `yaml:"id"`
+	Title string `yaml:"title"`
+	Slug  string `yaml:"slug"`
+	Type  string `yaml:"type"`
+	Order int    `yaml:"order"`
+}
+
+// ChangelogFrontMatter is the front matter of an exported changelog.
+type ChangelogFrontMatter struct {
+	ID        string                    `yaml:"id"`
+	Title     string                    `yaml:"title"`
+	Slug      string                    `yaml:"slug"`
+	Type      string                    `yaml:"type,omitempty"`
+	Hidden    bool                      `yaml:"hidden"`
+	CreatedAt string                    `yaml:"createdAt,omitempty"`
+	Metadata  *readme.DocMetadataParams `yaml:"metadata,omitempty"`
+}
+
+// CustomPageFrontMatter is the front matter of an exported custom page. The body of a custom page
+// in HTML mode is its HTML.
+type CustomPageFrontMatter struct {
+	ID         string                    `yaml:"id"`
+	Title      string                    `yaml:"title"`
+	Slug       string                    `yaml:"slug"`
+	Hidden     bool                      `yaml:"hidden"`
+	HTMLMode   bool                      `yaml:"htmlmode"`
+	Fullscreen bool                      `yaml:"fullscreen"`
+	Metadata   *readme.DocMetadataParams `yaml:"metadata,omitempty"`
+}
+
+// Export writes the content of a project to a directory and returns the written files. Existing
+// files are overwritten, but files that aren't part of the export are left in place.
+func Export(ctx context.Context, client *readme.Client, dir string, options Options) ([]File, error) {
+	files, err := Files(ctx, client, options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		name := filepath.Join(dir, filepath.FromSlash(file.Path))
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil { //nolint:gosec // Exports are shared.
+			return nil, fmt.Errorf("unable to export %s: %w", file.Path, err)
+		}
+		if err := os.WriteFile(name, file.Data, 0o644); err != nil { //nolint:gosec // Exports are shared.
+			return nil, fmt.Errorf("unable to export %s: %w", file.Path, err)
+		}
+	}
+
+	return files, nil
+}
+
+// Files returns the files of a project's export without writing them. Versions are sorted by name
+// and their categories by order, followed by the changelogs and the custom pages.
+func Files(ctx context.Context, client *readme.Client, options Options) ([]File, error) {
+	exporter := &exporter{client: client}
+
+	versions, _, err := client.Version.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to export versions: %w", err)
+	}
+	slices.SortFunc(versions, func(a, b readme.VersionSummary) int {
+		return strings.Compare(a.Version, b.Version)
+	})
+
+	for _, name := range options.Versions {
+		if !slices.ContainsFunc(versions, func(v readme.VersionSummary) bool { return v.Version == name }) {
+			return nil, fmt.Errorf("unable to export version '%s': %w", name, readme.ErrNotFound)
+		}
+	}
+
+	for _, v := range versions {
+		if len(options.Versions) > 0 && !slices.Contains(options.Versions, v.Version) {
+			continue
+		}
+
+		if err := exporter.version(ctx, v); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := exporter.changelogs(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := exporter.customPages(ctx); err != nil {
+		return nil, err
+	}
+
+	return exporter.files, nil
+}
+
+// exporter collects the files of an export.
+type exporter struct {
+	client *readme.Client
+	files  []File
+}
+
+// add adds a file with YAML content, or with YAML front matter followed by a markdown body when
+// the file has the ".md" extension.
+func (e *exporter) add(filePath string, content any, body string) error {
+	var buf bytes.Buffer
+	isMarkdown := path.Ext(filePath) == ".md"
+	if isMarkdown {
+		buf.WriteString("---\n")
+	}
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(content); err != nil {
+		return fmt.Errorf("unable to export %s: %w", filePath, err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("unable to export %s: %w", filePath, err)
+	}
+
+	if isMarkdown {
+		buf.WriteString("---\n")
+		if body = strings.TrimSpace(body); body != "" {
+			buf.WriteString("\n" + body + "\n")
+		}
+	}
+
+	e.files = append(e.files, File{Path: filePath, Data: buf.Bytes()})
+
+	return nil
+}
+
+// version adds the files of a version and its categories.
+func (e *exporter) version(ctx context.Context, v readme.VersionSummary) error {
+	dir := path.Join(VersionsDir, v.Version)
+	options := readme.RequestOptions{Version: v.Version}
+
+	err := e.add(path.Join(dir, VersionFileName), Version{
+		ID:           v.ID,
+		Version:      v.Version,
+		Codename:     v.Codename,
+		ForkedFrom:   v.ForkedFrom,
+		IsBeta:       v.IsBeta,
+		IsDeprecated: v.IsDeprecated,
+		IsHidden:     v.IsHidden,
+		IsStable:     v.IsStable,
+	}, "")
+	if err != nil {
+		return err
+	}
+
+	categories, _, err := e.client.Category.GetAllWithContext(ctx, options)
+	if err != nil {
+		return fmt.Errorf("unable to export the categories of version '%s': %w", v.Version, err)
+	}
+	slices.SortStableFunc(categories, func(a, b readme.Category) int {
+		return a.Order - b.Order
+	})
+
+	for _, category := range categories {
+		if err := e.category(ctx, dir, category, options); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// category adds the files of a category and its docs.
+func (e *exporter) category(
+	ctx context.Context,
+	dir string,
+	category readme.Category,
+	options readme.RequestOptions,
+) error {
+	dir = path.Join(dir, category.Slug)
+
+	err := e.add(path.Join(dir, CategoryFileName), Category{
+		ID:    category.ID,
+		Title: category.Title,
+		Slug:  category.Slug,
+		Type:  category.Type,
+		Order: category.Order,
+	}, "")
+	if err != nil {
+		return err
+	}
+
+	docs, _, err := e.client.Category.GetDocsWithContext(ctx, category.Slug, options)
+	if err != nil {
+		return fmt.Errorf("unable to export the docs of category '%s': %w", category.Slug, err)
+	}
+
+	return e.docs(ctx, dir, category.Slug, "", docs, options)
+}
+
+// docs adds the files of docs and their children.
+func (e *exporter) docs(
+	ctx context.Context,
+	dir, categorySlug, parentDocSlug string,
+	docs []readme.CategoryDocs,
+	options readme.RequestOptions,
+) error {
+	for _, summary := range docs {
+		doc, _, err := e.client.Doc.GetWithContext(ctx, summary.Slug, options)
+		if err != nil {
+			return fmt.Errorf("unable to export doc '%s': %w", summary.Slug, err)
+		}
+
+		err = e.add(path.Join(dir, doc.Slug+".md"), docsync.FrontMatter{
+			ID:            doc.ID,
+			Title:         doc.Title,
+			CategorySlug:  categorySlug,
+			ParentDocSlug: parentDocSlug,
+			Order:         &doc.Order,
+			Hidden:        &doc.Hidden,
+			Type:          doc.Type,
+			Excerpt:       doc.Excerpt,
+			Metadata:      metadata(doc.Metadata),
+		}, doc.Body)
+		if err != nil {
+			return err
+		}
+
+		err = e.docs(ctx, path.Join(dir, doc.Slug), categorySlug, doc.Slug, summary.Children, options)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// changelogs adds the files of the changelogs.
+func (e *exporter) changelogs(ctx context.Context) error {
+	changelogs, _, err := e.client.Changelog.GetAllWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to export changelogs: %w", err)
+	}
+
+	for _, changelog := range changelogs {
+		err := e.add(path.Join(ChangelogsDir, changelog.Slug+".md"), ChangelogFrontMatter{
+			ID:        changelog.ID,
+			Title:     changelog.Title,
+			Slug:      changelog.Slug,
+			Type:      changelog.Type,
+			Hidden:    changelog.Hidden,
+			CreatedAt: changelog.CreatedAt,
+			Metadata:  metadata(changelog.Metadata),
+		}, changelog.Body)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// customPages adds the files of the custom pages.
+func (e *exporter) customPages(ctx context.Context) error {
+	pages, _, err := e.client.CustomPage.GetAllWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to export custom pages: %w", err)
+	}
+
+	for _, page := range pages {
+		body := page.Body
+		if page.HTMLMode {
+			body = page.HTML
+		}
+
+		err := e.add(path.Join(CustomPagesDir, page.Slug+".md"), CustomPageFrontMatter{
+			ID:         page.ID,
+			Title:      page.Title,
+			Slug:       page.Slug,
+			Hidden:     page.Hidden,
+			HTMLMode:   page.HTMLMode,
+			Fullscreen: page.Fullscreen,
+			Metadata:   metadata(page.Metadata),
+		}, body)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// metadata returns the title and description of a page's metadata, or nil when neither is set.
+func metadata(m readme.DocMetadata) *readme.DocMetadataParams {
+	if m.Title == "" && m.Description == "" {
+		return nil
+	}
+
+	return &readme.DocMetadataParams{Description: m.Description, Title: m.Title}
+}
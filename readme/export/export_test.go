@@ -0,0 +1,148 @@
+package export_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/readme/docsync"
+	"github.com/liveoaklabs/readme-api-go-client/readme/export"
+	"github.com/liveoaklabs/readme-api-go-client/tests/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient returns a client backed by fake services with a category with a parent and a child
+// doc, a changelog and a custom page.
+func newTestClient(t *testing.T) *readme.Client {
+	t.Helper()
+
+	client, _ := mocks.NewFake(t)
+	hidden := false
+	order := 2
+
+	_, categoryErr := client.Category.Create(&readme.CategorySaved{},
+		readme.CategoryParams{Title: "Documentation", Type: "guide"})
+	_, _, parentErr := client.Doc.Create(readme.DocParams{
+		Title:        "Overview",
+		Body:         "Welcome to the docs.",
+		CategorySlug: "documentation",
+		Hidden:       &hidden,
+	})
+	_, _, childErr := client.Doc.Create(readme.DocParams{
+		Title:         "Installation",
+		Body:          "Run the installer.\n",
+		CategorySlug:  "documentation",
+		ParentDocSlug: "overview",
+		Order:         &order,
+		Metadata:      &readme.DocMetadataParams{Title: "Installing the Project"},
+	})
+	_, _, changelogErr := client.Changelog.Create(readme.ChangelogParams{
+		Title: "Release 1.0",
+		Body:  "The first release.",
+		Type:  "added",
+	})
+	_, _, pageErr := client.CustomPage.Create(readme.CustomPageParams{Title: "About", Body: "About us."})
+
+	for _, err := range []error{categoryErr, parentErr, childErr, changelogErr, pageErr} {
+		assert.NoError(t, err, "it creates the test data")
+	}
+
+	return client
+}
+
+func Test_Files(t *testing.T) {
+	t.Run("when the project is exported", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t)
+		doc, _, _ := client.Doc.Get("installation")
+
+		// Act
+		files, err := export.Files(context.Background(), client, export.Options{})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, []string{
+			"versions/1.0/version.yaml",
+			"versions/1.0/documentation/category.yaml",
+			"versions/1.0/documentation/overview.md",
+			"versions/1.0/documentation/overview/installation.md",
+			"changelogs/release-1-0.md",
+			"custom-pages/about.md",
+		}, paths(files), "it returns a file for every item")
+		assert.Equal(t, `---
+id: "`+doc.ID+`"
+title: Installation
+categorySlug: documentation
+parentDocSlug: overview
+order: 2
+hidden: true
+type: basic
+metadata:
+  description: ""
+  title: Installing the Project
+---
+
+Run the installer.
+`, string(files[3].Data), "it writes the doc's front matter and body")
+	})
+
+	t.Run("when the export is synced back", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t)
+		dir := t.TempDir()
+		_, exportErr := export.Export(context.Background(), client, dir, export.Options{})
+
+		// Act
+		report, err := docsync.Sync(context.Background(), client, filepath.Join(dir, "versions", "1.0"),
+			docsync.Options{})
+
+		// Assert
+		assert.NoError(t, exportErr, "it does not return an error")
+		assert.NoError(t, err, "it does not return an error")
+		assert.NoError(t, report.Err(), "it syncs every exported doc")
+		assert.Equal(t, 2, report.Count(docsync.ActionUnchanged), "it exports the docs unchanged")
+	})
+
+	t.Run("when versions are selected", func(t *testing.T) {
+		// Arrange
+		client := newTestClient(t)
+		_, _, _ = client.Version.Create(readme.VersionParams{Version: "2.0", From: mocks.DefaultFakeVersion})
+
+		// Act
+		files, err := export.Files(context.Background(), client, export.Options{Versions: []string{"2.0"}})
+		_, missingErr := export.Files(context.Background(), client, export.Options{Versions: []string{"3.0"}})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, "versions/2.0/version.yaml", files[0].Path, "it exports the selected version")
+		assert.Len(t, files, 6, "it only exports the selected version")
+		assert.ErrorIs(t, missingErr, readme.ErrNotFound, "it returns an error for an unknown version")
+	})
+}
+
+func Test_Export(t *testing.T) {
+	// Arrange
+	client := newTestClient(t)
+	dir := t.TempDir()
+
+	// Act
+	files, err := export.Export(context.Background(), client, dir, export.Options{})
+	data, readErr := os.ReadFile(filepath.Join(dir, "custom-pages", "about.md"))
+
+	// Assert
+	assert.NoError(t, err, "it does not return an error")
+	assert.NoError(t, readErr, "it writes the files")
+	assert.Equal(t, files[5].Data, data, "it writes the returned content")
+}
+
+// paths returns the path of every file.
+func paths(files []export.File) []string {
+	var paths []string
+	for _, file := range files {
+		paths = append(paths, file.Path)
+	}
+
+	return paths
+}
@@ -2,6 +2,7 @@ package readme
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -10,8 +11,9 @@ import (
 	"strings"
 )
 
-// ImageAPIURL is the base URL for the images endpoint of the ReadMe API.
+// ImageAPIURL is the default base URL for the images endpoint of the ReadMe API.
 // This endpoint is used for uploading images to ReadMe and is not part of the documented 'v1' API.
+// Use the WithImageAPIURL() client option to use a different URL.
 const ImageAPIURL = "https://dash.readme.com/api/images"
 
 // ImagesService is an interface for using the docs endpoints of the ReadMe.com API.
@@ -20,6 +22,9 @@ const ImageAPIURL = "https://dash.readme.com/api/images"
 type ImageService interface {
 	// Upload an image to ReadMe.
 	Upload(source []byte, filename ...string) (Image, *APIResponse, error)
+
+	// UploadWithContext uploads an image to ReadMe using the provided context.
+	UploadWithContext(ctx context.Context, source []byte, filename ...string) (Image, *APIResponse, error)
 }
 
 // ImageClient handles uploading images to ReadMe.com.
@@ -85,6 +90,17 @@ func buildForm(filename string, source []byte) ([]byte, string, error) {
 
 // Upload an image to ReadMe.
 func (c ImageClient) Upload(source []byte, filename ...string) (Image, *APIResponse, error) {
+	return c.UploadWithContext(context.Background(), source, filename...)
+}
+
+// UploadWithContext uploads an image to ReadMe using the provided context.
+func (c ImageClient) UploadWithContext(
+	ctx context.Context,
+	source []byte,
+	filename ...string,
+) (Image, *APIResponse, error) {
+	ctx = withOperation(ctx, "Image", "Upload")
+
 	var image Image
 
 	// Validate the image type.
@@ -107,9 +123,9 @@ func (c ImageClient) Upload(source []byte, filename ...string) (Image, *APIRespo
 
 	// Make the request.
 	var imageResponse []any
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "POST",
-		URL:          fmt.Sprintf("%s/image-upload", ImageAPIURL),
+		URL:          fmt.Sprintf("%s/image-upload", c.client.ImageAPIURL),
 		UseAuth:      true,
 		Headers:      []RequestHeader{{"Content-Type": contentType}},
 		Payload:      payload,
@@ -0,0 +1,219 @@
+package readme
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Link is a link from a Link HTTP response header, as described in RFC 8288.
+type Link struct {
+	// URL is the target of the link. ReadMe returns URLs that are relative to the API base URL,
+	// such as "/categories?perPage=100&page=2".
+	URL string
+	// Rel is the relation type of the link, such as "next". The "previous" relation type is returned
+	// as "prev".
+	Rel string
+	// Params lists the parameters of the link, including "rel", keyed by their lowercase name.
+	Params map[string]string
+}
+
+// Links holds the pagination links from a Link HTTP response header.
+//
+// A link is nil when the header doesn't include it or when its URL is empty, which ReadMe uses to
+// indicate that there's no such page.
+type Links struct {
+	// Next is the link to the next page.
+	Next *Link
+	// Prev is the link to the previous page.
+	Prev *Link
+	// First is the link to the first page.
+	First *Link
+	// Last is the link to the last page.
+	Last *Link
+}
+
+// ParseLinkHeader parses the value of a Link HTTP response header and returns the pagination links.
+//
+// The header is a comma-separated list of links, each a URL in angle brackets followed by
+// semicolon-separated parameters:
+//
+//	</categories?perPage=100&page=2>; rel="next", <>; rel="prev", </categories?perPage=100&page=3>; rel="last"
+//
+// Whitespace, quoting and the order of parameters don't matter, and a link with multiple relation
+// types, such as rel="next last", is returned for each of them. An empty header returns no links.
+func ParseLinkHeader(header string) (Links, error) {
+	var links Links
+
+	parser := &linkParser{input: header}
+	for {
+		parser.skipSpace()
+		if parser.done() {
+			break
+		}
+
+		link, err := parser.parseLink()
+		if err != nil {
+			return Links{}, fmt.Errorf("unable to parse link header '%s': %w", header, err)
+		}
+
+		for _, rel := range strings.Fields(strings.ToLower(link.Params["rel"])) {
+			if link.URL == "" {
+				continue
+			}
+
+			relLink := link
+			relLink.Rel = rel
+
+			switch rel {
+			case "next":
+				links.Next = &relLink
+			case "prev", "previous":
+				relLink.Rel = "prev"
+				links.Prev = &relLink
+			case "first":
+				links.First = &relLink
+			case "last":
+				links.Last = &relLink
+			}
+		}
+
+		parser.skipSpace()
+		if parser.done() {
+			break
+		}
+
+		if !parser.consume(',') {
+			return Links{}, fmt.Errorf(
+				"unable to parse link header '%s': expected ',' at position %d", header, parser.pos)
+		}
+	}
+
+	return links, nil
+}
+
+// linkParser tokenizes the value of a Link header.
+type linkParser struct {
+	input string
+	pos   int
+}
+
+// done returns true when the whole input has been consumed.
+func (p *linkParser) done() bool {
+	return p.pos >= len(p.input)
+}
+
+// peek returns the next byte without consuming it.
+func (p *linkParser) peek() byte {
+	if p.done() {
+		return 0
+	}
+
+	return p.input[p.pos]
+}
+
+// consume consumes the next byte if it matches.
+func (p *linkParser) consume(char byte) bool {
+	if p.peek() != char {
+		return false
+	}
+	p.pos++
+
+	return true
+}
+
+// skipSpace consumes any whitespace.
+func (p *linkParser) skipSpace() {
+	for !p.done() && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+// parseLink parses a single link and its parameters.
+func (p *linkParser) parseLink() (Link, error) {
+	if !p.consume('<') {
+		return Link{}, fmt.Errorf("expected '<' at position %d", p.pos)
+	}
+
+	end := strings.IndexByte(p.input[p.pos:], '>')
+	if end < 0 {
+		return Link{}, fmt.Errorf("expected '>' after position %d", p.pos)
+	}
+
+	link := Link{
+		URL:    strings.TrimSpace(p.input[p.pos : p.pos+end]),
+		Params: map[string]string{},
+	}
+	p.pos += end + 1
+
+	for {
+		p.skipSpace()
+		if p.done() || p.peek() == ',' {
+			break
+		}
+
+		if !p.consume(';') {
+			return Link{}, fmt.Errorf("expected ';' at position %d", p.pos)
+		}
+
+		p.skipSpace()
+		name, value, err := p.parseParam()
+		if err != nil {
+			return Link{}, err
+		}
+
+		// Only the first occurrence of a parameter is used, as required by RFC 8288.
+		if _, ok := link.Params[name]; !ok && name != "" {
+			link.Params[name] = value
+		}
+	}
+
+	link.Rel = link.Params["rel"]
+
+	return link, nil
+}
+
+// parseParam parses a link parameter with an optional token or quoted string value.
+func (p *linkParser) parseParam() (string, string, error) {
+	start := p.pos
+	for !p.done() && !strings.ContainsRune("=;, \t", rune(p.peek())) {
+		p.pos++
+	}
+	name := strings.ToLower(p.input[start:p.pos])
+
+	p.skipSpace()
+	if !p.consume('=') {
+		return name, "", nil
+	}
+	p.skipSpace()
+
+	if !p.consume('"') {
+		start = p.pos
+		for !p.done() && !strings.ContainsRune(";, \t", rune(p.peek())) {
+			p.pos++
+		}
+
+		return name, p.input[start:p.pos], nil
+	}
+
+	var value strings.Builder
+	for {
+		if p.done() {
+			return "", "", fmt.Errorf("unterminated quoted string for parameter '%s'", name)
+		}
+
+		char := p.input[p.pos]
+		p.pos++
+
+		switch char {
+		case '"':
+			return name, value.String(), nil
+		case '\\':
+			if !p.done() {
+				value.WriteByte(p.input[p.pos])
+				p.pos++
+			}
+		default:
+			value.WriteByte(char)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package readme_test
+
+import (
+	"testing"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseLinkHeader(t *testing.T) {
+	testCases := []struct {
+		name   string
+		header string
+		next   string
+		prev   string
+		first  string
+		last   string
+	}{
+		{
+			name: "when the header has a next link",
+			header: `</categories?perPage=10&page=2>; rel="next", <>; rel="prev", ` +
+				`</categories?perPage=10&page=3>; rel="last"`,
+			next: "/categories?perPage=10&page=2",
+			last: "/categories?perPage=10&page=3",
+		},
+		{
+			name:   "when the header is on the last page",
+			header: `<>; rel="next", </categories?page=2>; rel="prev", <>; rel="last"`,
+			prev:   "/categories?page=2",
+		},
+		{
+			name:   "when the header has no whitespace and unquoted parameters",
+			header: `</categories?page=2>;rel=next,</categories?page=1>;rel=prev`,
+			next:   "/categories?page=2",
+			prev:   "/categories?page=1",
+		},
+		{
+			name:   "when the header has extra whitespace and parameters",
+			header: ` < /categories?page=2 > ;  title="Next, page" ; rel = "next" ,	</categories?page=1>; rel="first"`,
+			next:   "/categories?page=2",
+			first:  "/categories?page=1",
+		},
+		{
+			name:   "when a link has multiple relation types",
+			header: `</categories?page=2>; rel="next last", </categories?page=1>; rel="Previous"`,
+			next:   "/categories?page=2",
+			prev:   "/categories?page=1",
+			last:   "/categories?page=2",
+		},
+		{
+			name:   "when the header has fewer links",
+			header: `</categories?page=2>; rel="next"`,
+			next:   "/categories?page=2",
+		},
+		{
+			name: "when the header is empty",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			got, err := readme.ParseLinkHeader(tc.header)
+
+			// Assert
+			assert.NoError(t, err, "it does not return an error")
+			for _, link := range []struct {
+				rel    string
+				got    *readme.Link
+				expect string
+			}{
+				{"next", got.Next, tc.next},
+				{"prev", got.Prev, tc.prev},
+				{"first", got.First, tc.first},
+				{"last", got.Last, tc.last},
+			} {
+				if link.expect == "" {
+					assert.Nil(t, link.got, "it doesn't return the %s link", link.rel)
+
+					continue
+				}
+
+				if assert.NotNil(t, link.got, "it returns the %s link", link.rel) {
+					assert.Equal(t, link.expect, link.got.URL, "it returns the %s URL", link.rel)
+					assert.Equal(t, link.rel, link.got.Rel, "it returns the %s relation type", link.rel)
+				}
+			}
+		})
+	}
+
+	t.Run("when a link has parameters", func(t *testing.T) {
+		// Act
+		got, err := readme.ParseLinkHeader(
+			`</categories?page=2>; REL="next"; title="Page \"2\""; hreflang=en; rel="prev"`,
+		)
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, map[string]string{"rel": "next", "title": `Page "2"`, "hreflang": "en"}, got.Next.Params,
+			"it returns the parameters with the first occurrence of each")
+		assert.Nil(t, got.Prev, "it ignores repeated rel parameters")
+	})
+
+	invalid := []struct {
+		name   string
+		header string
+		expect string
+	}{
+		{"when the header isn't a link", "invalid", "expected '<' at position 0"},
+		{"when a URL isn't terminated", `</categories?page=2; rel="next"`, "expected '>'"},
+		{"when a parameter separator is missing", `<> rel="next", <>; rel="prev"`, "expected ';' at position 3"},
+		{"when a quoted string isn't terminated", `</categories?page=2>; rel="next`, "unterminated quoted string"},
+		{"when a link separator is missing", `<>; rel="next" <>; rel="prev"`, "expected ';' at position 15"},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			got, err := readme.ParseLinkHeader(tc.header)
+
+			// Assert
+			assert.ErrorContains(t, err, "unable to parse link header", "it returns an error")
+			assert.ErrorContains(t, err, tc.expect, "it returns the position of the error")
+			assert.Equal(t, readme.Links{}, got, "it doesn't return any links")
+		})
+	}
+}
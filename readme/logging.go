@@ -0,0 +1,152 @@
+package readme
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// redacted replaces sensitive values in log records.
+const redacted = "[REDACTED]"
+
+// redactedHeaders lists the request headers that are never logged.
+var redactedHeaders = []string{"authorization"}
+
+// redactedJSONFields matches JSON fields in request and response bodies that are never logged.
+var redactedJSONFields = regexp.MustCompile(`("jwtSecret"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// LogConfig configures logging of the requests made by a Client.
+//
+// Every attempt of every request is logged, including each page of a paginated request and each
+// retry. The authorization header and the project's JWT secret are always redacted. Request and
+// response bodies are only logged when LogBodies is enabled.
+type LogConfig struct {
+	// Logger is the logger that records are written to. Nothing is logged when this is nil.
+	Logger *slog.Logger
+	// RequestLevel is the level used to log requests that succeed.
+	RequestLevel slog.Level
+	// RetryLevel is the level used to log failed attempts that are retried.
+	RetryLevel slog.Level
+	// ErrorLevel is the level used to log requests that fail.
+	ErrorLevel slog.Level
+	// LogBodies toggles logging the request and response bodies.
+	LogBodies bool
+}
+
+// DefaultLogConfig returns a LogConfig that logs successful requests at the debug level, retries
+// at the warning level and failed requests at the error level.
+func DefaultLogConfig(logger *slog.Logger) *LogConfig {
+	return &LogConfig{
+		Logger:       logger,
+		RequestLevel: slog.LevelDebug,
+		RetryLevel:   slog.LevelWarn,
+		ErrorLevel:   slog.LevelError,
+	}
+}
+
+// requestLog holds the details of a request attempt to log.
+type requestLog struct {
+	request     *APIRequest
+	httpRequest *http.Request
+	response    *APIResponse
+	err         error
+	attempt     int
+	duration    time.Duration
+	retry       bool
+	retryDelay  time.Duration
+}
+
+// log writes a record for a request attempt.
+func (l *LogConfig) log(ctx context.Context, entry requestLog) {
+	if l == nil || l.Logger == nil {
+		return
+	}
+
+	level := l.RequestLevel
+	message := "ReadMe API request"
+	switch {
+	case entry.retry:
+		level = l.RetryLevel
+		message = "ReadMe API request failed, retrying"
+	case entry.err != nil || entry.response == nil ||
+		!slices.Contains(entry.request.OkStatusCode, entry.response.HTTPResponse.StatusCode):
+		level = l.ErrorLevel
+		message = "ReadMe API request failed"
+	}
+
+	if !l.Logger.Enabled(ctx, level) {
+		return
+	}
+
+	endpoint := entry.request.Endpoint
+	if endpoint == "" {
+		endpoint = entry.request.URL
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", entry.request.Method),
+		slog.String("endpoint", endpoint),
+		slog.Int("attempt", entry.attempt),
+		slog.Duration("duration", entry.duration),
+	}
+
+	if entry.request.RequestOptions.Page != 0 {
+		attrs = append(attrs, slog.Int("page", entry.request.RequestOptions.Page))
+	}
+
+	if entry.httpRequest != nil {
+		if version := entry.httpRequest.Header.Get("x-readme-version"); version != "" {
+			attrs = append(attrs, slog.String("version", version))
+		}
+		attrs = append(attrs, slog.Any("headers", redactHeaders(entry.httpRequest.Header)))
+	}
+
+	if entry.response != nil {
+		attrs = append(attrs, slog.Int("status", entry.response.HTTPResponse.StatusCode))
+		if requestID := entry.response.HTTPResponse.Header.Get(RequestIDHeader); requestID != "" {
+			attrs = append(attrs, slog.String("request_id", requestID))
+		}
+	}
+
+	if entry.retry {
+		attrs = append(attrs, slog.Duration("retry_in", entry.retryDelay))
+	}
+
+	if entry.err != nil {
+		attrs = append(attrs, slog.String("error", entry.err.Error()))
+	}
+
+	if l.LogBodies {
+		if entry.request.Payload != nil {
+			attrs = append(attrs, slog.String("request_body", redactBody(entry.request.Payload)))
+		}
+		if entry.response != nil {
+			attrs = append(attrs, slog.String("response_body", redactBody(entry.response.Body)))
+		}
+	}
+
+	l.Logger.LogAttrs(ctx, level, message, attrs...)
+}
+
+// redactHeaders returns a copy of the HTTP headers with sensitive values redacted.
+func redactHeaders(headers http.Header) map[string]string {
+	result := make(map[string]string, len(headers))
+	for header, values := range headers {
+		value := strings.Join(values, ", ")
+		if slices.Contains(redactedHeaders, strings.ToLower(header)) {
+			value = redacted
+		}
+		result[strings.ToLower(header)] = value
+	}
+
+	return result
+}
+
+// redactBody returns a request or response body as a string with sensitive fields redacted.
+func redactBody(body []byte) string {
+	return redactedJSONFields.ReplaceAllString(string(body), `$1"`+redacted+`"`)
+}
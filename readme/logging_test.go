@@ -0,0 +1,169 @@
+package readme_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+// newLoggingClient returns a test client that logs JSON records to the returned buffer.
+func newLoggingClient(t *testing.T, logBodies bool) (*readme.Client, *bytes.Buffer) {
+	t.Helper()
+
+	buffer := &bytes.Buffer{}
+	config := readme.DefaultLogConfig(slog.New(slog.NewJSONHandler(buffer, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+	})))
+	config.LogBodies = logBodies
+
+	client, err := readme.NewClient("test", readme.WithAPIURL(TestClientBaseURL), readme.WithLogConfig(config))
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	return client, buffer
+}
+
+// logRecords parses the JSON log records written to a buffer.
+func logRecords(t *testing.T, buffer *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\n") {
+		record := map[string]any{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("unable to parse log record '%s': %s", line, err)
+		}
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func Test_LogConfig(t *testing.T) {
+	t.Run("when a request succeeds", func(t *testing.T) {
+		// Arrange
+		client, buffer := newLoggingClient(t, false)
+		client.DefaultVersion = "1.1.0"
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(200).
+			SetHeader("x-request-id", "req-123").
+			JSON(testdata.Project)
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.Project.Get()
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		records := logRecords(t, buffer)
+		assert.Len(t, records, 1, "it logs the request")
+		assert.Equal(t, "DEBUG", records[0]["level"], "it logs at the request level")
+		assert.Equal(t, "GET", records[0]["method"], "it logs the method")
+		assert.Equal(t, readme.ProjectEndpoint, records[0]["endpoint"], "it logs the endpoint")
+		assert.Equal(t, "1.1.0", records[0]["version"], "it logs the version")
+		assert.Equal(t, float64(200), records[0]["status"], "it logs the status")
+		assert.Equal(t, "req-123", records[0]["request_id"], "it logs the request ID")
+		assert.Equal(t, float64(1), records[0]["attempt"], "it logs the attempt")
+		assert.Contains(t, records[0], "duration", "it logs the duration")
+		assert.Equal(t, "[REDACTED]", records[0]["headers"].(map[string]any)["authorization"],
+			"it redacts the authorization header")
+		assert.NotContains(t, records[0], "response_body", "it doesn't log the body")
+		assert.NotContains(t, buffer.String(), testdata.Project.JWTSecret, "it doesn't log the JWT secret")
+	})
+
+	t.Run("when body logging is enabled", func(t *testing.T) {
+		// Arrange
+		client, buffer := newLoggingClient(t, true)
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(200).
+			JSON(testdata.Project)
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.Project.Get()
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		records := logRecords(t, buffer)
+		assert.Contains(t, records[0]["response_body"], testdata.Project.Name, "it logs the response body")
+		assert.Contains(t, records[0]["response_body"], `"jwtSecret":"[REDACTED]"`, "it redacts the JWT secret")
+		assert.NotContains(t, buffer.String(), testdata.Project.JWTSecret, "it doesn't log the JWT secret")
+	})
+
+	t.Run("when a request is retried and fails", func(t *testing.T) {
+		// Arrange
+		client, buffer := newLoggingClient(t, false)
+		client.RetryPolicy = &readme.RetryPolicy{MaxAttempts: 2, RetryableStatusCodes: []int{503}}
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Times(2).
+			Reply(503).
+			JSON(readme.APIErrorResponse{Error: "UNAVAILABLE"})
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.Project.Get()
+
+		// Assert
+		assert.Error(t, err, "it returns an error")
+		records := logRecords(t, buffer)
+		assert.Len(t, records, 2, "it logs every attempt")
+		assert.Equal(t, "WARN", records[0]["level"], "it logs the retry at the retry level")
+		assert.Contains(t, records[0], "retry_in", "it logs the retry delay")
+		assert.Equal(t, "ERROR", records[1]["level"], "it logs the failure at the error level")
+		assert.Equal(t, float64(2), records[1]["attempt"], "it logs the attempt")
+	})
+
+	t.Run("when a request is paginated", func(t *testing.T) {
+		// Arrange
+		client, buffer := newLoggingClient(t, false)
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			MatchParam("page", "1").
+			Reply(200).
+			SetHeader("x-total-count", "2").
+			SetHeader("link", `</categories?perPage=1&page=2>; rel="next", <>; rel="prev", <>; rel="last"`).
+			JSON([]readme.Category{testdata.Categories[0]})
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			MatchParam("page", "2").
+			Reply(200).
+			SetHeader("x-total-count", "2").
+			SetHeader("link", `<>; rel="next", </categories?perPage=1&page=1>; rel="prev", <>; rel="last"`).
+			JSON([]readme.Category{testdata.Categories[1]})
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.Category.GetAll(readme.RequestOptions{PerPage: 1})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		records := logRecords(t, buffer)
+		assert.Len(t, records, 2, "it logs every page")
+		assert.Equal(t, float64(1), records[0]["page"], "it logs the first page number")
+		assert.Equal(t, float64(2), records[1]["page"], "it logs the second page number")
+	})
+}
+
+func Test_Project_LogValue(t *testing.T) {
+	// Arrange
+	buffer := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(buffer, nil))
+
+	// Act
+	logger.Info("project", "project", testdata.Project)
+
+	// Assert
+	assert.Contains(t, buffer.String(), testdata.Project.Name, "it logs the project")
+	assert.NotContains(t, buffer.String(), testdata.Project.JWTSecret, "it redacts the JWT secret")
+}
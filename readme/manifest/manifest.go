@@ -0,0 +1,385 @@
+// Package manifest describes a whole ReadMe project in a YAML or JSON manifest and reconciles the
+// project with it.
+//
+// A manifest lists the project's versions with their categories, docs and API specifications, and
+// its changelogs and custom pages:
+//
+//	versions:
+//	  - version: "2.0"
+//	    from: "1.0"
+//	    stable: true
+//	    categories:
+//	      - slug: documentation
+//	        title: Documentation
+//	        type: guide
+//	        docs:
+//	          - slug: overview
+//	            title: Overview
+//	            bodyFile: docs/overview.md
+//	            children:
+//	              - slug: installation
+//	                title: Installation
+//	                body: Run the installer.
+//	      - slug: petstore
+//	        title: Petstore
+//	        type: reference
+//	        specs:
+//	          - file: specs/petstore.yaml
+//	changelogs:
+//	  - slug: release-2-0
+//	    title: Release 2.0
+//	    type: added
+//	    body: The second release.
+//	customPages:
+//	  - slug: about
+//	    title: About
+//	    body: About us.
+//
+// Reconcile the project with a manifest, or report its drift without changing anything:
+//
+//	m, err := manifest.Load("readme.yaml")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	report, err := manifest.Reconcile(ctx, client, m, manifest.Options{DryRun: true})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	fmt.Print(report.Text())
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"gopkg.in/yaml.v3"
+)
+
+// Manifest is the desired state of a ReadMe project.
+type Manifest struct {
+	// Versions is the versions of the project, with their content.
+	Versions []Version `yaml:"versions"`
+	// Changelogs is the changelogs of the project.
+	Changelogs []Changelog `yaml:"changelogs,omitempty"`
+	// CustomPages is the custom pages of the project.
+	CustomPages []CustomPage `yaml:"customPages,omitempty"`
+}
+
+// Version is the desired state of a version. The flags that aren't set aren't reconciled.
+type Version struct {
+	// Version is the name of the version.
+	Version string `yaml:"version"`
+	// From is the name of the version to fork the version from when it's created.
+	From string `yaml:"from,omitempty"`
+	// Codename is the codename of the version.
+	Codename string `yaml:"codename,omitempty"`
+	// Stable makes the version the project's main version. Only one version can be stable.
+	Stable *bool `yaml:"stable,omitempty"`
+	// Beta marks the version as a beta release.
+	Beta *bool `yaml:"beta,omitempty"`
+	// Hidden hides the version from the public.
+	Hidden *bool `yaml:"hidden,omitempty"`
+	// Deprecated marks the version as deprecated.
+	Deprecated *bool `yaml:"deprecated,omitempty"`
+	// Categories is the categories of the version.
+	Categories []Category `yaml:"categories,omitempty"`
+}
+
+// Category is the desired state of a category.
+type Category struct {
+	// Slug is the slug of the category, which ReadMe generates from its title when it's created.
+	Slug string `yaml:"slug"`
+	// Title is the title of the category.
+	Title string `yaml:"title"`
+	// Type is the type of the category, which is "guide" or "reference".
+	Type string `yaml:"type"`
+	// Docs is the top-level docs of the category.
+	Docs []Doc `yaml:"docs,omitempty"`
+	// Specs is the API specifications in the category. ReadMe adds an API specification to the
+	// reference category with the title of the API, so the category's title must match it.
+	Specs []Spec `yaml:"specs,omitempty"`
+}
+
+// Doc is the desired state of a doc. The optional fields that aren't set aren't reconciled.
+type Doc struct {
+	// Slug is the slug of the doc, which ReadMe generates from its title when it's created.
+	Slug string `yaml:"slug"`
+	// Title is the title of the doc.
+	Title string `yaml:"title"`
+	// Body is the markdown body of the doc.
+	Body string `yaml:"body,omitempty"`
+	// BodyFile is the path of a file with the body of the doc, relative to the manifest. It's read
+	// by Load.
+	BodyFile string `yaml:"bodyFile,omitempty"`
+	// Excerpt is a short summary of the doc.
+	Excerpt string `yaml:"excerpt,omitempty"`
+	// Hidden toggles the visibility of the doc.
+	Hidden *bool `yaml:"hidden,omitempty"`
+	// Order is the position of the doc in the sidebar.
+	Order *int `yaml:"order,omitempty"`
+	// Type is the type of the doc, such as "basic", "error" or "link".
+	Type string `yaml:"type,omitempty"`
+	// Metadata is the title and description of the doc used by search engines and link previews.
+	Metadata *readme.DocMetadataParams `yaml:"metadata,omitempty"`
+	// Children is the child docs of the doc.
+	Children []Doc `yaml:"children,omitempty"`
+}
+
+// Spec is the desired state of an API specification, which is identified by the title of its
+// definition.
+type Spec struct {
+	// Definition is the OpenAPI or Swagger definition as JSON or YAML.
+	Definition string `yaml:"definition,omitempty"`
+	// File is the path of a file with the definition, relative to the manifest. It's read by Load.
+	File string `yaml:"file,omitempty"`
+}
+
+// Changelog is the desired state of a changelog.
+type Changelog struct {
+	// Slug is the slug of the changelog, which ReadMe generates from its title when it's created.
+	Slug string `yaml:"slug"`
+	// Title is the title of the changelog.
+	Title string `yaml:"title"`
+	// Type is the type of the changelog, such as "added" or "fixed".
+	Type string `yaml:"type,omitempty"`
+	// Body is the markdown body of the changelog.
+	Body string `yaml:"body"`
+	// Hidden toggles the visibility of the changelog.
+	Hidden *bool `yaml:"hidden,omitempty"`
+}
+
+// CustomPage is the desired state of a custom page.
+type CustomPage struct {
+	// Slug is the slug of the custom page, which ReadMe generates from its title when it's created.
+	Slug string `yaml:"slug"`
+	// Title is the title of the custom page.
+	Title string `yaml:"title"`
+	// Body is the markdown body of the custom page.
+	Body string `yaml:"body,omitempty"`
+	// HTML is the HTML body of the custom page, which is shown in HTML mode.
+	HTML string `yaml:"html,omitempty"`
+	// HTMLMode shows the HTML body instead of the markdown body.
+	HTMLMode *bool `yaml:"htmlMode,omitempty"`
+	// Hidden toggles the visibility of the custom page.
+	Hidden *bool `yaml:"hidden,omitempty"`
+}
+
+// Parse parses and validates a YAML or JSON manifest. Unknown fields are rejected.
+func Parse(data []byte) (*Manifest, error) {
+	manifest := &Manifest{}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(manifest); err != nil {
+		return nil, fmt.Errorf("unable to parse manifest: %w", err)
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// Load reads and parses a manifest file, and reads the doc bodies and API definitions in the files
+// it refers to.
+func Load(filePath string) (*Manifest, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read manifest: %w", err)
+	}
+
+	manifest, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Dir(filePath)
+	for i := range manifest.Versions {
+		for j := range manifest.Versions[i].Categories {
+			category := &manifest.Versions[i].Categories[j]
+			if err := readBodies(dir, category.Docs); err != nil {
+				return nil, err
+			}
+
+			for k := range category.Specs {
+				if category.Specs[k].File == "" {
+					continue
+				}
+
+				definition, err := os.ReadFile(filepath.Join(dir, category.Specs[k].File))
+				if err != nil {
+					return nil, fmt.Errorf("unable to read API definition: %w", err)
+				}
+				category.Specs[k].Definition = string(definition)
+			}
+		}
+	}
+
+	return manifest, nil
+}
+
+// readBodies reads the bodies of docs and their children from their body files.
+func readBodies(dir string, docs []Doc) error {
+	for i := range docs {
+		if docs[i].BodyFile != "" {
+			body, err := os.ReadFile(filepath.Join(dir, docs[i].BodyFile))
+			if err != nil {
+				return fmt.Errorf("unable to read the body of doc '%s': %w", docs[i].Slug, err)
+			}
+			docs[i].Body = string(body)
+		}
+
+		if err := readBodies(dir, docs[i].Children); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate returns an error for every problem in the manifest: a missing slug, title or version
+// name, a duplicate, an invalid category type, more than one stable version, a version forked from
+// itself through other versions, or an API specification with both or neither of a definition and
+// a file.
+func (m *Manifest) Validate() error {
+	var errs []error
+
+	versions := map[string]bool{}
+	stable := 0
+	for _, v := range m.Versions {
+		switch {
+		case v.Version == "":
+			errs = append(errs, errors.New("a version doesn't have a name"))
+		case versions[v.Version]:
+			errs = append(errs, fmt.Errorf("the version '%s' is listed more than once", v.Version))
+		}
+		versions[v.Version] = true
+
+		if v.Stable != nil && *v.Stable {
+			stable++
+		}
+
+		errs = append(errs, v.validate()...)
+	}
+	if stable > 1 {
+		errs = append(errs, errors.New("more than one version is stable"))
+	}
+	if _, err := orderVersions(m.Versions); err != nil {
+		errs = append(errs, err)
+	}
+
+	changelogs := map[string]bool{}
+	for _, changelog := range m.Changelogs {
+		errs = append(errs, validateItem("changelog", changelog.Slug, changelog.Title, changelogs)...)
+	}
+
+	pages := map[string]bool{}
+	for _, page := range m.CustomPages {
+		errs = append(errs, validateItem("custom page", page.Slug, page.Title, pages)...)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid manifest: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// validate returns the problems in the content of a version.
+func (v Version) validate() []error {
+	var errs []error
+
+	categories := map[string]bool{}
+	docs := map[string]bool{}
+	for _, category := range v.Categories {
+		errs = append(errs, validateItem("category", category.Slug, category.Title, categories)...)
+		if category.Type != "guide" && category.Type != "reference" {
+			errs = append(errs, fmt.Errorf("the type of category '%s' isn't 'guide' or 'reference'", category.Slug))
+		}
+
+		errs = append(errs, validateDocs(category.Docs, docs)...)
+
+		for _, spec := range category.Specs {
+			if (spec.Definition == "") == (spec.File == "") {
+				errs = append(errs, fmt.Errorf(
+					"an API specification in category '%s' must have either a definition or a file", category.Slug))
+			}
+		}
+	}
+
+	for i := range errs {
+		errs[i] = fmt.Errorf("version '%s': %w", v.Version, errs[i])
+	}
+
+	return errs
+}
+
+// validateDocs returns the problems in docs and their children.
+func validateDocs(docs []Doc, slugs map[string]bool) []error {
+	var errs []error
+	for _, doc := range docs {
+		errs = append(errs, validateItem("doc", doc.Slug, doc.Title, slugs)...)
+		errs = append(errs, validateDocs(doc.Children, slugs)...)
+	}
+
+	return errs
+}
+
+// validateItem returns the problems with the slug and title of an item, and adds its slug to the
+// slugs of the items of its kind.
+func validateItem(kind, slug, title string, slugs map[string]bool) []error {
+	var errs []error
+	switch {
+	case slug == "":
+		errs = append(errs, fmt.Errorf("a %s doesn't have a slug", kind))
+	case slugs[slug]:
+		errs = append(errs, fmt.Errorf("the %s '%s' is listed more than once", kind, slug))
+	}
+	if title == "" {
+		errs = append(errs, fmt.Errorf("the %s '%s' doesn't have a title", kind, slug))
+	}
+	slugs[slug] = true
+
+	return errs
+}
+
+// orderVersions sorts versions so the versions forked from another version in the manifest come
+// after it.
+func orderVersions(versions []Version) ([]Version, error) {
+	listed := map[string]bool{}
+	for _, v := range versions {
+		listed[v.Version] = true
+	}
+
+	var ordered []Version
+	added := map[string]bool{}
+	pending := versions
+	for len(pending) > 0 {
+		var next []Version
+		for _, v := range pending {
+			if listed[v.From] && !added[v.From] && v.From != v.Version {
+				next = append(next, v)
+
+				continue
+			}
+			ordered = append(ordered, v)
+		}
+		for _, v := range ordered {
+			added[v.Version] = true
+		}
+
+		if len(next) == len(pending) {
+			return nil, fmt.Errorf("the version '%s' is forked from itself through other versions", next[0].Version)
+		}
+		pending = next
+	}
+
+	return ordered, nil
+}
@@ -0,0 +1,321 @@
+package manifest_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/readme/manifest"
+	"github.com/liveoaklabs/readme-api-go-client/readme/plan"
+	"github.com/liveoaklabs/readme-api-go-client/tests/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// testDefinition is an API definition titled like the reference category of the test manifest.
+const testDefinition = `{"openapi": "3.0.0", "info": {"title": "Petstore", "version": "1.0"}, "paths": {}}`
+
+// testManifest is a manifest with two versions, a changelog and a custom page.
+const testManifest = `
+versions:
+  - version: "1.0"
+    categories:
+      - slug: documentation
+        title: Documentation
+        type: guide
+        docs:
+          - slug: overview
+            title: Overview
+            body: Welcome to the docs.
+            children:
+              - slug: installation
+                title: Installation
+                body: Run the installer.
+      - slug: petstore
+        title: Petstore
+        type: reference
+        specs:
+          - definition: '` + testDefinition + `'
+  - version: "2.0"
+    from: "1.0"
+    beta: true
+    categories:
+      - slug: documentation
+        title: Documentation
+        type: guide
+        docs:
+          - slug: overview
+            title: Introduction
+            body: Welcome to the docs.
+            children:
+              - slug: installation
+                title: Installation
+                body: Run the installer.
+      - slug: petstore
+        title: Petstore
+        type: reference
+        specs:
+          - definition: '` + testDefinition + `'
+changelogs:
+  - slug: release-1-0
+    title: Release 1.0
+    type: added
+    body: The first release.
+customPages:
+  - slug: about
+    title: About
+    body: About us.
+`
+
+// parse returns the test manifest.
+func parse(t *testing.T) *manifest.Manifest {
+	t.Helper()
+
+	m, err := manifest.Parse([]byte(testManifest))
+	assert.NoError(t, err, "it parses the test manifest")
+
+	return m
+}
+
+func Test_Parse(t *testing.T) {
+	t.Run("when the manifest is valid", func(t *testing.T) {
+		// Act
+		m, err := manifest.Parse([]byte(testManifest))
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, "1.0", m.Versions[1].From, "it returns the versions")
+		assert.Equal(t, "installation", m.Versions[0].Categories[0].Docs[0].Children[0].Slug,
+			"it returns the doc hierarchy")
+		assert.Equal(t, "about", m.CustomPages[0].Slug, "it returns the custom pages")
+	})
+
+	t.Run("when the manifest is JSON", func(t *testing.T) {
+		// Act
+		m, err := manifest.Parse([]byte(`{"versions": [{"version": "1.0", "stable": true}]}`))
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.True(t, *m.Versions[0].Stable, "it returns the versions")
+	})
+
+	t.Run("when the manifest has an unknown field", func(t *testing.T) {
+		// Act
+		_, err := manifest.Parse([]byte("versions:\n  - version: \"1.0\"\n    name: one\n"))
+
+		// Assert
+		assert.ErrorContains(t, err, "unable to parse manifest", "it returns an error")
+	})
+
+	t.Run("when the manifest is invalid", func(t *testing.T) {
+		// Act
+		_, err := manifest.Parse([]byte(`
+versions:
+  - version: "1.0"
+    from: "2.0"
+    stable: true
+    categories:
+      - slug: documentation
+        title: Documentation
+        type: tutorial
+        docs:
+          - slug: overview
+            title: Overview
+            children:
+              - slug: overview
+                title: Overview
+        specs:
+          - {}
+  - version: "2.0"
+    from: "1.0"
+    stable: true
+changelogs:
+  - slug: release-1-0
+`))
+
+		// Assert
+		assert.ErrorContains(t, err, "invalid manifest", "it returns an error")
+		for _, message := range []string{
+			"version '1.0': the type of category 'documentation' isn't 'guide' or 'reference'",
+			"version '1.0': the doc 'overview' is listed more than once",
+			"an API specification in category 'documentation' must have either a definition or a file",
+			"more than one version is stable",
+			"is forked from itself through other versions",
+			"the changelog 'release-1-0' doesn't have a title",
+		} {
+			assert.ErrorContains(t, err, message, "it returns every problem")
+		}
+	})
+}
+
+func Test_Load(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	files := map[string]string{
+		"readme.yaml": `
+versions:
+  - version: "1.0"
+    categories:
+      - slug: petstore
+        title: Petstore
+        type: reference
+        docs:
+          - slug: overview
+            title: Overview
+            bodyFile: docs/overview.md
+        specs:
+          - file: petstore.json
+`,
+		"docs/overview.md": "Welcome to the docs.\n",
+		"petstore.json":    testDefinition,
+	}
+	for name, data := range files {
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0o755), "it creates the test files")
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600), "it creates the test files")
+	}
+
+	// Act
+	m, err := manifest.Load(filepath.Join(dir, "readme.yaml"))
+	_, missingErr := manifest.Load(filepath.Join(dir, "missing.yaml"))
+
+	// Assert
+	assert.NoError(t, err, "it does not return an error")
+	assert.Equal(t, "Welcome to the docs.\n", m.Versions[0].Categories[0].Docs[0].Body, "it reads the doc bodies")
+	assert.Equal(t, testDefinition, m.Versions[0].Categories[0].Specs[0].Definition, "it reads the definitions")
+	assert.ErrorContains(t, missingErr, "unable to read manifest", "it returns an error for a missing file")
+}
+
+func Test_Reconcile(t *testing.T) {
+	t.Run("when the project is reconciled", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+
+		// Act
+		report, err := manifest.Reconcile(context.Background(), client, parse(t), manifest.Options{})
+		version, _, versionErr := client.Version.Get("2.0")
+		doc, _, docErr := client.Doc.Get("overview", readme.RequestOptions{Version: "2.0"})
+		specs, _, specsErr := client.APISpecification.GetAll(readme.RequestOptions{Version: "2.0"})
+		drift, driftErr := manifest.Reconcile(context.Background(), client, parse(t),
+			manifest.Options{DryRun: true})
+
+		// Assert
+		assert.NoError(t, errors.Join(err, versionErr, docErr, specsErr, driftErr), "it does not return an error")
+		assert.Equal(t, []string{
+			"1.0 version 1.0 no-op",
+			"1.0 category documentation create",
+			"1.0 category petstore create",
+			"1.0 doc overview create",
+			"1.0 doc installation create",
+			"1.0 api_specification Petstore create",
+			"2.0 version 2.0 create",
+			"2.0 category documentation no-op",
+			"2.0 category petstore no-op",
+			"2.0 doc overview update",
+			"2.0 doc installation no-op",
+			"2.0 api_specification Petstore no-op",
+			" changelog release-1-0 create",
+			" custom_page about create",
+		}, summarize(report), "it reconciles the resources in dependency order")
+		assert.True(t, version.IsBeta, "it creates the version with its flags")
+		assert.Equal(t, "Introduction", doc.Title, "it reconciles the forked content")
+		assert.Len(t, specs, 1, "it doesn't upload the forked API specification again")
+		assert.Equal(t, "petstore", specs[0].Category.Slug, "it binds the API specification to its category")
+		assert.False(t, drift.HasDrift(), "it brings the project to the state of the manifest")
+	})
+
+	t.Run("when it's a dry run", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+
+		// Act
+		report, err := manifest.Reconcile(context.Background(), client, parse(t), manifest.Options{DryRun: true})
+		_, _, versionErr := client.Version.Get("2.0")
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, 13, report.Count(plan.ActionCreate), "it reports the resources to create")
+		assert.Equal(t, 1, report.Count(plan.ActionNoOp), "it reports the resources that don't change")
+		assert.Contains(t, report.Text(), `+ version "2.0" will be created`+"\n"+`    from: "1.0"`,
+			"it renders the drift")
+		assert.Contains(t, report.Text(), `+ doc "overview" in version "1.0" will be created`,
+			"it renders the version of the drift")
+		assert.ErrorIs(t, versionErr, readme.ErrNotFound, "it doesn't change anything")
+	})
+
+	t.Run("when the project drifted", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		_, _ = manifest.Reconcile(context.Background(), client, parse(t), manifest.Options{})
+		_, _, _ = client.Doc.Update("installation", readme.DocParams{
+			Title:        "Setup",
+			CategorySlug: "documentation",
+		}, readme.RequestOptions{Version: "2.0"})
+		hidden := true
+		_, _, _ = client.Version.Update("2.0", readme.VersionParams{From: "1.0", IsHidden: &hidden})
+		_, _, _ = client.Version.Create(readme.VersionParams{Version: "3.0", From: "1.0"})
+		_, _, _ = client.CustomPage.Create(readme.CustomPageParams{Title: "Contact"})
+
+		// Act
+		report, err := manifest.Reconcile(context.Background(), client, parse(t),
+			manifest.Options{DryRun: true, Prune: true})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, []string{
+			"2.0 doc installation update",
+			" custom_page contact delete",
+			"3.0 version 3.0 delete",
+		}, summarize(&manifest.Report{Changes: report.Drift()}), "it reports the drift")
+		assert.Contains(t, report.Text(), `    title: "Setup" -> "Installation"`, "it renders the changed fields")
+	})
+
+	t.Run("when the project is pruned", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		_, _ = manifest.Reconcile(context.Background(), client, parse(t), manifest.Options{})
+		_, _, _ = client.Version.Create(readme.VersionParams{Version: "3.0", From: "1.0"})
+		m := parse(t)
+		m.Versions[1].Categories = m.Versions[1].Categories[:1]
+
+		// Act
+		report, err := manifest.Reconcile(context.Background(), client, m, manifest.Options{Prune: true})
+		_, _, versionErr := client.Version.Get("3.0")
+		specs, _, specsErr := client.APISpecification.GetAll(readme.RequestOptions{Version: "2.0"})
+
+		// Assert
+		assert.NoError(t, errors.Join(err, specsErr), "it does not return an error")
+		assert.Equal(t, []string{
+			"2.0 category petstore delete",
+			"2.0 api_specification Petstore delete",
+			"3.0 version 3.0 delete",
+		}, summarize(&manifest.Report{Changes: report.Drift()}), "it deletes the resources that aren't in the manifest")
+		assert.ErrorIs(t, versionErr, readme.ErrNotFound, "it deletes the versions")
+		assert.Empty(t, specs, "it deletes the API specifications")
+	})
+
+	t.Run("when an API specification isn't titled like its category", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		m := parse(t)
+		m.Versions[0].Categories[1].Title = "Pets"
+
+		// Act
+		_, err := manifest.Reconcile(context.Background(), client, m, manifest.Options{})
+
+		// Assert
+		assert.ErrorContains(t, err, "the title of API specification 'Petstore' isn't the title of category 'petstore'",
+			"it returns an error")
+	})
+}
+
+// summarize returns the version, type, slug and action of every change in a report.
+func summarize(report *manifest.Report) []string {
+	var changes []string
+	for _, change := range report.Changes {
+		changes = append(changes, change.Version+" "+string(change.Type)+" "+change.Slug+" "+string(change.Action))
+	}
+
+	return changes
+}
@@ -0,0 +1,602 @@
+package manifest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/readme/plan"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// TypeVersion is a version.
+	TypeVersion plan.ResourceType = "version"
+	// TypeAPISpecification is an API specification, which is identified by its title.
+	TypeAPISpecification plan.ResourceType = "api_specification"
+)
+
+// Options configures a reconciliation.
+type Options struct {
+	// DryRun reports the drift without changing anything. The content of a version that doesn't
+	// exist yet is compared with the version it will be forked from.
+	DryRun bool
+	// Prune deletes the versions, categories, docs, API specifications, changelogs and custom pages
+	// that aren't in the manifest. The stable version isn't deleted.
+	Prune bool
+	// UpdateSpecs uploads the definitions of the API specifications that already exist. ReadMe
+	// doesn't return the definitions, so they can't be compared and are only uploaded when the API
+	// specification is missing otherwise.
+	UpdateSpecs bool
+}
+
+// Change is the change made to a resource of a version, or of the project when the version is
+// empty.
+type Change struct {
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	plan.Change
+}
+
+// Report is the result of a reconciliation.
+type Report struct {
+	// DryRun is whether the changes were only planned.
+	DryRun bool `json:"dryRun"`
+	// Changes is the change to every resource in the manifest, in the order they're made, followed by
+	// the deletions.
+	Changes []Change `json:"changes"`
+}
+
+// Count returns the number of changes with an action.
+func (r *Report) Count(action plan.Action) int {
+	count := 0
+	for _, change := range r.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Drift returns the changes to the resources that weren't in the state described by the manifest.
+func (r *Report) Drift() []Change {
+	var drift []Change
+	for _, change := range r.Changes {
+		if change.Action != plan.ActionNoOp {
+			drift = append(drift, change)
+		}
+	}
+
+	return drift
+}
+
+// HasDrift returns whether any resource wasn't in the state described by the manifest.
+func (r *Report) HasDrift() bool {
+	return len(r.Drift()) > 0
+}
+
+// Text returns the drift as text for review. Resources that don't change are only counted.
+func (r *Report) Text() string {
+	symbols := map[plan.Action]string{plan.ActionCreate: "+", plan.ActionUpdate: "~", plan.ActionDelete: "-"}
+	verbs := map[plan.Action]string{
+		plan.ActionCreate: "created",
+		plan.ActionUpdate: "updated",
+		plan.ActionDelete: "deleted",
+	}
+	tense := "was"
+	if r.DryRun {
+		tense = "will be"
+	}
+
+	var text strings.Builder
+	for _, change := range r.Drift() {
+		fmt.Fprintf(&text, "%s %s %q", symbols[change.Action], change.Type, change.Slug)
+		if change.Version != "" && change.Type != TypeVersion {
+			fmt.Fprintf(&text, " in version %q", change.Version)
+		}
+		fmt.Fprintf(&text, " %s %s\n", tense, verbs[change.Action])
+
+		for _, field := range change.Fields {
+			if change.Action == plan.ActionCreate {
+				fmt.Fprintf(&text, "    %s: %q\n", field.Name, field.New)
+			} else {
+				fmt.Fprintf(&text, "    %s: %q -> %q\n", field.Name, field.Old, field.New)
+			}
+		}
+		for _, line := range strings.Split(strings.TrimSuffix(change.Diff, "\n"), "\n") {
+			if line != "" {
+				text.WriteString("    " + line + "\n")
+			}
+		}
+		text.WriteString("\n")
+	}
+
+	fmt.Fprintf(&text, "Drift: %d to create, %d to update, %d to delete, %d unchanged.\n",
+		r.Count(plan.ActionCreate), r.Count(plan.ActionUpdate), r.Count(plan.ActionDelete),
+		r.Count(plan.ActionNoOp))
+
+	return text.String()
+}
+
+// Reconcile brings a project to the state described by a manifest and reports its drift.
+//
+// Versions are reconciled in order, with the versions forked from another version in the manifest
+// after it. For every version, its categories are reconciled first, then its docs with parents
+// before their children, and then its API specifications. Changelogs and custom pages are
+// reconciled last. A new version is forked from its From version, or from the stable version when
+// From is empty.
+//
+// The report is returned with the changes made so far when an error occurs.
+func Reconcile(ctx context.Context, client *readme.Client, m *Manifest, options Options) (*Report, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	// The manifest is valid, so the versions can be ordered.
+	versions, _ := orderVersions(m.Versions)
+
+	r := &reconciler{
+		client:   client,
+		options:  options,
+		report:   &Report{DryRun: options.DryRun},
+		manifest: map[string]Version{},
+		lookup:   map[string]string{},
+	}
+	for _, v := range versions {
+		r.manifest[v.Version] = v
+	}
+
+	if err := r.loadVersions(ctx); err != nil {
+		return r.report, err
+	}
+
+	for _, v := range versions {
+		if err := r.version(ctx, v); err != nil {
+			return r.report, fmt.Errorf("unable to reconcile version '%s': %w", v.Version, err)
+		}
+	}
+
+	if err := r.projectContent(ctx, m); err != nil {
+		return r.report, err
+	}
+
+	if options.Prune {
+		if err := r.pruneVersions(ctx); err != nil {
+			return r.report, err
+		}
+	}
+
+	return r.report, nil
+}
+
+// reconciler reconciles the resources of a project.
+type reconciler struct {
+	client  *readme.Client
+	options Options
+	report  *Report
+	// manifest maps the names of the versions in the manifest to their desired state.
+	manifest map[string]Version
+	// existing maps the names of the project's versions to their summary.
+	existing map[string]readme.VersionSummary
+	// stable is the name of the project's stable version.
+	stable string
+	// lookup maps the names of the versions in the manifest to the versions their content is
+	// compared with, which is the nearest existing version in a dry run for versions that don't
+	// exist yet.
+	lookup map[string]string
+}
+
+// loadVersions loads the project's versions.
+func (r *reconciler) loadVersions(ctx context.Context) error {
+	versions, _, err := r.client.Version.GetAllWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get versions: %w", err)
+	}
+
+	r.existing = map[string]readme.VersionSummary{}
+	for _, v := range versions {
+		r.existing[v.Version] = v
+		if v.IsStable {
+			r.stable = v.Version
+		}
+	}
+
+	return nil
+}
+
+// add adds changes to a version to the report.
+func (r *reconciler) add(version string, changes ...plan.Change) {
+	for _, change := range changes {
+		r.report.Changes = append(r.report.Changes, Change{Version: version, Change: change})
+	}
+}
+
+// version reconciles a version and its content.
+func (r *reconciler) version(ctx context.Context, desired Version) error {
+	if err := r.versionFlags(ctx, desired); err != nil {
+		return err
+	}
+	if desired.Stable != nil && *desired.Stable {
+		r.stable = desired.Version
+	}
+
+	desiredState := plan.Desired{}
+	for _, category := range desired.Categories {
+		desiredState.Categories = append(desiredState.Categories, plan.Category{
+			Slug:   category.Slug,
+			Params: readme.CategoryParams{Title: category.Title, Type: category.Type},
+		})
+		desiredState.Docs = append(desiredState.Docs, flattenDocs(category.Slug, "", category.Docs)...)
+	}
+
+	p, err := plan.New(ctx, r.client, desiredState, plan.Options{
+		Version: r.lookup[desired.Version],
+		Prune:   r.options.Prune,
+	})
+	if err != nil {
+		return err
+	}
+	// Changelogs and custom pages belong to the project, so their deletions are left out.
+	p.Changes = only(p.Changes, plan.TypeCategory, plan.TypeDoc)
+	p.Version = desired.Version
+
+	if err := r.apply(ctx, p); err != nil {
+		return err
+	}
+
+	return r.specs(ctx, desired)
+}
+
+// versionFlags creates a version or updates its flags, and sets the version its content is
+// compared with.
+func (r *reconciler) versionFlags(ctx context.Context, desired Version) error {
+	from := desired.From
+	if from == "" {
+		from = r.stable
+	}
+
+	current, exists := r.existing[desired.Version]
+	if !exists {
+		return r.createVersion(ctx, desired, from)
+	}
+	r.lookup[desired.Version] = desired.Version
+
+	var changed []plan.FieldChange
+	compare := func(name string, current bool, desired *bool) {
+		if desired != nil && current != *desired {
+			changed = append(changed, plan.FieldChange{
+				Name: name,
+				Old:  fmt.Sprint(current),
+				New:  fmt.Sprint(*desired),
+			})
+		}
+	}
+	if desired.Codename != "" && current.Codename != desired.Codename {
+		changed = append(changed, plan.FieldChange{Name: "codename", Old: current.Codename, New: desired.Codename})
+	}
+	compare("stable", current.IsStable, desired.Stable)
+	compare("beta", current.IsBeta, desired.Beta)
+	compare("hidden", current.IsHidden, desired.Hidden)
+	compare("deprecated", current.IsDeprecated, desired.Deprecated)
+
+	change := plan.Change{Type: TypeVersion, Slug: desired.Version, Action: plan.ActionNoOp, Fields: changed}
+	if len(changed) > 0 {
+		change.Action = plan.ActionUpdate
+	}
+	r.add(desired.Version, change)
+
+	if change.Action == plan.ActionNoOp || r.options.DryRun {
+		return nil
+	}
+
+	params := versionParams(desired, from)
+	if _, _, err := r.client.Version.UpdateWithContext(ctx, desired.Version, params); err != nil {
+		return fmt.Errorf("unable to update version: %w", err)
+	}
+
+	return nil
+}
+
+// createVersion forks a version from another version. In a dry run, the content of the version is
+// compared with the nearest existing version it will be forked from.
+func (r *reconciler) createVersion(ctx context.Context, desired Version, from string) error {
+	change := plan.Change{
+		Type:   TypeVersion,
+		Slug:   desired.Version,
+		Action: plan.ActionCreate,
+		Fields: []plan.FieldChange{{Name: "from", New: from}},
+	}
+	for _, flag := range []struct {
+		name  string
+		value *bool
+	}{
+		{"stable", desired.Stable},
+		{"beta", desired.Beta},
+		{"hidden", desired.Hidden},
+		{"deprecated", desired.Deprecated},
+	} {
+		if flag.value != nil {
+			change.Fields = append(change.Fields, plan.FieldChange{Name: flag.name, New: fmt.Sprint(*flag.value)})
+		}
+	}
+	r.add(desired.Version, change)
+
+	if r.options.DryRun {
+		lookup, ok := r.lookup[from]
+		if _, exists := r.existing[from]; exists {
+			lookup, ok = from, true
+		}
+		if !ok {
+			return fmt.Errorf("the version '%s' to fork from doesn't exist", from)
+		}
+		r.lookup[desired.Version] = lookup
+
+		return nil
+	}
+
+	params := versionParams(desired, from)
+	// A version can only be deprecated when it's updated.
+	params.IsDeprecated = nil
+	if _, _, err := r.client.Version.CreateWithContext(ctx, params); err != nil {
+		return fmt.Errorf("unable to create version: %w", err)
+	}
+	if desired.Deprecated != nil {
+		update := readme.VersionParams{From: from, IsDeprecated: desired.Deprecated}
+		if _, _, err := r.client.Version.UpdateWithContext(ctx, desired.Version, update); err != nil {
+			return fmt.Errorf("unable to deprecate version: %w", err)
+		}
+	}
+
+	r.existing[desired.Version] = readme.VersionSummary{Version: desired.Version}
+	r.lookup[desired.Version] = desired.Version
+
+	return nil
+}
+
+// versionParams returns the parameters to create or update a version.
+func versionParams(desired Version, from string) readme.VersionParams {
+	return readme.VersionParams{
+		Version:      desired.Version,
+		From:         from,
+		Codename:     desired.Codename,
+		IsStable:     desired.Stable,
+		IsBeta:       desired.Beta,
+		IsHidden:     desired.Hidden,
+		IsDeprecated: desired.Deprecated,
+	}
+}
+
+// flattenDocs returns the desired state of docs and their children, with their category and
+// parent.
+func flattenDocs(category, parent string, docs []Doc) []plan.Doc {
+	var flattened []plan.Doc
+	for _, doc := range docs {
+		flattened = append(flattened, plan.Doc{
+			Slug: doc.Slug,
+			Params: readme.DocParams{
+				Title:         doc.Title,
+				Body:          doc.Body,
+				CategorySlug:  category,
+				ParentDocSlug: parent,
+				Excerpt:       doc.Excerpt,
+				Hidden:        doc.Hidden,
+				Order:         doc.Order,
+				Type:          doc.Type,
+				Metadata:      doc.Metadata,
+			},
+		})
+		flattened = append(flattened, flattenDocs(category, doc.Slug, doc.Children)...)
+	}
+
+	return flattened
+}
+
+// specs reconciles the API specifications of a version. An API specification is added to the
+// reference category with its title, which must be the title of its category in the manifest.
+func (r *reconciler) specs(ctx context.Context, desired Version) error {
+	current, _, err := r.client.APISpecification.GetAllWithContext(ctx,
+		readme.RequestOptions{Version: r.lookup[desired.Version]})
+	if err != nil {
+		return fmt.Errorf("unable to get API specifications: %w", err)
+	}
+
+	keep := map[string]bool{}
+	for _, category := range desired.Categories {
+		for _, spec := range category.Specs {
+			title, err := specTitle(spec.Definition)
+			if err != nil {
+				return err
+			}
+			if title != category.Title {
+				return fmt.Errorf("the title of API specification '%s' isn't the title of category '%s'",
+					title, category.Slug)
+			}
+			keep[title] = true
+
+			if err := r.spec(ctx, desired.Version, category.Slug, title, spec, find(current, title)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !r.options.Prune {
+		return nil
+	}
+
+	for _, spec := range current {
+		if keep[spec.Title] {
+			continue
+		}
+
+		r.add(desired.Version, plan.Change{Type: TypeAPISpecification, Slug: spec.Title, Action: plan.ActionDelete})
+		if r.options.DryRun {
+			continue
+		}
+		if _, _, err := r.client.APISpecification.DeleteWithContext(ctx, spec.ID); err != nil {
+			return fmt.Errorf("unable to delete API specification '%s': %w", spec.Title, err)
+		}
+	}
+
+	return nil
+}
+
+// spec reconciles an API specification with the current one, which is nil when it doesn't exist.
+func (r *reconciler) spec(
+	ctx context.Context,
+	version, category, title string,
+	desired Spec,
+	current *readme.APISpecification,
+) error {
+	change := plan.Change{Type: TypeAPISpecification, Slug: title, Action: plan.ActionNoOp}
+	switch {
+	case current == nil:
+		change.Action = plan.ActionCreate
+		change.Fields = []plan.FieldChange{{Name: "category", New: category}}
+	case r.options.UpdateSpecs:
+		change.Action = plan.ActionUpdate
+	}
+	r.add(version, change)
+
+	if r.options.DryRun {
+		return nil
+	}
+
+	var err error
+	switch change.Action {
+	case plan.ActionCreate:
+		_, _, err = r.client.APISpecification.CreateWithContext(ctx, desired.Definition,
+			readme.RequestOptions{Version: version})
+	case plan.ActionUpdate:
+		_, _, err = r.client.APISpecification.UpdateWithContext(ctx, current.ID, desired.Definition)
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to %s API specification '%s': %w", change.Action, title, err)
+	}
+
+	return nil
+}
+
+// find returns the API specification with a title, or nil when there isn't one.
+func find(specs []readme.APISpecification, title string) *readme.APISpecification {
+	for i := range specs {
+		if specs[i].Title == title {
+			return &specs[i]
+		}
+	}
+
+	return nil
+}
+
+// specTitle returns the title in the "info" object of a JSON or YAML API definition.
+func specTitle(definition string) (string, error) {
+	var parsed struct {
+		Info struct {
+			Title string `yaml:"title"`
+		} `yaml:"info"`
+	}
+	// JSON is valid YAML, so both are decoded as YAML.
+	if err := yaml.Unmarshal([]byte(definition), &parsed); err != nil {
+		return "", fmt.Errorf("unable to parse API definition: %w", err)
+	}
+	if parsed.Info.Title == "" {
+		return "", errors.New("an API definition doesn't have a title")
+	}
+
+	return parsed.Info.Title, nil
+}
+
+// projectContent reconciles the changelogs and custom pages of the project.
+func (r *reconciler) projectContent(ctx context.Context, m *Manifest) error {
+	desired := plan.Desired{}
+	for _, changelog := range m.Changelogs {
+		desired.Changelogs = append(desired.Changelogs, plan.Changelog{
+			Slug: changelog.Slug,
+			Params: readme.ChangelogParams{
+				Title:  changelog.Title,
+				Type:   changelog.Type,
+				Body:   changelog.Body,
+				Hidden: changelog.Hidden,
+			},
+		})
+	}
+	for _, page := range m.CustomPages {
+		desired.CustomPages = append(desired.CustomPages, plan.CustomPage{
+			Slug: page.Slug,
+			Params: readme.CustomPageParams{
+				Title:    page.Title,
+				Body:     page.Body,
+				HTML:     page.HTML,
+				HTMLMode: page.HTMLMode,
+				Hidden:   page.Hidden,
+			},
+		})
+	}
+
+	p, err := plan.New(ctx, r.client, desired, plan.Options{Prune: r.options.Prune})
+	if err != nil {
+		return fmt.Errorf("unable to reconcile changelogs and custom pages: %w", err)
+	}
+	// The categories and docs of the stable version are reconciled with their version.
+	p.Changes = only(p.Changes, plan.TypeChangelog, plan.TypeCustomPage)
+
+	if err := r.apply(ctx, p); err != nil {
+		return fmt.Errorf("unable to reconcile changelogs and custom pages: %w", err)
+	}
+
+	return nil
+}
+
+// apply adds the changes of a plan to the report, and applies it unless it's a dry run.
+func (r *reconciler) apply(ctx context.Context, p *plan.Plan) error {
+	r.add(p.Version, p.Changes...)
+	if r.options.DryRun || !p.HasChanges() {
+		return nil
+	}
+
+	//nolint:wrapcheck // The error is wrapped by the caller.
+	_, err := plan.Apply(ctx, r.client, p)
+
+	return err
+}
+
+// only returns the changes to resources of some types.
+func only(changes []plan.Change, types ...plan.ResourceType) []plan.Change {
+	var kept []plan.Change
+	for _, change := range changes {
+		for _, resourceType := range types {
+			if change.Type == resourceType {
+				kept = append(kept, change)
+
+				break
+			}
+		}
+	}
+
+	return kept
+}
+
+// pruneVersions deletes the versions that aren't in the manifest, except the stable version.
+func (r *reconciler) pruneVersions(ctx context.Context) error {
+	for _, name := range slices.Sorted(maps.Keys(r.existing)) {
+		if _, ok := r.manifest[name]; ok || name == r.stable {
+			continue
+		}
+
+		r.add(name, plan.Change{Type: TypeVersion, Slug: name, Action: plan.ActionDelete})
+		if r.options.DryRun {
+			continue
+		}
+		if _, _, err := r.client.Version.DeleteWithContext(ctx, name); err != nil {
+			return fmt.Errorf("unable to delete version '%s': %w", name, err)
+		}
+	}
+
+	return nil
+}
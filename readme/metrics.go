@@ -0,0 +1,138 @@
+package readme
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// MetricsRecorder records metrics about the requests made by a Client.
+//
+// RecordRequest is called once for every request made with Client.APIRequest(), including each
+// page of a paginated request, after any retries. Implementations must be safe for concurrent use.
+//
+// See the readmeprom package for an implementation that exports Prometheus metrics.
+type MetricsRecorder interface {
+	RecordRequest(ctx context.Context, metrics RequestMetrics)
+}
+
+// RequestMetrics describes a request made to the ReadMe API.
+type RequestMetrics struct {
+	// Service is the name of the service that made the request, such as "Category". This is empty
+	// for requests made directly with Client.APIRequest().
+	Service string
+	// Operation is the name of the service method that made the request, such as "GetAll". This is
+	// empty for requests made directly with Client.APIRequest().
+	Operation string
+	// Endpoint is the first segment of the request's endpoint, such as "categories". It identifies
+	// the API resource without the slugs and IDs in the path, so it's suitable as a metric label.
+	Endpoint string
+	// Method is the HTTP method of the request.
+	Method string
+	// StatusCode is the HTTP status code of the response, or 0 if no response was received.
+	StatusCode int
+	// Duration is the time taken to complete the request, including any retries.
+	Duration time.Duration
+	// BytesSent is the size of the request payload.
+	BytesSent int
+	// BytesReceived is the size of the response body.
+	BytesReceived int
+	// Retries is the number of times the request was retried.
+	Retries int
+	// Err is the error returned for the request, if any.
+	Err error
+}
+
+// operationContextKey is the context key for the service and operation making a request.
+type operationContextKey struct{}
+
+// operation identifies the service method that makes a request.
+type operation struct {
+	service string
+	name    string
+}
+
+// withOperation returns a copy of the context labeled with the service and operation making
+// requests.
+func withOperation(ctx context.Context, service, name string) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, operation{service: service, name: name})
+}
+
+// operationFromContext returns the service and operation making a request.
+func operationFromContext(ctx context.Context) operation {
+	op, _ := ctx.Value(operationContextKey{}).(operation)
+
+	return op
+}
+
+// recordMetrics reports the metrics of a request to the client's metrics recorder.
+func (c *Client) recordMetrics(
+	ctx context.Context,
+	request *APIRequest,
+	response *APIResponse,
+	duration time.Duration,
+	err error,
+) {
+	if c.MetricsRecorder == nil {
+		return
+	}
+
+	op := operationFromContext(ctx)
+	metrics := RequestMetrics{
+		Service:   op.service,
+		Operation: op.name,
+		Endpoint:  c.resource(requestPath(request)),
+		Method:    request.Method,
+		Duration:  duration,
+		BytesSent: len(request.Payload),
+		Err:       err,
+	}
+
+	if response != nil {
+		if response.HTTPResponse != nil {
+			metrics.StatusCode = response.HTTPResponse.StatusCode
+		}
+		metrics.BytesReceived = len(response.Body)
+		if response.Attempts > 1 {
+			metrics.Retries = response.Attempts - 1
+		}
+	}
+
+	c.MetricsRecorder.RecordRequest(ctx, metrics)
+}
+
+// requestPath returns the path of a request's URL, or its endpoint without the query string when
+// the URL isn't set.
+func requestPath(request *APIRequest) string {
+	if request.URL != "" {
+		if parsed, err := url.Parse(request.URL); err == nil {
+			return parsed.Path
+		}
+	}
+	path, _, _ := strings.Cut(request.Endpoint, "?")
+
+	return path
+}
+
+// resource returns the first segment of a path to the ReadMe API or the image API after the base
+// path of the API, such as "categories".
+func (c *Client) resource(path string) string {
+	resource, _, _ := strings.Cut(c.endpointPath(path), "/")
+
+	return resource
+}
+
+// endpointPath returns a path to the ReadMe API or the image API without the base path of the API
+// and the leading slash, such as "categories/getting-started".
+func (c *Client) endpointPath(path string) string {
+	for _, baseURL := range []string{c.APIURL, c.ImageAPIURL} {
+		if base, err := url.Parse(baseURL); err == nil && base.Path != "" && strings.HasPrefix(path, base.Path) {
+			path = strings.TrimPrefix(path, base.Path)
+
+			break
+		}
+	}
+
+	return strings.TrimPrefix(path, "/")
+}
@@ -0,0 +1,169 @@
+package readme_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeMetricsRecorder is a MetricsRecorder that keeps the recorded metrics in memory.
+type fakeMetricsRecorder struct {
+	mu      sync.Mutex
+	metrics []readme.RequestMetrics
+}
+
+func (r *fakeMetricsRecorder) RecordRequest(_ context.Context, metrics readme.RequestMetrics) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.metrics = append(r.metrics, metrics)
+}
+
+func Test_MetricsRecorder(t *testing.T) {
+	t.Run("when a request is retried", func(t *testing.T) {
+		// Arrange
+		recorder := &fakeMetricsRecorder{}
+		client, _ := readme.NewClient("test",
+			readme.WithAPIURL(TestClientBaseURL),
+			readme.WithMetricsRecorder(recorder),
+			readme.WithRetryPolicy(&readme.RetryPolicy{MaxAttempts: 2, RetryableStatusCodes: []int{503}}),
+		)
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(503).
+			JSON(readme.APIErrorResponse{Error: "UNAVAILABLE"})
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(200).
+			JSON(testdata.Project)
+		defer gock.Off()
+
+		// Act
+		_, apiResponse, err := client.Project.Get()
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Len(t, recorder.metrics, 1, "it records the request once")
+		got := recorder.metrics[0]
+		assert.Equal(t, "Project", got.Service, "it records the service")
+		assert.Equal(t, "Get", got.Operation, "it records the operation")
+		assert.Equal(t, "GET", got.Method, "it records the method")
+		assert.Equal(t, 200, got.StatusCode, "it records the status code")
+		assert.Equal(t, 1, got.Retries, "it records the retries")
+		assert.Equal(t, len(apiResponse.Body), got.BytesReceived, "it records the bytes received")
+		assert.Positive(t, got.Duration, "it records the duration")
+	})
+
+	t.Run("when a request sends a payload and fails", func(t *testing.T) {
+		// Arrange
+		recorder := &fakeMetricsRecorder{}
+		client, _ := readme.NewClient("test",
+			readme.WithAPIURL(TestClientBaseURL),
+			readme.WithMetricsRecorder(recorder),
+		)
+		gock.New(TestClient.APIURL).
+			Post(readme.ChangelogEndpoint).
+			Reply(400).
+			JSON(readme.APIErrorResponse{Error: "CHANGELOG_INVALID"})
+		defer gock.Off()
+
+		// Act
+		_, apiResponse, err := client.Changelog.Create(readme.ChangelogParams{Title: "Test", Body: "Test"})
+
+		// Assert
+		assert.Error(t, err, "it returns an error")
+		assert.Len(t, recorder.metrics, 1, "it records the request")
+		got := recorder.metrics[0]
+		assert.Equal(t, "Changelog", got.Service, "it records the service")
+		assert.Equal(t, "Create", got.Operation, "it records the operation")
+		assert.Equal(t, "changelogs", got.Endpoint, "it records the endpoint")
+		assert.Equal(t, 400, got.StatusCode, "it records the status code")
+		assert.Equal(t, len(apiResponse.Request.Payload), got.BytesSent, "it records the bytes sent")
+		assert.ErrorIs(t, got.Err, readme.ErrValidation, "it records the error")
+	})
+
+	t.Run("when an API specification is created", func(t *testing.T) {
+		// Arrange
+		recorder := &fakeMetricsRecorder{}
+		client, _ := readme.NewClient("test",
+			readme.WithAPIURL(TestClientBaseURL),
+			readme.WithMetricsRecorder(recorder),
+		)
+		gock.New(TestClient.APIURL).
+			Post(readme.APISpecificationEndpoint).
+			Reply(201).
+			JSON(readme.APISpecificationSaved{ID: testdata.APISpecifications[0].ID})
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.APISpecification.Create(`{"openapi": "3.0.0"}`)
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Len(t, recorder.metrics, 1, "it records the request")
+		assert.Equal(t, "Create", recorder.metrics[0].Operation, "it records the operation that was called")
+	})
+
+	t.Run("when an operation makes multiple requests", func(t *testing.T) {
+		// Arrange
+		recorder := &fakeMetricsRecorder{}
+		client, _ := readme.NewClient("test",
+			readme.WithAPIURL(TestClientBaseURL),
+			readme.WithMetricsRecorder(recorder),
+		)
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			Reply(200).
+			SetHeader("x-total-count", "1").
+			SetHeader("link", `<>; rel="next", <>; rel="prev", <>; rel="last"`).
+			JSON([]readme.Category{testdata.Categories[0]})
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint + "/" + testdata.Categories[0].Slug).
+			Reply(200).
+			JSON(testdata.Categories[0])
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.Category.Get("id:" + testdata.Categories[0].ID)
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Len(t, recorder.metrics, 2, "it records every request")
+		assert.Equal(t, "GetAll", recorder.metrics[0].Operation, "it records the operation that made the request")
+		assert.Equal(t, "Get", recorder.metrics[1].Operation, "it records the operation that made the request")
+		assert.Equal(t, []string{"categories", "categories"},
+			[]string{recorder.metrics[0].Endpoint, recorder.metrics[1].Endpoint},
+			"it records the endpoint without the query string or slug")
+	})
+
+	t.Run("when a request is made directly", func(t *testing.T) {
+		// Arrange
+		recorder := &fakeMetricsRecorder{}
+		client, _ := readme.NewClient("test",
+			readme.WithAPIURL(TestClientBaseURL),
+			readme.WithMetricsRecorder(recorder),
+		)
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(200).
+			JSON(testdata.Project)
+		defer gock.Off()
+
+		// Act
+		_, err := client.APIRequest(&readme.APIRequest{
+			Method:       "GET",
+			Endpoint:     readme.ProjectEndpoint,
+			OkStatusCode: []int{200},
+		})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Len(t, recorder.metrics, 1, "it records the request")
+		assert.Empty(t, recorder.metrics[0].Service, "it doesn't record a service")
+		assert.Empty(t, recorder.metrics[0].Operation, "it doesn't record an operation")
+	})
+}
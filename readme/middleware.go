@@ -0,0 +1,84 @@
+package readme
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Handler performs a single attempt of an API request and returns the API response.
+//
+// The *http.Request is prepared from the *APIRequest with the client's headers, authentication and
+// payload set. The returned error is only set when a response couldn't be obtained, such as a
+// network error. A response with an unexpected status code isn't an error at this stage; it's
+// checked against the request's OkStatusCode after the handler returns.
+type Handler func(ctx context.Context, request *APIRequest, httpRequest *http.Request) (*APIResponse, error)
+
+// Middleware wraps a Handler to add behavior to every request made by a Client.
+//
+// A middleware may modify the outgoing *http.Request before calling the next handler, inspect or
+// modify the *APIResponse it returns, or short-circuit the request by returning a response without
+// calling the next handler. A response returned without calling the next handler must have its
+// HTTPResponse and Body set.
+//
+// Middleware is applied to every attempt of every request, including each page of a paginated
+// request, retries and multipart uploads:
+//
+//	func AuditLog(next readme.Handler) readme.Handler {
+//		return func(ctx context.Context, req *readme.APIRequest, httpReq *http.Request) (*readme.APIResponse, error) {
+//			response, err := next(ctx, req, httpReq)
+//			log.Printf("%s %s", httpReq.Method, httpReq.URL)
+//
+//			return response, err
+//		}
+//	}
+type Middleware func(next Handler) Handler
+
+// handler returns the client's middleware chain wrapped around the handler that sends requests.
+//
+// The first middleware in the client's Middleware slice is the outermost. The response cache, when
+// enabled, is the innermost so cached responses pass through every middleware. Dry-run mode, when
+// enabled, wraps the cache so planned operations don't remove cached responses.
+func (c *Client) handler() Handler {
+	handler := c.send
+	if c.CacheConfig != nil && c.CacheConfig.Store != nil {
+		handler = c.cache(handler)
+	}
+	if c.DryRun != nil {
+		handler = c.dryRun(handler)
+	}
+	for i := len(c.Middleware) - 1; i >= 0; i-- {
+		handler = c.Middleware[i](handler)
+	}
+
+	return handler
+}
+
+// send is the innermost Handler, which sends the HTTP request and reads the response body.
+func (c *Client) send(_ context.Context, request *APIRequest, httpRequest *http.Request) (*APIResponse, error) {
+	res, err := c.HTTPClient.Do(httpRequest)
+	if err != nil {
+		return nil, &transportError{err: fmt.Errorf("unable to make request: %w", err)}
+	}
+
+	apiResponse := &APIResponse{HTTPResponse: res, Request: request}
+
+	if res.Body == nil {
+		return apiResponse, fmt.Errorf(
+			"response body is nil in %s request to %s", httpRequest.Method, httpRequest.URL)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return apiResponse, &transportError{err: fmt.Errorf("unable to read response: %w", err)}
+	}
+	apiResponse.Body = body
+
+	err = res.Body.Close()
+	if err != nil {
+		return apiResponse, fmt.Errorf("problem closing HTTP response body")
+	}
+
+	return apiResponse, nil
+}
@@ -0,0 +1,238 @@
+package readme_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingMiddleware returns a middleware that records the URL of every request it sees.
+func recordingMiddleware(name string, calls *[]string) readme.Middleware {
+	return func(next readme.Handler) readme.Handler {
+		return func(
+			ctx context.Context,
+			request *readme.APIRequest,
+			httpRequest *http.Request,
+		) (*readme.APIResponse, error) {
+			*calls = append(*calls, name+" "+httpRequest.URL.RequestURI())
+
+			return next(ctx, request, httpRequest)
+		}
+	}
+}
+
+func Test_Middleware(t *testing.T) {
+	t.Run("when multiple middleware are configured", func(t *testing.T) {
+		// Arrange
+		var calls []string
+		client, _ := readme.NewClient("test",
+			readme.WithAPIURL(TestClientBaseURL),
+			readme.WithMiddleware(recordingMiddleware("first", &calls), recordingMiddleware("second", &calls)),
+		)
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(200).
+			JSON(testdata.Project)
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.Project.Get()
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, []string{"first /api/v1/", "second /api/v1/"}, calls,
+			"it applies the middleware in order")
+	})
+
+	t.Run("when a middleware sets a header", func(t *testing.T) {
+		// Arrange
+		client, _ := readme.NewClient("test",
+			readme.WithAPIURL(TestClientBaseURL),
+			readme.WithMiddleware(func(next readme.Handler) readme.Handler {
+				return func(
+					ctx context.Context,
+					request *readme.APIRequest,
+					httpRequest *http.Request,
+				) (*readme.APIResponse, error) {
+					httpRequest.Header.Set("x-audit-id", "abc123")
+
+					return next(ctx, request, httpRequest)
+				}
+			}),
+		)
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			MatchHeader("x-audit-id", "abc123").
+			Reply(200).
+			JSON(testdata.Project)
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.Project.Get()
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.True(t, gock.IsDone(), "it sends the header set by the middleware")
+	})
+
+	t.Run("when a middleware short-circuits the request", func(t *testing.T) {
+		// Arrange
+		client, _ := readme.NewClient("test",
+			readme.WithAPIURL(TestClientBaseURL),
+			readme.WithMiddleware(func(_ readme.Handler) readme.Handler {
+				return func(
+					_ context.Context,
+					request *readme.APIRequest,
+					_ *http.Request,
+				) (*readme.APIResponse, error) {
+					return &readme.APIResponse{
+						Body:         []byte(testdata.ToJSON(testdata.Project)),
+						HTTPResponse: &http.Response{StatusCode: 200, Header: http.Header{}},
+						Request:      request,
+					}, nil
+				}
+			}),
+		)
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(500)
+		defer gock.Off()
+
+		// Act
+		got, _, err := client.Project.Get()
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, testdata.Project, got, "it returns the response from the middleware")
+		assert.False(t, gock.IsDone(), "it doesn't make the API call")
+	})
+
+	t.Run("when a middleware returns no response", func(t *testing.T) {
+		// Arrange
+		client, _ := readme.NewClient("test",
+			readme.WithAPIURL(TestClientBaseURL),
+			readme.WithMiddleware(func(_ readme.Handler) readme.Handler {
+				return func(context.Context, *readme.APIRequest, *http.Request) (*readme.APIResponse, error) {
+					return nil, nil
+				}
+			}),
+		)
+
+		// Act
+		_, _, err := client.Project.Get()
+
+		// Assert
+		assert.ErrorContains(t, err, "no response returned", "it returns an error")
+	})
+
+	t.Run("when the request fails", func(t *testing.T) {
+		// Arrange
+		var seen error
+		client, _ := readme.NewClient("test",
+			readme.WithAPIURL(TestClientBaseURL),
+			readme.WithMiddleware(func(next readme.Handler) readme.Handler {
+				return func(
+					ctx context.Context,
+					request *readme.APIRequest,
+					httpRequest *http.Request,
+				) (*readme.APIResponse, error) {
+					response, err := next(ctx, request, httpRequest)
+					seen = err
+
+					return response, err
+				}
+			}),
+		)
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			ReplyError(errors.New("connection refused"))
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.Project.Get()
+
+		// Assert
+		assert.ErrorContains(t, err, "connection refused", "it returns the error")
+		assert.ErrorContains(t, seen, "connection refused", "the middleware sees the error")
+	})
+
+	t.Run("when the request is paginated", func(t *testing.T) {
+		// Arrange
+		var calls []string
+		client, _ := readme.NewClient("test",
+			readme.WithAPIURL(TestClientBaseURL),
+			readme.WithMiddleware(recordingMiddleware("mw", &calls)),
+		)
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			MatchParam("page", "1").
+			Reply(200).
+			SetHeader("x-total-count", "2").
+			SetHeader("link", `</categories?perPage=1&page=2>; rel="next", <>; rel="prev", <>; rel="last"`).
+			JSON([]readme.Category{testdata.Categories[0]})
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			MatchParam("page", "2").
+			Reply(200).
+			SetHeader("x-total-count", "2").
+			SetHeader("link", `<>; rel="next", </categories?perPage=1&page=1>; rel="prev", <>; rel="last"`).
+			JSON([]readme.Category{testdata.Categories[1]})
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.Category.GetAll(readme.RequestOptions{PerPage: 1})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, []string{
+			"mw /api/v1/categories?perPage=1&page=1",
+			"mw /api/v1/categories?perPage=1&page=2",
+		}, calls, "it applies the middleware to every page")
+	})
+
+	t.Run("when uploading an image", func(t *testing.T) {
+		// Arrange
+		var payload string
+		client, _ := readme.NewClient("test",
+			readme.WithAPIURL(TestClientBaseURL),
+			readme.WithMiddleware(func(next readme.Handler) readme.Handler {
+				return func(
+					ctx context.Context,
+					request *readme.APIRequest,
+					httpRequest *http.Request,
+				) (*readme.APIResponse, error) {
+					body, _ := httpRequest.GetBody()
+					data, _ := io.ReadAll(body)
+					payload = string(data)
+
+					return next(ctx, request, httpRequest)
+				}
+			}),
+		)
+		gock.New(readme.ImageAPIURL).
+			Post("/").
+			Reply(200).
+			JSON([]any{"https://files.readme.io/image.png", "image.png", 1, 1, "#000000"})
+		defer gock.Off()
+
+		image, _ := base64.StdEncoding.DecodeString(
+			"iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNk+A8AAQUBAScY42YAAAAASUVORK5CYII")
+
+		// Act
+		_, _, err := client.Image.Upload(image, "image.png")
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.True(t, strings.Contains(payload, `filename="image.png"`),
+			"it applies the middleware to multipart requests")
+	})
+}
@@ -0,0 +1,248 @@
+package readme
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ClientOption configures a Client when it's created with NewClient().
+//
+// Options are applied in the order they're provided, except WithTimeout(), which is applied after
+// the others. Options that take a pointer, an interface or a function return an error when it's
+// nil; a feature is left disabled by omitting its option.
+type ClientOption func(*Client) error
+
+// WithAPIURL sets the base URL for the ReadMe API.
+func WithAPIURL(apiURL string) ClientOption {
+	return func(c *Client) error {
+		if err := validateURL(apiURL); err != nil {
+			return fmt.Errorf("invalid API URL: %w", err)
+		}
+		c.APIURL = apiURL
+
+		return nil
+	}
+}
+
+// WithImageAPIURL sets the base URL for uploading images to ReadMe.
+func WithImageAPIURL(imageAPIURL string) ClientOption {
+	return func(c *Client) error {
+		if err := validateURL(imageAPIURL); err != nil {
+			return fmt.Errorf("invalid image API URL: %w", err)
+		}
+		c.ImageAPIURL = imageAPIURL
+
+		return nil
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to make requests.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("HTTP client must not be nil")
+		}
+		c.HTTPClient = httpClient
+
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout of the HTTP client used to make requests.
+//
+// The timeout is set after the other options are applied, so it's kept when WithHTTPClient() comes
+// later. The HTTP client is copied before setting the timeout, so a client provided with
+// WithHTTPClient() isn't modified.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) error {
+		if timeout < 0 {
+			return fmt.Errorf("timeout must not be negative (got: %s)", timeout)
+		}
+		c.timeout = &timeout
+
+		return nil
+	}
+}
+
+// WithUserAgent appends a product identifier to the default User-Agent header sent with requests.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		if userAgent != "" {
+			c.UserAgent = c.UserAgent + " " + userAgent
+		}
+
+		return nil
+	}
+}
+
+// WithDefaultVersion sets the project version used for requests that don't specify a version in
+// their RequestOptions.
+func WithDefaultVersion(version string) ClientOption {
+	return func(c *Client) error {
+		c.DefaultVersion = version
+
+		return nil
+	}
+}
+
+// WithDefaultHeaders sets HTTP headers that are sent with every request.
+//
+// Headers set by a request take precedence over the default headers.
+func WithDefaultHeaders(headers RequestHeader) ClientOption {
+	return func(c *Client) error {
+		if c.DefaultHeaders == nil {
+			c.DefaultHeaders = RequestHeader{}
+		}
+		for header, value := range headers {
+			c.DefaultHeaders[header] = value
+		}
+
+		return nil
+	}
+}
+
+// WithLogger enables logging of requests to the provided logger with the levels from
+// DefaultLogConfig().
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) error {
+		if logger == nil {
+			return errors.New("logger must not be nil")
+		}
+		c.LogConfig = DefaultLogConfig(logger)
+
+		return nil
+	}
+}
+
+// WithLogConfig sets the configuration for logging requests.
+func WithLogConfig(config *LogConfig) ClientOption {
+	return func(c *Client) error {
+		if config == nil {
+			return errors.New("log config must not be nil")
+		}
+		c.LogConfig = config
+
+		return nil
+	}
+}
+
+// WithMetricsRecorder sets the recorder that metrics about every request are reported to.
+func WithMetricsRecorder(recorder MetricsRecorder) ClientOption {
+	return func(c *Client) error {
+		if recorder == nil {
+			return errors.New("metrics recorder must not be nil")
+		}
+		c.MetricsRecorder = recorder
+
+		return nil
+	}
+}
+
+// WithMiddleware appends middleware to the chain applied to every request.
+//
+// Middleware is applied in the order it's provided, with the first middleware being the outermost.
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(c *Client) error {
+		for _, m := range middleware {
+			if m == nil {
+				return errors.New("middleware must not be nil")
+			}
+		}
+		c.Middleware = append(c.Middleware, middleware...)
+
+		return nil
+	}
+}
+
+// WithTracer enables tracing of requests and operations with spans created by the provided tracer.
+func WithTracer(tracer Tracer) ClientOption {
+	return func(c *Client) error {
+		if tracer == nil {
+			return errors.New("tracer must not be nil")
+		}
+		c.Tracer = tracer
+
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the policy for retrying requests that fail with a transient error.
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		if policy == nil {
+			return errors.New("retry policy must not be nil")
+		}
+		c.RetryPolicy = policy
+
+		return nil
+	}
+}
+
+// WithRateLimiter sets the rate limiter used to pace requests.
+func WithRateLimiter(limiter RateLimiter) ClientOption {
+	return func(c *Client) error {
+		if limiter == nil {
+			return errors.New("rate limiter must not be nil")
+		}
+		c.RateLimiter = limiter
+
+		return nil
+	}
+}
+
+// WithCache enables caching of API responses in the store, which are returned without contacting
+// the API for the duration of the TTL. See CacheConfig for details.
+func WithCache(store CacheStore, ttl time.Duration) ClientOption {
+	return func(c *Client) error {
+		if store == nil {
+			return errors.New("cache store must not be nil")
+		}
+		c.CacheConfig = &CacheConfig{Store: store, TTL: ttl}
+
+		return nil
+	}
+}
+
+// WithResolverCache sets the cache that remembers the slugs and versions that IDs resolve to. The
+// cache may be shared between clients.
+func WithResolverCache(cache *ResolverCache) ClientOption {
+	return func(c *Client) error {
+		if cache == nil {
+			return errors.New("resolver cache must not be nil")
+		}
+		c.ResolverCache = cache
+
+		return nil
+	}
+}
+
+// WithDryRun enables dry-run mode, which records POST, PUT and DELETE requests in the DryRun as
+// planned operations instead of sending them to the API.
+func WithDryRun(dryRun *DryRun) ClientOption {
+	return func(c *Client) error {
+		if dryRun == nil {
+			return errors.New("dry run must not be nil")
+		}
+		c.DryRun = dryRun
+
+		return nil
+	}
+}
+
+// validateURL checks that a string is an absolute URL.
+func validateURL(value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("unable to parse '%s': %w", value, err)
+	}
+
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return fmt.Errorf("'%s' must be an absolute URL", value)
+	}
+
+	return nil
+}
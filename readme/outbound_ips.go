@@ -1,5 +1,7 @@
 package readme
 
+import "context"
+
 // OutboundIPEndpoint is the ReadMe API URL endpoint for retrieving ReadMe's outbound IP addresses.
 const OutboundIPEndpoint = "/outbound-ips"
 
@@ -16,6 +18,9 @@ type OutboundIPService interface {
 	//
 	// API Reference: https://docs.readme.com/main/reference/getoutboundips
 	Get() ([]OutboundIP, *APIResponse, error)
+
+	// GetWithContext retrieves ReadMe's outbound IP addresses using the provided context.
+	GetWithContext(ctx context.Context) ([]OutboundIP, *APIResponse, error)
 }
 
 // OutboundIPClient handles communication with the OutboundIP related methods of the ReadMe.com API.
@@ -34,8 +39,15 @@ var _ OutboundIPService = &OutboundIPClient{}
 //
 // API Reference: https://docs.readme.com/main/reference/getoutboundips
 func (c OutboundIPClient) Get() ([]OutboundIP, *APIResponse, error) {
+	return c.GetWithContext(context.Background())
+}
+
+// GetWithContext retrieves ReadMe's outbound IP addresses using the provided context.
+func (c OutboundIPClient) GetWithContext(ctx context.Context) ([]OutboundIP, *APIResponse, error) {
+	ctx = withOperation(ctx, "OutboundIP", "Get")
+
 	ipList := []OutboundIP{}
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "GET",
 		Endpoint:     OutboundIPEndpoint,
 		UseAuth:      false,
@@ -0,0 +1,452 @@
+package readme
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// defaultPerPage is the number of items requested in each page when RequestOptions.PerPage isn't
+	// set.
+	defaultPerPage = 100
+
+	// maxPages is the maximum number of pages requested by a single paginated operation, which guards
+	// against pagination links that never end.
+	maxPages = 10000
+)
+
+// Page is a page of results from a paginated API endpoint.
+type Page[T any] struct {
+	// Items lists the results in the page.
+	Items []T
+	// Number is the page number, starting at 1.
+	Number int
+	// PerPage is the maximum number of items requested in the page.
+	PerPage int
+	// TotalCount is the total number of items reported by the API in the x-total-count header, or 0
+	// if the header isn't set.
+	TotalCount int
+	// Response is the API response for the page.
+	Response *APIResponse
+}
+
+// Pagination is the pagination information of a response to a request for a page of results.
+type Pagination struct {
+	// Count is the number of items in the page.
+	Count int
+	// Links holds the pagination links from the Link header of the response.
+	Links
+	// Page is the page number, starting at 1.
+	Page int
+	// PerPage is the maximum number of items requested in the page.
+	PerPage int
+	// TotalCount is the total number of items reported by the API in the x-total-count header, or 0
+	// if the header isn't set or can't be parsed.
+	TotalCount int
+}
+
+// fetchPages returns an iterator that requests each page of a paginated endpoint as the iteration
+// progresses.
+//
+// No further pages are requested once the consumer stops iterating. An error ends the iteration and
+// is yielded with a Page holding the page number and the API response, if any.
+func fetchPages[T any](
+	ctx context.Context,
+	c *Client,
+	endpoint string,
+	options *RequestOptions,
+) iter.Seq2[Page[T], error] {
+	page := 1
+	if options != nil && options.Page != 0 {
+		page = options.Page
+	}
+
+	// The number of items before the requested page.
+	seen := (page - 1) * perPageOption(options)
+
+	return followPages[T](ctx, c, endpoint, options, page, "", seen, map[string]bool{})
+}
+
+// followPages returns an iterator that requests a page of a paginated endpoint, from pageURL when
+// it's set, and then follows the "next" link of each page as the iteration progresses.
+//
+// seen is the number of items before the page, and visited lists the links already followed.
+func followPages[T any](
+	ctx context.Context,
+	c *Client,
+	endpoint string,
+	options *RequestOptions,
+	page int,
+	pageURL string,
+	seen int,
+	visited map[string]bool,
+) iter.Seq2[Page[T], error] {
+	return func(yield func(Page[T], error) bool) {
+		perPage := perPageOption(options)
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(Page[T]{Number: page, PerPage: perPage}, err)
+
+				return
+			}
+
+			result, next, err := fetchPage[T](ctx, c, endpoint, options, page, pageURL)
+			follow := false
+			if err == nil && next != nil {
+				seen += len(result.Items)
+				follow, err = followLink(result, next, seen, visited)
+			}
+
+			if err != nil {
+				yield(result, err)
+
+				return
+			}
+
+			if !yield(result, nil) || !follow {
+				return
+			}
+
+			pageURL = next.URL
+			page = linkPageNumber(next, page+1)
+		}
+	}
+}
+
+// followLink checks whether the "next" link of a page should be followed and returns false when
+// pagination should stop.
+//
+// These checks guard against pagination links that never end: pagination stops when a page is
+// empty, when the API has returned the total number of items in its x-total-count header or when
+// the next link doesn't advance to a later page. Following a link that was already requested, or
+// more than maxPages links, returns an error.
+func followLink[T any](page Page[T], next *Link, seen int, visited map[string]bool) (bool, error) {
+	if header := page.Response.HTTPResponse.Header.Get(TotalCountHeader); header != "" {
+		totalCount, err := strconv.Atoi(header)
+		if err != nil {
+			return false, fmt.Errorf("unable to parse '%s' header: %w", TotalCountHeader, err)
+		}
+
+		if seen >= totalCount {
+			return false, nil
+		}
+	}
+
+	if len(page.Items) == 0 || linkPageNumber(next, page.Number+1) <= page.Number {
+		return false, nil
+	}
+
+	if visited[next.URL] || len(visited) >= maxPages {
+		return false, fmt.Errorf("unable to follow pagination link '%s' after page %d: the link was already "+
+			"requested or the maximum of %d pages was reached", next.URL, page.Number, maxPages)
+	}
+	visited[next.URL] = true
+
+	return true, nil
+}
+
+// fetchPage requests a single page of a paginated endpoint and returns it along with the link to
+// the next page, which is nil on the last page.
+//
+// The page is requested from pageURL when it's set, otherwise the endpoint is requested with the
+// page number.
+func fetchPage[T any](
+	ctx context.Context,
+	c *Client,
+	endpoint string,
+	options *RequestOptions,
+	page int,
+	pageURL string,
+) (Page[T], *Link, error) {
+	var items []T
+	apiRequest := &APIRequest{
+		Method:       "GET",
+		Endpoint:     endpoint,
+		UseAuth:      true,
+		OkStatusCode: []int{200},
+		Response:     &items,
+	}
+	if options != nil {
+		apiRequest.RequestOptions = *options
+	}
+
+	apiResponse, links, err := c.paginatedRequest(ctx, apiRequest, page, pageURL)
+	result := Page[T]{
+		Items:    items,
+		Number:   page,
+		PerPage:  perPageOption(options),
+		Response: apiResponse,
+	}
+	if apiResponse != nil && apiResponse.Pagination != nil {
+		apiResponse.Pagination.Count = len(items)
+		result.TotalCount = apiResponse.Pagination.TotalCount
+	}
+
+	return result, links.Next, err
+}
+
+// linkPageNumber returns the page number from the "page" query parameter of a pagination link, or
+// the fallback if the link doesn't have one.
+func linkPageNumber(link *Link, fallback int) int {
+	parsed, err := url.Parse(link.URL)
+	if err != nil {
+		return fallback
+	}
+
+	page, err := strconv.Atoi(parsed.Query().Get("page"))
+	if err != nil || page < 1 {
+		return fallback
+	}
+
+	return page
+}
+
+// perPageOption returns the number of items to request in each page.
+func perPageOption(options *RequestOptions) int {
+	if options != nil && options.PerPage != 0 {
+		return options.PerPage
+	}
+
+	return defaultPerPage
+}
+
+// fetchItems returns an iterator over the items in each page of a paginated endpoint, requesting
+// each page as the iteration progresses.
+func fetchItems[T any](
+	ctx context.Context,
+	c *Client,
+	endpoint string,
+	options *RequestOptions,
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page, err := range fetchPages[T](ctx, c, endpoint, options) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+
+				return
+			}
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// fetchAllPages requests each page of a paginated endpoint and returns the results of every page
+// along with the API response of the last page.
+//
+// The context is checked before each page is requested so a cancelled context stops the loop
+// without making further requests.
+//
+// When RequestOptions.Concurrency is greater than 1, the pages after the first one are requested
+// concurrently.
+func fetchAllPages[T any](
+	ctx context.Context,
+	c *Client,
+	endpoint string,
+	options *RequestOptions,
+) ([]T, *APIResponse, error) {
+	ctx, span := c.startSpan(ctx, SpanStart{
+		Name:     "readme.fetchAllPages",
+		Kind:     SpanKindInternal,
+		Endpoint: endpoint,
+	})
+
+	var results []T
+	var apiResponse *APIResponse
+	var err error
+	if options != nil && options.Concurrency > 1 {
+		results, apiResponse, err = fetchAllPagesConcurrently[T](ctx, c, endpoint, options)
+	} else {
+		results, apiResponse, err = fetchAllPagesSequentially[T](ctx, c, endpoint, options)
+	}
+
+	span.End(SpanEnd{Err: err})
+
+	return results, apiResponse, err
+}
+
+// fetchAllPagesSequentially requests each page of a paginated endpoint one at a time by following
+// the "next" links.
+func fetchAllPagesSequentially[T any](
+	ctx context.Context,
+	c *Client,
+	endpoint string,
+	options *RequestOptions,
+) ([]T, *APIResponse, error) {
+	var results []T
+	var apiResponse *APIResponse
+	var responses []*APIResponse
+	for page, pageErr := range fetchPages[T](ctx, c, endpoint, options) {
+		if page.Response != nil {
+			apiResponse = page.Response
+			responses = append(responses, page.Response)
+		}
+
+		if pageErr != nil {
+			return results, withPages(apiResponse, responses), fmt.Errorf("unable to retrieve data: %w", pageErr)
+		}
+
+		results = append(results, page.Items...)
+	}
+
+	return results, withPages(apiResponse, responses), nil
+}
+
+// fetchAllPagesConcurrently requests the first page of a paginated endpoint and then requests the
+// remaining pages concurrently, up to RequestOptions.Concurrency at a time.
+//
+// The number of remaining pages is computed from the x-total-count header of the first page. When
+// the header is missing, the remaining pages are requested one at a time by following the "next"
+// links instead. The results are returned in page order. An error from any page, or a cancelled
+// context, cancels the requests for the other pages.
+func fetchAllPagesConcurrently[T any](
+	ctx context.Context,
+	c *Client,
+	endpoint string,
+	options *RequestOptions,
+) ([]T, *APIResponse, error) {
+	firstPage := 1
+	if options.Page != 0 {
+		firstPage = options.Page
+	}
+
+	first, next, err := fetchPage[T](ctx, c, endpoint, options, firstPage, "")
+	if err != nil {
+		return nil, first.Response, fmt.Errorf("unable to retrieve data: %w", err)
+	}
+
+	// The first page is checked like the pages of a sequential request, which also validates the
+	// x-total-count header.
+	seen := (firstPage-1)*first.PerPage + len(first.Items)
+	visited := map[string]bool{}
+	follow := false
+	if next != nil {
+		follow, err = followLink(first, next, seen, visited)
+	}
+	if err != nil {
+		return first.Items, withPages(first.Response, []*APIResponse{first.Response}),
+			fmt.Errorf("unable to retrieve data: %w", err)
+	}
+	if !follow {
+		return first.Items, withPages(first.Response, []*APIResponse{first.Response}), nil
+	}
+
+	lastPage := (first.TotalCount + first.PerPage - 1) / first.PerPage
+	if lastPage <= firstPage {
+		// The number of pages is unknown, so the next links are followed one page at a time.
+		return fetchRemainingPages(ctx, c, endpoint, options, first, next, seen, visited)
+	}
+
+	pages := make([]Page[T], lastPage-firstPage+1)
+	pages[0] = first
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(options.Concurrency)
+
+	for i := 1; i < len(pages); i++ {
+		group.Go(func() error {
+			if err := groupCtx.Err(); err != nil {
+				return err //nolint:wrapcheck // The context's error is wrapped below.
+			}
+
+			page, _, pageErr := fetchPage[T](groupCtx, c, endpoint, options, firstPage+i, "")
+			pages[i] = page
+			if pageErr != nil {
+				return fmt.Errorf("unable to retrieve page %d: %w", firstPage+i, pageErr)
+			}
+
+			return nil
+		})
+	}
+
+	err = group.Wait()
+	if err == nil {
+		err = ctx.Err()
+	}
+
+	var results []T
+	var apiResponse *APIResponse
+	var responses []*APIResponse
+	for _, page := range pages {
+		results = append(results, page.Items...)
+		if page.Response != nil {
+			apiResponse = page.Response
+			responses = append(responses, page.Response)
+		}
+	}
+
+	if err != nil {
+		return results, withPages(apiResponse, responses), fmt.Errorf("unable to retrieve data: %w", err)
+	}
+
+	return results, withPages(apiResponse, responses), nil
+}
+
+// fetchRemainingPages follows the "next" link of the first page of a paginated endpoint, which was
+// already checked with followLink, and requests the remaining pages one at a time. It returns the
+// results of every page along with the API response of the last page.
+//
+// seen is the number of items up to the end of the first page, and visited lists the links already
+// followed.
+func fetchRemainingPages[T any](
+	ctx context.Context,
+	c *Client,
+	endpoint string,
+	options *RequestOptions,
+	first Page[T],
+	next *Link,
+	seen int,
+	visited map[string]bool,
+) ([]T, *APIResponse, error) {
+	results := first.Items
+	apiResponse := first.Response
+	responses := []*APIResponse{first.Response}
+
+	page := linkPageNumber(next, first.Number+1)
+	for result, pageErr := range followPages[T](ctx, c, endpoint, options, page, next.URL, seen, visited) {
+		if result.Response != nil {
+			apiResponse = result.Response
+			responses = append(responses, result.Response)
+		}
+
+		if pageErr != nil {
+			return results, withPages(apiResponse, responses), fmt.Errorf("unable to retrieve data: %w", pageErr)
+		}
+
+		results = append(results, result.Items...)
+	}
+
+	return results, withPages(apiResponse, responses), nil
+}
+
+// withPages sets the responses of every page requested by an operation on the response it
+// returns.
+//
+// The responses are copied without their Pages, since the response of the last page is the one
+// returned and would otherwise refer to itself.
+func withPages(apiResponse *APIResponse, responses []*APIResponse) *APIResponse {
+	if apiResponse == nil {
+		return nil
+	}
+
+	pages := make([]*APIResponse, len(responses))
+	for i, response := range responses {
+		page := *response
+		page.Pages = nil
+		pages[i] = &page
+	}
+	apiResponse.Pages = pages
+
+	return apiResponse
+}
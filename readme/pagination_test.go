@@ -0,0 +1,402 @@
+package readme_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/h2non/gock"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockCategoryPages mocks two pages of categories with one category on each page.
+func mockCategoryPages() {
+	gock.New(TestClient.APIURL).
+		Get(readme.CategoryEndpoint).
+		MatchParam("page", "1").
+		Reply(200).
+		SetHeader("x-total-count", "2").
+		SetHeader("link", `</categories?perPage=1&page=2>; rel="next", <>; rel="prev", <>; rel="last"`).
+		JSON([]readme.Category{testdata.Categories[0]})
+	gock.New(TestClient.APIURL).
+		Get(readme.CategoryEndpoint).
+		MatchParam("page", "2").
+		Reply(200).
+		SetHeader("x-total-count", "2").
+		SetHeader("link", `<>; rel="next", </categories?perPage=1&page=1>; rel="prev", <>; rel="last"`).
+		JSON([]readme.Category{testdata.Categories[1]})
+}
+
+func Test_Category_All(t *testing.T) {
+	t.Run("when iterating over every category", func(t *testing.T) {
+		// Arrange
+		mockCategoryPages()
+		defer gock.Off()
+
+		// Act
+		var got []readme.Category
+		for category, err := range TestClient.Category.All(context.Background(), readme.RequestOptions{PerPage: 1}) {
+			assert.NoError(t, err, "it does not return an error")
+			got = append(got, category)
+		}
+
+		// Assert
+		assert.Equal(t, testdata.Categories[:2], got, "it returns the categories from every page")
+		assert.True(t, gock.IsDone(), "it requests every page")
+	})
+
+	t.Run("when the iteration stops early", func(t *testing.T) {
+		// Arrange
+		mockCategoryPages()
+		defer gock.Off()
+
+		// Act
+		var got []readme.Category
+		for category := range TestClient.Category.All(context.Background(), readme.RequestOptions{PerPage: 1}) {
+			got = append(got, category)
+
+			break
+		}
+
+		// Assert
+		assert.Equal(t, testdata.Categories[:1], got, "it returns the first category")
+		assert.Len(t, gock.Pending(), 1, "it doesn't request the next page")
+	})
+
+	t.Run("when the API responds with an error", func(t *testing.T) {
+		// Arrange
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			Reply(401).
+			JSON(readme.APIErrorResponse{Error: "APIKEY_NOTFOUND"})
+		defer gock.Off()
+
+		// Act
+		var errs []error
+		for _, err := range TestClient.Category.All(context.Background()) {
+			errs = append(errs, err)
+		}
+
+		// Assert
+		assert.Len(t, errs, 1, "it ends the iteration")
+		assert.ErrorIs(t, errs[0], readme.ErrUnauthorized, "it returns the error")
+	})
+}
+
+func Test_Category_Pages(t *testing.T) {
+	// Arrange
+	mockCategoryPages()
+	defer gock.Off()
+
+	// Act
+	var got []readme.Page[readme.Category]
+	for page, err := range TestClient.Category.Pages(context.Background(), readme.RequestOptions{PerPage: 1}) {
+		assert.NoError(t, err, "it does not return an error")
+		got = append(got, page)
+	}
+
+	// Assert
+	assert.Len(t, got, 2, "it returns every page")
+	for i, page := range got {
+		assert.Equal(t, i+1, page.Number, "it includes the page number")
+		assert.Equal(t, 1, page.PerPage, "it includes the page size")
+		assert.Equal(t, 2, page.TotalCount, "it includes the total count")
+		assert.Equal(t, testdata.Categories[i:i+1], page.Items, "it includes the page's categories")
+		assert.Equal(t, 200, page.Response.HTTPResponse.StatusCode, "it includes the API response")
+	}
+}
+
+func Test_Category_GetAll_Pagination(t *testing.T) {
+	// Arrange
+	mockCategoryPages()
+	defer gock.Off()
+
+	// Act
+	_, apiResponse, err := TestClient.Category.GetAll(readme.RequestOptions{PerPage: 1})
+
+	// Assert
+	assert.NoError(t, err, "it does not return an error")
+	assert.Len(t, apiResponse.Pages, 2, "it returns the response of every page")
+	assert.Same(t, apiResponse.Pagination, apiResponse.Pages[1].Pagination, "it returns the response of the last page")
+	assert.NotSame(t, apiResponse, apiResponse.Pages[1], "it returns a copy of the response of the last page")
+	assert.Nil(t, apiResponse.Pages[1].Pages, "it doesn't refer to the returned response")
+	for i, page := range apiResponse.Pages {
+		assert.Equal(t, i+1, page.Pagination.Page, "it includes the page number")
+		assert.Equal(t, 1, page.Pagination.PerPage, "it includes the page size")
+		assert.Equal(t, 1, page.Pagination.Count, "it includes the number of items in the page")
+		assert.Equal(t, 2, page.Pagination.TotalCount, "it includes the total count")
+	}
+	assert.Equal(t, "/categories?perPage=1&page=2", apiResponse.Pages[0].Pagination.Next.URL,
+		"it includes the next link")
+	assert.Nil(t, apiResponse.Pages[0].Pagination.Prev, "it doesn't include an empty prev link")
+	assert.Nil(t, apiResponse.Pagination.Next, "it doesn't include an empty next link")
+	assert.Equal(t, "/categories?perPage=1&page=1", apiResponse.Pagination.Prev.URL, "it includes the prev link")
+}
+
+func Test_Category_GetAll_Links(t *testing.T) {
+	t.Run("when the API returns next links", func(t *testing.T) {
+		// Arrange
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			MatchParam("page", "1").
+			Reply(200).
+			SetHeader("link", `</categories?perPage=1&page=2&cursor=abc>; rel="next"`).
+			JSON([]readme.Category{testdata.Categories[0]})
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			MatchParam("cursor", "abc").
+			Reply(200).
+			SetHeader("link", `</api/v1/categories?perPage=1&page=3&cursor=def>; rel="next"`).
+			JSON([]readme.Category{testdata.Categories[1]})
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			MatchParam("cursor", "def").
+			Reply(200).
+			SetHeader("link", `<>; rel="next", </categories?perPage=1&page=2&cursor=abc>; rel="prev"`).
+			JSON([]readme.Category{testdata.Categories[0]})
+		defer gock.Off()
+
+		// Act
+		var got []readme.Page[readme.Category]
+		for page, err := range TestClient.Category.Pages(context.Background(), readme.RequestOptions{PerPage: 1}) {
+			assert.NoError(t, err, "it does not return an error")
+			got = append(got, page)
+		}
+
+		// Assert
+		assert.True(t, gock.IsDone(), "it requests each next link")
+		assert.Len(t, got, 3, "it returns every page")
+		assert.Equal(t, 3, got[2].Number, "it returns the page number from the link")
+		assert.Equal(t, "/categories?perPage=1&page=3&cursor=def", got[2].Response.Request.Endpoint,
+			"it requests the link relative to the API URL")
+	})
+
+	t.Run("when the API returns fewer items than requested", func(t *testing.T) {
+		// Arrange
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			MatchParam("page", "1").
+			Reply(200).
+			SetHeader("x-total-count", "2").
+			SetHeader("link", `</categories?perPage=200&page=2>; rel="next"`).
+			JSON([]readme.Category{testdata.Categories[0]})
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			MatchParam("page", "2").
+			Reply(200).
+			SetHeader("x-total-count", "2").
+			SetHeader("link", `<>; rel="next"`).
+			JSON([]readme.Category{testdata.Categories[1]})
+		defer gock.Off()
+
+		// Act
+		got, _, err := TestClient.Category.GetAll(readme.RequestOptions{PerPage: 200})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, testdata.Categories[:2], got, "it follows the next link until every item is received")
+		assert.True(t, gock.IsDone(), "it requests every page")
+	})
+
+	t.Run("when the API repeats a next link", func(t *testing.T) {
+		// Arrange
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			Times(2).
+			Reply(200).
+			SetHeader("link", `</categories?cursor=abc>; rel="next"`).
+			JSON([]readme.Category{testdata.Categories[0]})
+		defer gock.Off()
+
+		// Act
+		_, _, err := TestClient.Category.GetAll()
+
+		// Assert
+		assert.ErrorContains(t, err, "unable to follow pagination link '/categories?cursor=abc'",
+			"it returns an error")
+		assert.True(t, gock.IsDone(), "it stops requesting pages")
+	})
+}
+
+func Test_Changelog_All(t *testing.T) {
+	// Arrange
+	gock.New(TestClient.APIURL).
+		Get(readme.ChangelogEndpoint).
+		Reply(200).
+		SetHeader("x-total-count", "1").
+		SetHeader("link", `<>; rel="next", <>; rel="prev", <>; rel="last"`).
+		JSON(testdata.Changelogs)
+	defer gock.Off()
+
+	// Act
+	var got []readme.Changelog
+	for changelog, err := range TestClient.Changelog.All(context.Background()) {
+		assert.NoError(t, err, "it does not return an error")
+		got = append(got, changelog)
+	}
+
+	// Assert
+	assert.Equal(t, testdata.Changelogs, got, "it returns the changelogs")
+}
+
+func Test_Changelog_GetAll_Concurrency(t *testing.T) {
+	// newServer returns a test server with 5 changelogs, one per page, that fails the listed pages and
+	// sets the x-total-count header to totalCount when it isn't empty.
+	newServer := func(totalCount string, failPages ...string) (*httptest.Server, *atomic.Int32) {
+		var inFlight, maxInFlight atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				peak := maxInFlight.Load()
+				if current <= peak || maxInFlight.CompareAndSwap(peak, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+
+			page := r.URL.Query().Get("page")
+			if slices.Contains(failPages, page) {
+				w.WriteHeader(http.StatusInternalServerError)
+
+				return
+			}
+
+			number, _ := strconv.Atoi(page)
+			if totalCount != "" {
+				w.Header().Set("x-total-count", totalCount)
+			}
+			if number < 5 {
+				w.Header().Set("link", fmt.Sprintf(`</changelogs?page=%d>; rel="next", <>; rel="prev", <>; rel="last"`,
+					number+1))
+			}
+			_, _ = w.Write([]byte(testdata.ToJSON([]readme.Changelog{{Title: "Changelog " + page}})))
+		}))
+
+		return server, &maxInFlight
+	}
+
+	t.Run("when the pages are requested concurrently", func(t *testing.T) {
+		// Arrange
+		server, maxInFlight := newServer("5")
+		defer server.Close()
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL), readme.WithHTTPClient(server.Client()))
+
+		// Act
+		got, apiResponse, err := client.Changelog.GetAll(readme.RequestOptions{PerPage: 1, Concurrency: 3})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		titles := []string{}
+		for _, changelog := range got {
+			titles = append(titles, changelog.Title)
+		}
+		assert.Equal(t, []string{"Changelog 1", "Changelog 2", "Changelog 3", "Changelog 4", "Changelog 5"},
+			titles, "it returns the results in page order")
+		assert.Len(t, apiResponse.Pages, 5, "it returns the response of every page")
+		for i, page := range apiResponse.Pages {
+			assert.Equal(t, i+1, page.Pagination.Page, "it returns the responses in page order")
+		}
+		assert.Greater(t, maxInFlight.Load(), int32(1), "it requests pages concurrently")
+		assert.LessOrEqual(t, maxInFlight.Load(), int32(3), "it limits the number of concurrent requests")
+	})
+
+	t.Run("when a page fails", func(t *testing.T) {
+		// Arrange
+		server, _ := newServer("5", "3")
+		defer server.Close()
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL), readme.WithHTTPClient(server.Client()))
+
+		// Act
+		_, _, err := client.Changelog.GetAll(readme.RequestOptions{PerPage: 1, Concurrency: 2})
+
+		// Assert
+		assert.ErrorContains(t, err, "unable to retrieve page 3", "it returns the error of the page")
+		assert.ErrorIs(t, err, readme.ErrServer, "it returns the API error")
+	})
+	t.Run("when the total count is missing", func(t *testing.T) {
+		// Arrange
+		server, maxInFlight := newServer("")
+		defer server.Close()
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL), readme.WithHTTPClient(server.Client()))
+
+		// Act
+		got, apiResponse, err := client.Changelog.GetAll(readme.RequestOptions{PerPage: 1, Concurrency: 3})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		titles := []string{}
+		for _, changelog := range got {
+			titles = append(titles, changelog.Title)
+		}
+		assert.Equal(t, []string{"Changelog 1", "Changelog 2", "Changelog 3", "Changelog 4", "Changelog 5"},
+			titles, "it follows the next links")
+		assert.Len(t, apiResponse.Pages, 5, "it returns the response of every page")
+		assert.Equal(t, int32(1), maxInFlight.Load(), "it requests the pages one at a time")
+	})
+	t.Run("when the context is canceled", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var requests atomic.Int32
+		transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			// The responses ignore the context, so only the pages that are still queued can notice
+			// that it's canceled.
+			if requests.Add(1) == 2 {
+				cancel()
+			}
+			page := r.URL.Query().Get("page")
+			body := testdata.ToJSON([]readme.Changelog{{Title: "Changelog " + page}})
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header: http.Header{
+					"X-Total-Count": []string{"5"},
+					"Link":          []string{`</changelogs?page=2>; rel="next"`},
+				},
+				Body:    io.NopCloser(strings.NewReader(body)),
+				Request: r,
+			}, nil
+		})
+		client, _ := readme.NewClient("test", readme.WithAPIURL("https://readme.test"),
+			readme.WithHTTPClient(&http.Client{Transport: transport}))
+
+		// Act
+		_, _, err := client.Changelog.GetAllWithContext(ctx, readme.RequestOptions{PerPage: 1, Concurrency: 2})
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled, "it returns the context's error")
+		assert.Less(t, requests.Load(), int32(5), "it doesn't request the queued pages")
+	})
+
+	t.Run("when the total count is invalid", func(t *testing.T) {
+		// Arrange
+		server, _ := newServer("x")
+		defer server.Close()
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL), readme.WithHTTPClient(server.Client()))
+
+		// Act
+		_, _, err := client.Changelog.GetAll(readme.RequestOptions{PerPage: 1, Concurrency: 3})
+
+		// Assert
+		assert.ErrorContains(t, err, "unable to parse 'x-total-count' header", "it returns an error")
+	})
+}
+
+// roundTripperFunc is an http.RoundTripper that calls a function.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls the function.
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
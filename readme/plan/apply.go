@@ -0,0 +1,183 @@
+package plan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// errMissingParams is returned when a change to create or update a resource doesn't have its
+// parameters.
+var errMissingParams = errors.New("the change doesn't have the parameters of the resource")
+
+// Apply makes the changes of a plan in order and returns the number of changes made.
+//
+// Every resource in the plan is checked first, and nothing is changed when a resource that's
+// created now exists, or a resource that's updated, deleted or left as it is changed since the plan
+// was made. The returned error then wraps ErrStale for every such resource.
+func Apply(ctx context.Context, client *readme.Client, plan *Plan) (int, error) {
+	if err := verify(ctx, client, plan); err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for _, change := range plan.Changes {
+		if change.Action == ActionNoOp {
+			continue
+		}
+
+		if err := apply(ctx, client, plan.Version, change); err != nil {
+			return applied, err
+		}
+		applied++
+	}
+
+	return applied, nil
+}
+
+// verify returns an error when a resource in ReadMe changed since a plan was made.
+func verify(ctx context.Context, client *readme.Client, plan *Plan) error {
+	var errs []error
+	for _, change := range plan.Changes {
+		remote, found, err := fetch(ctx, client, plan.Version, change.Type, change.Slug)
+		if err != nil {
+			return fmt.Errorf("unable to verify plan: %w", err)
+		}
+
+		switch {
+		case change.Action == ActionCreate && found:
+			errs = append(errs, fmt.Errorf("%s '%s' was created: %w", change.Type, change.Slug, ErrStale))
+		case change.Action == ActionCreate:
+		case !found:
+			errs = append(errs, fmt.Errorf("%s '%s' was deleted: %w", change.Type, change.Slug, ErrStale))
+		case fingerprint(remote) != change.Fingerprint:
+			errs = append(errs, fmt.Errorf("%s '%s' was changed: %w", change.Type, change.Slug, ErrStale))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("unable to apply plan: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// apply makes a change.
+func apply(ctx context.Context, client *readme.Client, version string, change Change) error {
+	options := readme.RequestOptions{Version: version}
+
+	var err error
+	switch change.Type {
+	case TypeCategory:
+		err = applyCategory(ctx, client, options, change)
+	case TypeDoc:
+		err = applyDoc(ctx, client, options, change)
+	case TypeChangelog:
+		err = applyChangelog(ctx, client, change)
+	case TypeCustomPage:
+		err = applyCustomPage(ctx, client, change)
+	default:
+		err = fmt.Errorf("unknown resource type '%s'", change.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to %s %s '%s': %w", change.Action, change.Type, change.Slug, err)
+	}
+
+	return nil
+}
+
+// applyCategory makes a change to a category.
+func applyCategory(ctx context.Context, client *readme.Client, options readme.RequestOptions, change Change) error {
+	if change.Action != ActionDelete && change.Category == nil {
+		return errMissingParams
+	}
+
+	var err error
+	switch change.Action {
+	case ActionCreate:
+		// ReadMe responds with the version's details when a version is requested.
+		var response any = &readme.CategorySaved{}
+		if options.Version != "" {
+			response = &readme.CategoryVersionSaved{}
+		}
+		_, err = client.Category.CreateWithContext(ctx, response, *change.Category, options)
+	case ActionUpdate:
+		_, _, err = client.Category.UpdateWithContext(ctx, change.Slug, *change.Category, options)
+	case ActionDelete:
+		_, _, err = client.Category.DeleteWithContext(ctx, change.Slug, options)
+	default:
+		return unknownAction(change.Action)
+	}
+
+	return err //nolint:wrapcheck // The error is wrapped by apply.
+}
+
+// applyDoc makes a change to a doc.
+func applyDoc(ctx context.Context, client *readme.Client, options readme.RequestOptions, change Change) error {
+	if change.Action != ActionDelete && change.Doc == nil {
+		return errMissingParams
+	}
+
+	var err error
+	switch change.Action {
+	case ActionCreate:
+		_, _, err = client.Doc.CreateWithContext(ctx, *change.Doc, options)
+	case ActionUpdate:
+		_, _, err = client.Doc.UpdateWithContext(ctx, change.Slug, *change.Doc, options)
+	case ActionDelete:
+		_, _, err = client.Doc.DeleteWithContext(ctx, change.Slug, options)
+	default:
+		return unknownAction(change.Action)
+	}
+
+	return err //nolint:wrapcheck // The error is wrapped by apply.
+}
+
+// applyChangelog makes a change to a changelog.
+func applyChangelog(ctx context.Context, client *readme.Client, change Change) error {
+	if change.Action != ActionDelete && change.Changelog == nil {
+		return errMissingParams
+	}
+
+	var err error
+	switch change.Action {
+	case ActionCreate:
+		_, _, err = client.Changelog.CreateWithContext(ctx, *change.Changelog)
+	case ActionUpdate:
+		_, _, err = client.Changelog.UpdateWithContext(ctx, change.Slug, *change.Changelog)
+	case ActionDelete:
+		_, _, err = client.Changelog.DeleteWithContext(ctx, change.Slug)
+	default:
+		return unknownAction(change.Action)
+	}
+
+	return err //nolint:wrapcheck // The error is wrapped by apply.
+}
+
+// applyCustomPage makes a change to a custom page.
+func applyCustomPage(ctx context.Context, client *readme.Client, change Change) error {
+	if change.Action != ActionDelete && change.CustomPage == nil {
+		return errMissingParams
+	}
+
+	var err error
+	switch change.Action {
+	case ActionCreate:
+		_, _, err = client.CustomPage.CreateWithContext(ctx, *change.CustomPage)
+	case ActionUpdate:
+		_, _, err = client.CustomPage.UpdateWithContext(ctx, change.Slug, *change.CustomPage)
+	case ActionDelete:
+		_, _, err = client.CustomPage.DeleteWithContext(ctx, change.Slug)
+	default:
+		return unknownAction(change.Action)
+	}
+
+	return err //nolint:wrapcheck // The error is wrapped by apply.
+}
+
+// unknownAction returns the error of a change with an unknown action.
+func unknownAction(action Action) error {
+	return fmt.Errorf("unknown action '%s'", action)
+}
@@ -0,0 +1,233 @@
+// Package plan compares the desired categories, docs, changelogs and custom pages of a project
+// with their current state in ReadMe, and applies the differences.
+//
+// A plan lists a change for every resource: whether it will be created, updated, deleted or left
+// as it is, with the fields that change and a unified diff of the body. It can be rendered as text
+// for review or encoded as JSON, and applying it refuses to make any change when a resource
+// changed in ReadMe since the plan was made.
+//
+// Make a plan, print it and apply it:
+//
+//	p, err := plan.New(ctx, client, plan.Desired{
+//		Categories: []plan.Category{{
+//			Slug:   "documentation",
+//			Params: readme.CategoryParams{Title: "Documentation", Type: "guide"},
+//		}},
+//		Docs: []plan.Doc{{
+//			Slug:   "overview",
+//			Params: readme.DocParams{Title: "Overview", Body: body, CategorySlug: "documentation"},
+//		}},
+//	}, plan.Options{})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	fmt.Print(p.Text())
+//
+//	if _, err := plan.Apply(ctx, client, p); err != nil {
+//		log.Fatal(err)
+//	}
+package plan
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// diffContext is the number of unchanged lines around the changes in a diff.
+const diffContext = 3
+
+// ErrStale is returned by Apply when a resource changed in ReadMe since the plan was made.
+var ErrStale = errors.New("the resource changed since the plan was made")
+
+// ResourceType is the type of a resource in a plan.
+type ResourceType string
+
+const (
+	// TypeCategory is a category.
+	TypeCategory ResourceType = "category"
+	// TypeDoc is a doc.
+	TypeDoc ResourceType = "doc"
+	// TypeChangelog is a changelog.
+	TypeChangelog ResourceType = "changelog"
+	// TypeCustomPage is a custom page.
+	TypeCustomPage ResourceType = "custom_page"
+)
+
+// Action is what a plan does to a resource.
+type Action string
+
+const (
+	// ActionCreate creates a resource that doesn't exist.
+	ActionCreate Action = "create"
+	// ActionUpdate updates a resource that differs from its desired state.
+	ActionUpdate Action = "update"
+	// ActionDelete deletes a resource that isn't desired.
+	ActionDelete Action = "delete"
+	// ActionNoOp leaves a resource that's already in its desired state.
+	ActionNoOp Action = "no-op"
+)
+
+// Plan is the list of changes to make to the resources of a project.
+//
+// A plan is encoded as JSON with its field tags, and can be decoded and applied later.
+type Plan struct {
+	// Version is the project version of the categories and docs.
+	Version string `json:"version,omitempty"`
+	// Changes is the changes in the order they're applied: categories, docs with parents before
+	// their children, changelogs and custom pages, followed by the deletions.
+	Changes []Change `json:"changes"`
+}
+
+// Change is the change to make to a resource.
+type Change struct {
+	// Type is the type of the resource.
+	Type ResourceType `json:"type"`
+	// Slug is the slug of the resource.
+	Slug string `json:"slug"`
+	// Action is what's done to the resource.
+	Action Action `json:"action"`
+	// Fields is the fields that change, not including the body.
+	Fields []FieldChange `json:"fields,omitempty"`
+	// Diff is a unified diff of the body of the resource.
+	Diff string `json:"diff,omitempty"`
+	// Fingerprint is the hash of the resource in ReadMe when the plan was made. It's empty for
+	// resources that are created.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// Category is the parameters to create or update a category.
+	Category *readme.CategoryParams `json:"category,omitempty"`
+	// Doc is the parameters to create or update a doc.
+	Doc *readme.DocParams `json:"doc,omitempty"`
+	// Changelog is the parameters to create or update a changelog.
+	Changelog *readme.ChangelogParams `json:"changelog,omitempty"`
+	// CustomPage is the parameters to create or update a custom page.
+	CustomPage *readme.CustomPageParams `json:"customPage,omitempty"`
+}
+
+// FieldChange is a field of a resource that changes.
+type FieldChange struct {
+	// Name is the name of the field.
+	Name string `json:"name"`
+	// Old is the current value of the field. It's empty for resources that are created.
+	Old string `json:"old"`
+	// New is the desired value of the field.
+	New string `json:"new"`
+}
+
+// Count returns the number of changes with an action.
+func (p *Plan) Count(action Action) int {
+	count := 0
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+
+	return count
+}
+
+// HasChanges returns whether applying the plan changes anything.
+func (p *Plan) HasChanges() bool {
+	return p.Count(ActionNoOp) < len(p.Changes)
+}
+
+// JSON returns the plan encoded as indented JSON.
+func (p *Plan) JSON() ([]byte, error) {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode plan: %w", err)
+	}
+
+	return data, nil
+}
+
+// Text returns the plan as text for review. Resources that don't change are only counted.
+func (p *Plan) Text() string {
+	symbols := map[Action]string{ActionCreate: "+", ActionUpdate: "~", ActionDelete: "-"}
+	verbs := map[Action]string{ActionCreate: "created", ActionUpdate: "updated", ActionDelete: "deleted"}
+
+	var text strings.Builder
+	for _, change := range p.Changes {
+		if change.Action == ActionNoOp {
+			continue
+		}
+
+		fmt.Fprintf(&text, "%s %s %q will be %s\n", symbols[change.Action], change.Type, change.Slug,
+			verbs[change.Action])
+		for _, field := range change.Fields {
+			if change.Action == ActionCreate {
+				fmt.Fprintf(&text, "    %s: %q\n", field.Name, field.New)
+			} else {
+				fmt.Fprintf(&text, "    %s: %q -> %q\n", field.Name, field.Old, field.New)
+			}
+		}
+		for _, line := range strings.SplitAfter(strings.TrimSuffix(change.Diff, "\n"), "\n") {
+			if line != "" {
+				text.WriteString("    " + strings.TrimSuffix(line, "\n") + "\n")
+			}
+		}
+		text.WriteString("\n")
+	}
+
+	fmt.Fprintf(&text, "Plan: %d to create, %d to update, %d to delete, %d unchanged.\n",
+		p.Count(ActionCreate), p.Count(ActionUpdate), p.Count(ActionDelete), p.Count(ActionNoOp))
+
+	return text.String()
+}
+
+// fields collects the fields of a resource that change.
+type fields []FieldChange
+
+// compare adds a field when its current and desired values differ.
+func (f *fields) compare(name string, current, desired any) {
+	old, value := fmt.Sprint(current), fmt.Sprint(desired)
+	if old != value {
+		*f = append(*f, FieldChange{Name: name, Old: old, New: value})
+	}
+}
+
+// diff returns a unified diff between the current and desired body of a resource, or an empty
+// string when they're the same.
+func diff(name, current, desired string) string {
+	current, desired = strings.TrimSpace(current), strings.TrimSpace(desired)
+	if current == desired {
+		return ""
+	}
+
+	// The diff is written to a string, which doesn't fail.
+	text, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(current),
+		B:        splitLines(desired),
+		FromFile: "a/" + name,
+		ToFile:   "b/" + name,
+		Context:  diffContext,
+	})
+
+	return text
+}
+
+// splitLines returns the lines of text, each ending with a new line, or nil when it's empty.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	return difflib.SplitLines(text)
+}
+
+// fingerprint returns the hex-encoded SHA-256 hash of the JSON encoding of a resource.
+func fingerprint(resource any) string {
+	// Resources are decoded from JSON, so they always encode.
+	data, _ := json.Marshal(resource)
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,237 @@
+package plan_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/readme/plan"
+	"github.com/liveoaklabs/readme-api-go-client/tests/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// testDesired returns the desired state of a project with a category, a parent and a child doc, a
+// changelog and a custom page.
+func testDesired() plan.Desired {
+	hidden := false
+
+	return plan.Desired{
+		Categories: []plan.Category{{
+			Slug:   "documentation",
+			Params: readme.CategoryParams{Title: "Documentation", Type: "guide"},
+		}},
+		Docs: []plan.Doc{
+			{
+				Slug: "installation",
+				Params: readme.DocParams{
+					Title:         "Installation",
+					Body:          "Run the installer.",
+					CategorySlug:  "documentation",
+					ParentDocSlug: "overview",
+				},
+			},
+			{
+				Slug: "overview",
+				Params: readme.DocParams{
+					Title:        "Overview",
+					Body:         "Welcome to the docs.\nRead the guides.",
+					CategorySlug: "documentation",
+					Hidden:       &hidden,
+				},
+			},
+		},
+		Changelogs: []plan.Changelog{{
+			Slug:   "release-1-0",
+			Params: readme.ChangelogParams{Title: "Release 1.0", Body: "The first release.", Type: "added"},
+		}},
+		CustomPages: []plan.CustomPage{{
+			Slug:   "about",
+			Params: readme.CustomPageParams{Title: "About", Body: "About us."},
+		}},
+	}
+}
+
+func Test_New(t *testing.T) {
+	t.Run("when the resources don't exist", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+
+		// Act
+		p, err := plan.New(context.Background(), client, testDesired(), plan.Options{})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, 5, p.Count(plan.ActionCreate), "it creates every resource")
+		assert.Equal(t, "overview", p.Changes[1].Slug, "it creates parent docs before their children")
+		assert.Equal(t, []plan.FieldChange{
+			{Name: "title", Old: "", New: "Overview"},
+			{Name: "category", Old: "", New: "documentation"},
+		}, p.Changes[1].Fields, "it returns the fields of the created resource")
+		assert.Contains(t, p.Text(), `+ doc "overview" will be created`, "it renders the created resources")
+		assert.Contains(t, p.Text(), "Plan: 5 to create, 0 to update, 0 to delete, 0 unchanged.",
+			"it renders a summary")
+	})
+
+	t.Run("when the resources differ", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		desired := testDesired()
+		applied, _ := plan.New(context.Background(), client, desired, plan.Options{})
+		_, _ = plan.Apply(context.Background(), client, applied)
+		desired.Docs[1].Params.Title = "Introduction"
+		desired.Docs[1].Params.Body = "Welcome to the docs.\nRead the guides first."
+
+		// Act
+		p, err := plan.New(context.Background(), client, desired, plan.Options{})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, []plan.Action{
+			plan.ActionNoOp,
+			plan.ActionUpdate,
+			plan.ActionNoOp,
+			plan.ActionNoOp,
+			plan.ActionNoOp,
+		}, actions(p), "it only updates the changed doc")
+		assert.Equal(t, []plan.FieldChange{{Name: "title", Old: "Overview", New: "Introduction"}},
+			p.Changes[1].Fields, "it returns the changed fields")
+		assert.Equal(t, `--- a/overview.md
++++ b/overview.md
+@@ -1,2 +1,2 @@
+ Welcome to the docs.
+-Read the guides.
++Read the guides first.
+`, p.Changes[1].Diff, "it returns a unified diff of the body")
+		assert.Contains(t, p.Text(), `    title: "Overview" -> "Introduction"`, "it renders the changed fields")
+	})
+
+	t.Run("when resources are pruned", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		applied, _ := plan.New(context.Background(), client, testDesired(), plan.Options{})
+		_, _ = plan.Apply(context.Background(), client, applied)
+		_, _, _ = client.Doc.Create(readme.DocParams{Title: "Stale", CategorySlug: "documentation"})
+		_, _, _ = client.Changelog.Create(readme.ChangelogParams{Title: "Old", Body: "Old."})
+		desired := testDesired()
+		desired.Docs = desired.Docs[:1]
+
+		// Act
+		p, err := plan.New(context.Background(), client, desired, plan.Options{Prune: true})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, []plan.Change{
+			{Type: plan.TypeDoc, Slug: "stale", Action: plan.ActionDelete, Fingerprint: p.Changes[4].Fingerprint},
+			{Type: plan.TypeChangelog, Slug: "old", Action: plan.ActionDelete, Fingerprint: p.Changes[5].Fingerprint},
+		}, p.Changes[4:], "it deletes the resources that aren't desired, but keeps the parents of desired docs")
+	})
+
+	t.Run("when the desired state is invalid", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		duplicate := testDesired()
+		duplicate.Docs[0].Slug = "overview"
+		cyclic := testDesired()
+		cyclic.Docs[1].Params.ParentDocSlug = "installation"
+
+		// Act
+		_, duplicateErr := plan.New(context.Background(), client, duplicate, plan.Options{})
+		_, cyclicErr := plan.New(context.Background(), client, cyclic, plan.Options{})
+
+		// Assert
+		assert.ErrorContains(t, duplicateErr, "the doc 'overview' is desired more than once",
+			"it returns an error for duplicate slugs")
+		assert.ErrorContains(t, cyclicErr, "form a cycle", "it returns an error for cyclic parent docs")
+	})
+}
+
+func Test_Apply(t *testing.T) {
+	t.Run("when the plan is applied", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		p, _ := plan.New(context.Background(), client, testDesired(), plan.Options{})
+
+		// Act
+		applied, err := plan.Apply(context.Background(), client, p)
+		doc, _, docErr := client.Doc.Get("installation")
+		replanned, replanErr := plan.New(context.Background(), client, testDesired(), plan.Options{})
+
+		// Assert
+		assert.NoError(t, errors.Join(err, docErr, replanErr), "it does not return an error")
+		assert.Equal(t, 5, applied, "it makes every change")
+		assert.Equal(t, "Run the installer.", doc.Body, "it creates the docs")
+		assert.False(t, replanned.HasChanges(), "it brings the resources to their desired state")
+	})
+
+	t.Run("when the plan is for a version", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		_, _, versionErr := client.Version.Create(readme.VersionParams{Version: "2.0", From: mocks.DefaultFakeVersion})
+		options := plan.Options{Version: "2.0"}
+		p, planErr := plan.New(context.Background(), client, testDesired(), options)
+
+		// Act
+		applied, err := plan.Apply(context.Background(), client, p)
+		category, _, categoryErr := client.Category.Get("documentation", readme.RequestOptions{Version: "2.0"})
+		_, _, stableErr := client.Category.Get("documentation")
+
+		// Assert
+		assert.NoError(t, errors.Join(versionErr, planErr, err, categoryErr), "it does not return an error")
+		assert.Equal(t, 5, applied, "it makes every change")
+		assert.Equal(t, "Documentation", category.Title, "it creates the category in the version")
+		assert.ErrorIs(t, stableErr, readme.ErrNotFound, "it doesn't create the category in the stable version")
+	})
+
+	t.Run("when the plan is decoded from JSON", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		p, _ := plan.New(context.Background(), client, testDesired(), plan.Options{})
+		data, encodeErr := p.JSON()
+		decoded := &plan.Plan{}
+		decodeErr := json.Unmarshal(data, decoded)
+
+		// Act
+		applied, err := plan.Apply(context.Background(), client, decoded)
+
+		// Assert
+		assert.NoError(t, errors.Join(encodeErr, decodeErr, err), "it does not return an error")
+		assert.Equal(t, 5, applied, "it makes every change")
+	})
+
+	t.Run("when the resources changed since the plan was made", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		initial, _ := plan.New(context.Background(), client, testDesired(), plan.Options{})
+		_, _ = plan.Apply(context.Background(), client, initial)
+		desired := testDesired()
+		desired.Docs[1].Params.Title = "Introduction"
+		desired.CustomPages = append(desired.CustomPages, plan.CustomPage{
+			Slug:   "contact",
+			Params: readme.CustomPageParams{Title: "Contact"},
+		})
+		p, _ := plan.New(context.Background(), client, desired, plan.Options{})
+		_, _, _ = client.Doc.Update("overview", readme.DocParams{Title: "Overview", CategorySlug: "documentation"})
+
+		// Act
+		applied, err := plan.Apply(context.Background(), client, p)
+		_, _, pageErr := client.CustomPage.Get("contact")
+
+		// Assert
+		assert.ErrorIs(t, err, plan.ErrStale, "it returns an error")
+		assert.ErrorContains(t, err, "doc 'overview' was changed", "it returns the changed resource")
+		assert.Equal(t, 0, applied, "it doesn't make any change")
+		assert.ErrorIs(t, pageErr, readme.ErrNotFound, "it doesn't create resources")
+	})
+}
+
+// actions returns the action of every change in a plan.
+func actions(p *plan.Plan) []plan.Action {
+	var actions []plan.Action
+	for _, change := range p.Changes {
+		actions = append(actions, change.Action)
+	}
+
+	return actions
+}
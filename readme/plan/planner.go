@@ -0,0 +1,515 @@
+package plan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// Desired is the desired state of the resources of a project.
+type Desired struct {
+	Categories  []Category
+	Docs        []Doc
+	Changelogs  []Changelog
+	CustomPages []CustomPage
+}
+
+// Category is the desired state of a category.
+//
+// The slug finds the category in ReadMe, which generates the slug of a new category from its
+// title, so it must match the generated slug for the category to be found on the next plan. The
+// same applies to the other resources.
+type Category struct {
+	Slug   string
+	Params readme.CategoryParams
+}
+
+// Doc is the desired state of a doc. Only the optional parameters that are set are compared.
+type Doc struct {
+	Slug   string
+	Params readme.DocParams
+}
+
+// Changelog is the desired state of a changelog. Only the optional parameters that are set are
+// compared.
+type Changelog struct {
+	Slug   string
+	Params readme.ChangelogParams
+}
+
+// CustomPage is the desired state of a custom page. Only the optional parameters that are set are
+// compared.
+type CustomPage struct {
+	Slug   string
+	Params readme.CustomPageParams
+}
+
+// Options configures a plan.
+type Options struct {
+	// Version is the project version of the categories and docs. The stable version is used when
+	// this is empty.
+	Version string
+	// Prune deletes the categories, docs, changelogs and custom pages that aren't desired. A doc
+	// with a desired child and a category with a desired doc aren't deleted.
+	Prune bool
+}
+
+// New makes a plan to bring the resources of a project to their desired state.
+func New(ctx context.Context, client *readme.Client, desired Desired, options Options) (*Plan, error) {
+	if err := validate(desired); err != nil {
+		return nil, err
+	}
+
+	docs, err := orderDocs(desired.Docs)
+	if err != nil {
+		return nil, err
+	}
+
+	planner := &planner{
+		client:        client,
+		version:       options.Version,
+		categorySlugs: map[string]string{},
+		docSlugs:      map[string]string{},
+		docs:          map[string][]readme.CategoryDocs{},
+	}
+	if err := planner.load(ctx); err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{Version: options.Version}
+	if plan.Changes, err = changes(ctx, plan.Changes, desired.Categories, planner.category); err != nil {
+		return nil, err
+	}
+	if plan.Changes, err = changes(ctx, plan.Changes, docs, planner.doc); err != nil {
+		return nil, err
+	}
+	if plan.Changes, err = changes(ctx, plan.Changes, desired.Changelogs, planner.changelog); err != nil {
+		return nil, err
+	}
+	if plan.Changes, err = changes(ctx, plan.Changes, desired.CustomPages, planner.customPage); err != nil {
+		return nil, err
+	}
+
+	if options.Prune {
+		deletions, err := planner.prune(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, deletions...)
+	}
+
+	return plan, nil
+}
+
+// changes appends the changes to desired resources of a type to a list of changes.
+func changes[T any](
+	ctx context.Context,
+	list []Change,
+	desired []T,
+	change func(context.Context, T) (Change, error),
+) ([]Change, error) {
+	for _, resource := range desired {
+		planned, err := change(ctx, resource)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, planned)
+	}
+
+	return list, nil
+}
+
+// validate returns an error when a desired resource doesn't have a slug or has the same slug as
+// another resource of its type.
+func validate(desired Desired) error {
+	slugs := map[ResourceType][]string{}
+	for _, category := range desired.Categories {
+		slugs[TypeCategory] = append(slugs[TypeCategory], category.Slug)
+	}
+	for _, doc := range desired.Docs {
+		slugs[TypeDoc] = append(slugs[TypeDoc], doc.Slug)
+	}
+	for _, changelog := range desired.Changelogs {
+		slugs[TypeChangelog] = append(slugs[TypeChangelog], changelog.Slug)
+	}
+	for _, page := range desired.CustomPages {
+		slugs[TypeCustomPage] = append(slugs[TypeCustomPage], page.Slug)
+	}
+
+	var errs []error
+	for _, resourceType := range []ResourceType{TypeCategory, TypeDoc, TypeChangelog, TypeCustomPage} {
+		seen := map[string]bool{}
+		for _, slug := range slugs[resourceType] {
+			switch {
+			case slug == "":
+				errs = append(errs, fmt.Errorf("a desired %s doesn't have a slug", resourceType))
+			case seen[slug]:
+				errs = append(errs, fmt.Errorf("the %s '%s' is desired more than once", resourceType, slug))
+			}
+			seen[slug] = true
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid desired state: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// orderDocs sorts docs so parent docs come before their children.
+func orderDocs(docs []Doc) ([]Doc, error) {
+	pending := docs
+	ordered := make([]Doc, 0, len(docs))
+	added := map[string]bool{}
+
+	for len(pending) > 0 {
+		waiting := map[string]bool{}
+		for _, doc := range pending {
+			waiting[doc.Slug] = true
+		}
+
+		var next []Doc
+		for _, doc := range pending {
+			if waiting[doc.Params.ParentDocSlug] && !added[doc.Params.ParentDocSlug] {
+				next = append(next, doc)
+
+				continue
+			}
+			ordered = append(ordered, doc)
+		}
+		for _, doc := range ordered {
+			added[doc.Slug] = true
+		}
+
+		if len(next) == len(pending) {
+			return nil, fmt.Errorf("invalid desired state: the parent docs of '%s' form a cycle", next[0].Slug)
+		}
+		pending = next
+	}
+
+	return ordered, nil
+}
+
+// planner compares desired resources with the resources in ReadMe.
+type planner struct {
+	client  *readme.Client
+	version string
+	// categories is the categories of the version.
+	categories []readme.Category
+	// categorySlugs maps the IDs of the version's categories to their slugs.
+	categorySlugs map[string]string
+	// docSlugs maps the IDs of the version's docs to their slugs.
+	docSlugs map[string]string
+	// docs maps the slugs of the version's categories to their docs.
+	docs map[string][]readme.CategoryDocs
+}
+
+// load loads the categories and docs of the version.
+func (p *planner) load(ctx context.Context) error {
+	options := readme.RequestOptions{Version: p.version}
+
+	categories, _, err := p.client.Category.GetAllWithContext(ctx, options)
+	if err != nil {
+		return fmt.Errorf("unable to plan categories: %w", err)
+	}
+	p.categories = categories
+
+	var addDocs func(docs []readme.CategoryDocs)
+	addDocs = func(docs []readme.CategoryDocs) {
+		for _, doc := range docs {
+			p.docSlugs[doc.ID] = doc.Slug
+			addDocs(doc.Children)
+		}
+	}
+
+	for _, category := range categories {
+		p.categorySlugs[category.ID] = category.Slug
+
+		docs, _, err := p.client.Category.GetDocsWithContext(ctx, category.Slug, options)
+		if err != nil {
+			return fmt.Errorf("unable to plan the docs of category '%s': %w", category.Slug, err)
+		}
+		p.docs[category.Slug] = docs
+		addDocs(docs)
+	}
+
+	return nil
+}
+
+// category returns the change to a category.
+func (p *planner) category(ctx context.Context, desired Category) (Change, error) {
+	remote, found, err := fetch(ctx, p.client, p.version, TypeCategory, desired.Slug)
+	if err != nil {
+		return Change{}, err
+	}
+
+	current, _ := remote.(readme.Category)
+	var changed fields
+	changed.compare("title", current.Title, desired.Params.Title)
+	changed.compare("type", current.Type, desired.Params.Type)
+
+	change := Change{Type: TypeCategory, Slug: desired.Slug, Category: &desired.Params}
+
+	return finish(change, remote, found, changed, ""), nil
+}
+
+// doc returns the change to a doc.
+func (p *planner) doc(ctx context.Context, desired Doc) (Change, error) {
+	remote, found, err := fetch(ctx, p.client, p.version, TypeDoc, desired.Slug)
+	if err != nil {
+		return Change{}, err
+	}
+
+	current, _ := remote.(readme.Doc)
+	params := desired.Params
+	var changed fields
+
+	changed.compare("title", current.Title, params.Title)
+	category := params.CategorySlug
+	if category == "" {
+		category = slugOf(p.categorySlugs, params.Category)
+	}
+	changed.compare("category", slugOf(p.categorySlugs, current.Category), category)
+	if params.ParentDocSlug != "" || params.ParentDoc != "" {
+		parent := params.ParentDocSlug
+		if parent == "" {
+			parent = slugOf(p.docSlugs, params.ParentDoc)
+		}
+		changed.compare("parentDoc", slugOf(p.docSlugs, current.ParentDoc), parent)
+	}
+	if params.Excerpt != "" {
+		changed.compare("excerpt", current.Excerpt, params.Excerpt)
+	}
+	if params.Hidden != nil {
+		changed.compare("hidden", current.Hidden, *params.Hidden)
+	}
+	if params.Order != nil {
+		changed.compare("order", current.Order, *params.Order)
+	}
+	if params.Type != "" {
+		changed.compare("type", current.Type, params.Type)
+	}
+	if params.Metadata != nil {
+		changed.compare("metadata.title", current.Metadata.Title, params.Metadata.Title)
+		changed.compare("metadata.description", current.Metadata.Description, params.Metadata.Description)
+	}
+
+	var bodyDiff string
+	if params.Body != "" {
+		bodyDiff = diff(desired.Slug+".md", current.Body, params.Body)
+	}
+
+	change := Change{Type: TypeDoc, Slug: desired.Slug, Doc: &desired.Params}
+
+	return finish(change, remote, found, changed, bodyDiff), nil
+}
+
+// changelog returns the change to a changelog.
+func (p *planner) changelog(ctx context.Context, desired Changelog) (Change, error) {
+	remote, found, err := fetch(ctx, p.client, p.version, TypeChangelog, desired.Slug)
+	if err != nil {
+		return Change{}, err
+	}
+
+	current, _ := remote.(readme.Changelog)
+	params := desired.Params
+	var changed fields
+
+	changed.compare("title", current.Title, params.Title)
+	if params.Type != "" {
+		changed.compare("type", current.Type, params.Type)
+	}
+	if params.Hidden != nil {
+		changed.compare("hidden", current.Hidden, *params.Hidden)
+	}
+
+	change := Change{Type: TypeChangelog, Slug: desired.Slug, Changelog: &desired.Params}
+
+	return finish(change, remote, found, changed, diff(desired.Slug+".md", current.Body, params.Body)), nil
+}
+
+// customPage returns the change to a custom page.
+func (p *planner) customPage(ctx context.Context, desired CustomPage) (Change, error) {
+	remote, found, err := fetch(ctx, p.client, p.version, TypeCustomPage, desired.Slug)
+	if err != nil {
+		return Change{}, err
+	}
+
+	current, _ := remote.(readme.CustomPage)
+	params := desired.Params
+	var changed fields
+
+	changed.compare("title", current.Title, params.Title)
+	if params.Hidden != nil {
+		changed.compare("hidden", current.Hidden, *params.Hidden)
+	}
+	if params.HTMLMode != nil {
+		changed.compare("htmlmode", current.HTMLMode, *params.HTMLMode)
+	}
+
+	var bodyDiff string
+	if params.Body != "" {
+		bodyDiff = diff(desired.Slug+".md", current.Body, params.Body)
+	}
+	if params.HTML != "" {
+		bodyDiff += diff(desired.Slug+".html", current.HTML, params.HTML)
+	}
+
+	change := Change{Type: TypeCustomPage, Slug: desired.Slug, CustomPage: &desired.Params}
+
+	return finish(change, remote, found, changed, bodyDiff), nil
+}
+
+// prune returns the deletions of the resources that aren't desired: docs with their children
+// first, then categories, changelogs and custom pages.
+func (p *planner) prune(ctx context.Context, desired Desired) ([]Change, error) {
+	keep := map[ResourceType]map[string]bool{
+		TypeCategory:   {},
+		TypeDoc:        {},
+		TypeChangelog:  {},
+		TypeCustomPage: {},
+	}
+	for _, category := range desired.Categories {
+		keep[TypeCategory][category.Slug] = true
+	}
+	for _, doc := range desired.Docs {
+		keep[TypeDoc][doc.Slug] = true
+	}
+	for _, changelog := range desired.Changelogs {
+		keep[TypeChangelog][changelog.Slug] = true
+	}
+	for _, page := range desired.CustomPages {
+		keep[TypeCustomPage][page.Slug] = true
+	}
+
+	// The deletions are planned in the order of the candidates.
+	var candidates []Change
+	for _, category := range p.categories {
+		if pruneDocs(p.docs[category.Slug], keep[TypeDoc], &candidates) {
+			keep[TypeCategory][category.Slug] = true
+		}
+	}
+	for _, category := range p.categories {
+		candidates = append(candidates, Change{Type: TypeCategory, Slug: category.Slug})
+	}
+
+	changelogs, _, err := p.client.Changelog.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to plan changelogs: %w", err)
+	}
+	for _, changelog := range changelogs {
+		candidates = append(candidates, Change{Type: TypeChangelog, Slug: changelog.Slug})
+	}
+
+	pages, _, err := p.client.CustomPage.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to plan custom pages: %w", err)
+	}
+	for _, page := range pages {
+		candidates = append(candidates, Change{Type: TypeCustomPage, Slug: page.Slug})
+	}
+
+	var deletions []Change
+	for _, deletion := range candidates {
+		if keep[deletion.Type][deletion.Slug] {
+			continue
+		}
+
+		remote, found, err := fetch(ctx, p.client, p.version, deletion.Type, deletion.Slug)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			deletion.Action = ActionDelete
+			deletion.Fingerprint = fingerprint(remote)
+			deletions = append(deletions, deletion)
+		}
+	}
+
+	return deletions, nil
+}
+
+// pruneDocs adds the docs that aren't kept to a list of deletions, children before their parents,
+// and returns whether any of them are kept. A doc with a kept child is kept.
+func pruneDocs(docs []readme.CategoryDocs, keep map[string]bool, deletions *[]Change) bool {
+	kept := false
+	for _, doc := range docs {
+		if pruneDocs(doc.Children, keep, deletions) || keep[doc.Slug] {
+			keep[doc.Slug] = true
+			kept = true
+
+			continue
+		}
+		*deletions = append(*deletions, Change{Type: TypeDoc, Slug: doc.Slug})
+	}
+
+	return kept
+}
+
+// slugOf returns the slug of an ID, or the ID when it isn't known.
+func slugOf(slugs map[string]string, id string) string {
+	if slug, ok := slugs[id]; ok {
+		return slug
+	}
+
+	return id
+}
+
+// finish sets the action of a change, with its changed fields and body diff, and the fingerprint
+// of the resource in ReadMe.
+func finish(change Change, remote any, found bool, changed fields, bodyDiff string) Change {
+	change.Fields = changed
+	change.Diff = bodyDiff
+
+	switch {
+	case !found:
+		change.Action = ActionCreate
+
+		return change
+	case len(changed) == 0 && bodyDiff == "":
+		change.Action = ActionNoOp
+	default:
+		change.Action = ActionUpdate
+	}
+	change.Fingerprint = fingerprint(remote)
+
+	return change
+}
+
+// fetch returns a resource in ReadMe, and whether it exists.
+func fetch(
+	ctx context.Context,
+	client *readme.Client,
+	version string,
+	resourceType ResourceType,
+	slug string,
+) (any, bool, error) {
+	options := readme.RequestOptions{Version: version}
+
+	var remote any
+	var err error
+	switch resourceType {
+	case TypeCategory:
+		remote, _, err = client.Category.GetWithContext(ctx, slug, options)
+	case TypeDoc:
+		remote, _, err = client.Doc.GetWithContext(ctx, slug, options)
+	case TypeChangelog:
+		remote, _, err = client.Changelog.GetWithContext(ctx, slug)
+	case TypeCustomPage:
+		remote, _, err = client.CustomPage.GetWithContext(ctx, slug)
+	default:
+		return nil, false, fmt.Errorf("unknown resource type '%s'", resourceType)
+	}
+
+	switch {
+	case errors.Is(err, readme.ErrNotFound):
+		return nil, false, nil
+	case err != nil:
+		return nil, false, fmt.Errorf("unable to get %s '%s': %w", resourceType, slug, err)
+	}
+
+	return remote, true, nil
+}
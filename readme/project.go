@@ -1,5 +1,10 @@
 package readme
 
+import (
+	"context"
+	"log/slog"
+)
+
 // ProjectEndpoint is the ReadMe API URL endpoint for Project metadata.
 const ProjectEndpoint = "/"
 
@@ -11,6 +16,9 @@ type ProjectService interface {
 	//
 	// API Reference: https://docs.readme.com/main/reference/getproject
 	Get() (Project, *APIResponse, error)
+
+	// GetWithContext retrieves project metadata from the ReadMe.com API using the provided context.
+	GetWithContext(ctx context.Context) (Project, *APIResponse, error)
 }
 
 // ProjectClient handles communication with the Project related methods of the ReadMe.com API.
@@ -35,14 +43,35 @@ type Project struct {
 }
 
 // Ensure the implementation satisfies the expected interfaces.
-var _ ProjectService = &ProjectClient{}
+var (
+	_ ProjectService = &ProjectClient{}
+	_ slog.LogValuer = Project{}
+)
+
+// LogValue implements slog.LogValuer so the JWT secret is redacted when a Project is logged.
+func (p Project) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("baseUrl", p.BaseURL),
+		slog.String("jwtSecret", redacted),
+		slog.String("name", p.Name),
+		slog.String("plan", p.Plan),
+		slog.String("subdomain", p.SubDomain),
+	)
+}
 
 // Get retrieves project metadata from the ReadMe.com API.
 //
 // API Reference: https://docs.readme.com/main/reference/getproject
 func (c ProjectClient) Get() (Project, *APIResponse, error) {
+	return c.GetWithContext(context.Background())
+}
+
+// GetWithContext retrieves project metadata from the ReadMe.com API using the provided context.
+func (c ProjectClient) GetWithContext(ctx context.Context) (Project, *APIResponse, error) {
+	ctx = withOperation(ctx, "Project", "Get")
+
 	project := Project{}
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "GET",
 		Endpoint:     ProjectEndpoint,
 		UseAuth:      true,
@@ -0,0 +1,224 @@
+package readme
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// RateLimitLimitHeader is the name of the HTTP response header with the number of requests
+	// allowed in the current rate limit window.
+	RateLimitLimitHeader = "x-ratelimit-limit"
+
+	// RateLimitRemainingHeader is the name of the HTTP response header with the number of requests
+	// remaining in the current rate limit window.
+	RateLimitRemainingHeader = "x-ratelimit-remaining"
+
+	// RateLimitResetHeader is the name of the HTTP response header with the time the current rate
+	// limit window resets, either as a number of seconds or a Unix timestamp.
+	RateLimitResetHeader = "x-ratelimit-reset"
+)
+
+// RateLimit is the rate limit reported by the API in the headers of a response.
+type RateLimit struct {
+	// Limit is the number of requests allowed in the current rate limit window, or -1 if the header
+	// isn't set.
+	Limit int
+	// Remaining is the number of requests remaining in the current rate limit window, or -1 if the
+	// header isn't set.
+	Remaining int
+	// Reset is the time the current rate limit window resets, or the zero time if the header isn't
+	// set.
+	Reset time.Time
+}
+
+// parseRateLimit parses the rate limit headers of a response and returns nil if none are set.
+func parseRateLimit(header http.Header, now time.Time) *RateLimit {
+	rateLimit := &RateLimit{Limit: -1, Remaining: -1}
+	found := false
+
+	if limit, err := strconv.Atoi(header.Get(RateLimitLimitHeader)); err == nil {
+		rateLimit.Limit = limit
+		found = true
+	}
+
+	if remaining, err := strconv.Atoi(header.Get(RateLimitRemainingHeader)); err == nil {
+		rateLimit.Remaining = remaining
+		found = true
+	}
+
+	if reset, ok := parseRateLimitReset(header.Get(RateLimitResetHeader), now); ok {
+		rateLimit.Reset = reset
+		found = true
+	}
+
+	if !found {
+		return nil
+	}
+
+	return rateLimit
+}
+
+// RateLimiter controls the rate of requests made by a Client.
+//
+// Every request made with Client.APIRequest() waits on the limiter before it's sent, including each
+// page of a paginated request and each retry. A limiter may be shared between multiple clients,
+// such as clients that use the same API key, so implementations must be safe for concurrent use.
+type RateLimiter interface {
+	// Wait blocks until a request may be made or the context is done.
+	Wait(ctx context.Context) error
+
+	// Observe is called with every HTTP response so the limiter can adapt to the rate limit
+	// reported by the API.
+	Observe(response *http.Response)
+}
+
+// TokenBucketLimiter is a RateLimiter that allows requests at a steady rate with bursts up to a
+// maximum size.
+//
+// The limiter adapts to the API's rate limit headers: when the API reports that no requests remain
+// in the current window, or responds with a Retry-After header, requests are paused until the
+// window resets.
+type TokenBucketLimiter struct {
+	mu          sync.Mutex
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ RateLimiter = &TokenBucketLimiter{}
+
+// NewTokenBucketLimiter returns a TokenBucketLimiter that allows `requestsPerSecond` requests per
+// second on average with bursts of up to `burst` requests.
+func NewTokenBucketLimiter(requestsPerSecond float64, burst int) *TokenBucketLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &TokenBucketLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request may be made or the context is done.
+func (l *TokenBucketLimiter) Wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay == 0 {
+			return nil
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token if one is available and returns zero, otherwise it returns how long to wait
+// before trying again.
+func (l *TokenBucketLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+
+	l.refill(now)
+	if l.tokens >= 1 {
+		l.tokens--
+
+		return 0
+	}
+
+	if l.rate <= 0 {
+		// Without a refill rate, tokens are only replenished by the API's rate limit headers.
+		return time.Second
+	}
+
+	return time.Duration(math.Ceil((1 - l.tokens) / l.rate * float64(time.Second)))
+}
+
+// refill adds the tokens accumulated since the last refill, up to the burst size.
+func (l *TokenBucketLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last).Seconds()
+	l.last = now
+
+	if elapsed > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+elapsed*l.rate)
+	}
+}
+
+// Observe adapts the limiter to the rate limit headers in an API response.
+//
+// When the API reports fewer remaining requests than the limiter has tokens, the tokens are reduced
+// to match. When no requests remain, or the API responds with a Retry-After header, requests are
+// paused until the reported reset time.
+func (l *TokenBucketLimiter) Observe(response *http.Response) {
+	if response == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+
+	if response.StatusCode == http.StatusTooManyRequests {
+		if delay, ok := parseRetryAfter(response.Header.Get(RetryAfterHeader)); ok {
+			l.pause(now.Add(delay))
+		}
+	}
+
+	remaining, err := strconv.Atoi(response.Header.Get(RateLimitRemainingHeader))
+	if err != nil {
+		return
+	}
+
+	if float64(remaining) < l.tokens {
+		l.tokens = float64(remaining)
+	}
+
+	if remaining > 0 {
+		return
+	}
+
+	if reset, ok := parseRateLimitReset(response.Header.Get(RateLimitResetHeader), now); ok {
+		l.pause(reset)
+	}
+}
+
+// pause stops requests until the given time, unless they're already paused for longer.
+func (l *TokenBucketLimiter) pause(until time.Time) {
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// parseRateLimitReset parses the value of a rate limit reset header, which may either be a number of
+// seconds until the reset or a Unix timestamp.
+func parseRateLimitReset(value string, now time.Time) (time.Time, bool) {
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds < 0 {
+		return time.Time{}, false
+	}
+
+	// Values larger than a year of seconds are treated as a Unix timestamp.
+	if seconds > int64((365 * 24 * time.Hour).Seconds()) {
+		return time.Unix(seconds, 0), true
+	}
+
+	return now.Add(time.Duration(seconds) * time.Second), true
+}
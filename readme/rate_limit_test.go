@@ -0,0 +1,203 @@
+package readme_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/h2non/gock"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingLimiter is a RateLimiter that counts how many times it's used.
+type countingLimiter struct {
+	mu       sync.Mutex
+	waits    int
+	observed []int
+}
+
+func (l *countingLimiter) Wait(_ context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.waits++
+
+	return nil
+}
+
+func (l *countingLimiter) Observe(response *http.Response) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.observed = append(l.observed, response.StatusCode)
+}
+
+func Test_TokenBucketLimiter(t *testing.T) {
+	t.Run("when requests exceed the burst size", func(t *testing.T) {
+		// Arrange
+		limiter := readme.NewTokenBucketLimiter(20, 1)
+		start := time.Now()
+
+		// Act
+		for range 3 {
+			assert.NoError(t, limiter.Wait(context.Background()), "it does not return an error")
+		}
+
+		// Assert
+		assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond,
+			"it waits for tokens to refill at the configured rate")
+	})
+
+	t.Run("when the API reports no remaining requests", func(t *testing.T) {
+		// Arrange
+		limiter := readme.NewTokenBucketLimiter(100, 10)
+		limiter.Observe(&http.Response{
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{"60"},
+			},
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		// Act
+		err := limiter.Wait(ctx)
+
+		// Assert
+		assert.ErrorIs(t, err, context.DeadlineExceeded, "it waits until the rate limit resets")
+	})
+
+	t.Run("when the API responds with 429 and Retry-After", func(t *testing.T) {
+		// Arrange
+		limiter := readme.NewTokenBucketLimiter(100, 10)
+		limiter.Observe(&http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{"Retry-After": []string{"60"}},
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		// Act
+		err := limiter.Wait(ctx)
+
+		// Assert
+		assert.ErrorIs(t, err, context.DeadlineExceeded, "it waits for the Retry-After delay")
+	})
+
+	t.Run("when the API reports remaining requests", func(t *testing.T) {
+		// Arrange
+		limiter := readme.NewTokenBucketLimiter(100, 10)
+		limiter.Observe(&http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"X-Ratelimit-Remaining": []string{"5"}},
+		})
+
+		// Act
+		err := limiter.Wait(context.Background())
+
+		// Assert
+		assert.NoError(t, err, "it does not wait")
+	})
+}
+
+func Test_Client_RateLimiter(t *testing.T) {
+	// Arrange
+	limiter := &countingLimiter{}
+	first, _ := readme.NewClient("test", readme.WithAPIURL(TestClientBaseURL))
+	second, _ := readme.NewClient("test", readme.WithAPIURL(TestClientBaseURL))
+	first.RateLimiter = limiter
+	second.RateLimiter = limiter
+
+	gock.New(TestClient.APIURL).
+		Get(readme.ProjectEndpoint).
+		Reply(200).
+		JSON(testdata.Project)
+	gock.New(TestClient.APIURL).
+		Get(readme.CategoryEndpoint).
+		MatchParam("page", "1").
+		Reply(200).
+		SetHeader("x-total-count", "2").
+		SetHeader("link", `</categories?perPage=1&page=2>; rel="next", <>; rel="prev", <>; rel="last"`).
+		JSON([]readme.Category{testdata.Categories[0]})
+	gock.New(TestClient.APIURL).
+		Get(readme.CategoryEndpoint).
+		MatchParam("page", "2").
+		Reply(200).
+		SetHeader("x-total-count", "2").
+		SetHeader("link", `<>; rel="next", </categories?perPage=1&page=1>; rel="prev", <>; rel="last"`).
+		JSON([]readme.Category{testdata.Categories[1]})
+	defer gock.Off()
+
+	// Act
+	_, _, projectErr := first.Project.Get()
+	_, _, categoryErr := second.Category.GetAll(readme.RequestOptions{PerPage: 1})
+
+	// Assert
+	assert.NoError(t, projectErr, "it does not return an error")
+	assert.NoError(t, categoryErr, "it does not return an error")
+	assert.Equal(t, 3, limiter.waits, "it waits on the shared limiter for every request and page")
+	assert.Equal(t, []int{200, 200, 200}, limiter.observed, "it observes every response")
+	assert.True(t, gock.IsDone(), "it makes the expected API calls")
+}
+
+func Test_APIResponse_RateLimit(t *testing.T) {
+	t.Run("when the response has rate limit headers", func(t *testing.T) {
+		// Arrange
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(200).
+			SetHeader("x-ratelimit-limit", "100").
+			SetHeader("x-ratelimit-remaining", "42").
+			SetHeader("x-ratelimit-reset", "1700000000").
+			JSON(testdata.Project)
+		defer gock.Off()
+
+		// Act
+		_, apiResponse, err := TestClient.Project.Get()
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		expect := &readme.RateLimit{Limit: 100, Remaining: 42, Reset: time.Unix(1700000000, 0)}
+		assert.Equal(t, expect, apiResponse.RateLimit, "it returns the rate limit")
+	})
+
+	t.Run("when the response has some rate limit headers", func(t *testing.T) {
+		// Arrange
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(200).
+			SetHeader("x-ratelimit-remaining", "0").
+			SetHeader("x-ratelimit-reset", "30").
+			JSON(testdata.Project)
+		defer gock.Off()
+
+		// Act
+		_, apiResponse, _ := TestClient.Project.Get()
+
+		// Assert
+		assert.Equal(t, -1, apiResponse.RateLimit.Limit, "it returns -1 for a missing limit")
+		assert.Equal(t, 0, apiResponse.RateLimit.Remaining, "it returns the remaining requests")
+		assert.WithinDuration(t, time.Now().Add(30*time.Second), apiResponse.RateLimit.Reset, time.Second,
+			"it returns the reset time relative to the response")
+	})
+
+	t.Run("when the response doesn't have rate limit headers", func(t *testing.T) {
+		// Arrange
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(200).
+			JSON(testdata.Project)
+		defer gock.Off()
+
+		// Act
+		_, apiResponse, _ := TestClient.Project.Get()
+
+		// Assert
+		assert.Nil(t, apiResponse.RateLimit, "it doesn't return a rate limit")
+	})
+}
@@ -6,12 +6,12 @@ package readme
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"reflect"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
@@ -28,6 +28,9 @@ const (
 	// ReadmeAPIURL is the default base URL for the ReadMe API.
 	ReadmeAPIURL = "https://dash.readme.com/api/v1"
 
+	// RequestIDHeader is the name of the HTTP response header with the unique identifier of a request.
+	RequestIDHeader = "x-request-id"
+
 	// TotalCountHeader is the name of the HTTP response header with the total count in results.
 	TotalCountHeader = "x-total-count"
 
@@ -43,10 +46,42 @@ var IDValidCharacters = regexp.MustCompile("^[0-9a-zA-Z]+$")
 type Client struct {
 	// APIURL is the base URL for the ReadMe API.
 	APIURL string
+	// CacheConfig configures caching of API responses. Responses aren't cached when this is nil.
+	CacheConfig *CacheConfig
+	// DefaultHeaders lists HTTP headers to send with every request.
+	// Headers set by a request take precedence.
+	DefaultHeaders RequestHeader
+	// DefaultVersion is the project version used for requests that don't specify a version.
+	DefaultVersion string
+	// DryRun records POST, PUT and DELETE requests as planned operations instead of sending them.
+	// Requests are sent when this is nil.
+	DryRun *DryRun
 	// HTTPClient is the initialized HTTP client.
 	HTTPClient *http.Client
+	// ImageAPIURL is the base URL for uploading images to ReadMe.
+	ImageAPIURL string
+	// LogConfig configures logging of requests. Requests aren't logged when this is nil.
+	LogConfig *LogConfig
+	// MetricsRecorder records metrics about every request. Metrics aren't recorded when this is nil.
+	MetricsRecorder MetricsRecorder
+	// Middleware lists the middleware applied to every request, in order from outermost to
+	// innermost.
+	Middleware []Middleware
+	// RateLimiter limits the rate of requests made by the client. It may be shared between clients.
+	// Requests aren't limited when this is nil.
+	RateLimiter RateLimiter
+	// ResolverCache remembers the slugs and versions that IDs resolve to. IDs are looked up with every
+	// request that uses them when this is nil.
+	ResolverCache *ResolverCache
+	// RetryPolicy configures retrying requests that fail with a transient error.
+	// Requests aren't retried when this is nil.
+	RetryPolicy *RetryPolicy
 	// Token is the API token for authenticating with ReadMe.
 	Token string
+	// Tracer creates spans for requests and operations. Requests aren't traced when this is nil.
+	Tracer Tracer
+	// UserAgent is the value of the User-Agent header sent with requests.
+	UserAgent string
 
 	// APIRegistry implements the ReadMe API Registry API for managing API definitions.
 	APIRegistry APIRegistryService
@@ -70,6 +105,10 @@ type Client struct {
 	Project ProjectService
 	// Version implements the ReadMe Version API for managing versions.
 	Version VersionService
+
+	// timeout is the HTTP client timeout set with WithTimeout(), which is applied after every other
+	// option.
+	timeout *time.Duration
 }
 
 // RequestHeader represents an HTTP header set on requests.
@@ -110,12 +149,29 @@ type APIRequest struct {
 type APIResponse struct {
 	// APIErrorResponse is a structured error from the ReadMe API when a request results in error.
 	APIErrorResponse APIErrorResponse
+	// Attempts is the number of times the request was attempted, including any retries.
+	Attempts int
 	// Body is the response body in bytes.
 	Body []byte
 	// HTTPResponse is the stdlib http.Response type.
 	HTTPResponse *http.Response
+	// LogID is the identifier of the ReadMe Metrics log for the request, parsed from the Docs URL of
+	// an API error. It's empty if the request didn't result in an error with a log URL.
+	LogID string
+	// Pages lists the responses of every page requested by an operation that requests multiple
+	// pages, such as GetAll(), in page order. It's only set on the response returned by the
+	// operation, and the responses it lists are copies that don't have their own Pages.
+	Pages []*APIResponse
+	// Pagination is the pagination information of a response to a request for a page of results. It's
+	// nil for requests that aren't paginated.
+	Pagination *Pagination
+	// RateLimit is the rate limit reported by the API in the response headers. It's nil if the
+	// response doesn't have rate limit headers.
+	RateLimit *RateLimit
 	// Request is the APIRequest struct used to create the request.
 	Request *APIRequest
+	// RequestID is the unique identifier of the request from the x-request-id response header.
+	RequestID string
 }
 
 // APIErrorResponse represents the response ReadMe provides in the body of requests that failed.
@@ -137,6 +193,11 @@ type APIErrorResponse struct {
 
 // RequestOptions is used for specifying options for requests, such as pagination options.
 type RequestOptions struct {
+	// Concurrency is the maximum number of pages requested at the same time by methods that return
+	// every page of results, such as Category.GetAll(). After the first page is requested, the
+	// remaining pages are requested concurrently. Pages are requested one at a time when this is
+	// less than 2 or when the API doesn't return the total number of items.
+	Concurrency int
 	// Headers is a list of additional headers to add to the request.
 	Headers []RequestHeader
 	// PerPage is the number of items to return in each request when using pagination.
@@ -153,20 +214,28 @@ type RequestOptions struct {
 
 // NewClient initializes the API client configuration and returns the HTTP client with an auth token and URL set.
 //
-// Optionally provide a custom API URL as a second parameter.
-func NewClient(token string, apiURL ...string) (*Client, error) {
+// Optionally provide options to customize the client, such as WithAPIURL() to use a custom API URL:
+//
+//	client, err := readme.NewClient(token, readme.WithAPIURL("http://localhost:8080/api/v1"))
+func NewClient(token string, options ...ClientOption) (*Client, error) {
 	client := &Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		APIURL:      ReadmeAPIURL,
+		HTTPClient:  &http.Client{Timeout: 10 * time.Second},
+		ImageAPIURL: ImageAPIURL,
+		Token:       token,
+		UserAgent:   UserAgent,
 	}
-	client.APIURL = ReadmeAPIURL
-	client.Token = token
 
-	if apiURL != nil {
-		if len(apiURL) > 1 {
-			return nil, fmt.Errorf("unable to configure ReadMe API client: "+
-				"too many values specified for API URL (got: %v; expects 1)", len(apiURL))
+	for _, option := range options {
+		if err := option(client); err != nil {
+			return nil, fmt.Errorf("unable to configure ReadMe API client: %w", err)
 		}
-		client.APIURL = apiURL[0]
+	}
+
+	if client.timeout != nil {
+		httpClient := *client.HTTPClient
+		httpClient.Timeout = *client.timeout
+		client.HTTPClient = &httpClient
 	}
 
 	client.APIRegistry = &APIRegistryClient{client: client}
@@ -188,113 +257,192 @@ func NewClient(token string, apiURL ...string) (*Client, error) {
 //
 // This function is called directly by the receiver functions used to implement each endpoint.
 func (c *Client) APIRequest(request *APIRequest) (*APIResponse, error) {
-	// Perform the request
-	body, httpResponse, err := c.doRequest(request)
+	return c.APIRequestWithContext(context.Background(), request)
+}
+
+// APIRequestWithContext performs a request to the ReadMe API using the provided context and handles
+// parsing the response and API errors.
+//
+// The context is attached to the outgoing HTTP request, so cancelling it or reaching its deadline
+// aborts the request. It also interrupts the wait between attempts when the client's RetryPolicy
+// retries a failed request.
+func (c *Client) APIRequestWithContext(ctx context.Context, request *APIRequest) (*APIResponse, error) {
+	ctx, span := c.startRequestSpan(ctx, request)
+	start := time.Now()
+	apiResponse, err := c.apiRequest(ctx, request)
+	c.recordMetrics(ctx, request, apiResponse, time.Since(start), err)
+	endRequestSpan(span, request, apiResponse, err)
+
+	return apiResponse, err
+}
+
+// apiRequest performs a request to the ReadMe API, retrying it according to the retry policy, and
+// handles parsing the response and API errors.
+func (c *Client) apiRequest(ctx context.Context, request *APIRequest) (*APIResponse, error) {
+	var apiResponse *APIResponse
+	var err error
+
+	// Perform the request, retrying it according to the retry policy.
+	attempt := 1
+	for ; ; attempt++ {
+		if c.RateLimiter != nil {
+			if waitErr := c.RateLimiter.Wait(ctx); waitErr != nil {
+				return nil, fmt.Errorf("unable to wait for rate limiter: %w", waitErr)
+			}
+		}
+
+		// Errors from preparing the request, such as an invalid URL, would fail every attempt, so
+		// they're returned without retrying.
+		httpRequest, prepareErr := c.prepareRequest(ctx, request)
+		if prepareErr != nil {
+			return nil, prepareErr
+		}
+
+		start := time.Now()
+		apiResponse, err = c.doRequest(ctx, request, httpRequest)
+		duration := time.Since(start)
+
+		var response *http.Response
+		if err == nil {
+			response = apiResponse.HTTPResponse
+		}
+
+		if c.RateLimiter != nil {
+			c.RateLimiter.Observe(response)
+		}
+
+		delay, retry := c.RetryPolicy.shouldRetry(request, attempt, response, err)
+		c.LogConfig.log(ctx, requestLog{
+			request:     request,
+			httpRequest: httpRequest,
+			response:    apiResponse,
+			err:         err,
+			attempt:     attempt,
+			duration:    duration,
+			retry:       retry,
+			retryDelay:  delay,
+		})
+		if !retry {
+			break
+		}
+
+		if sleepErr := sleep(ctx, delay); sleepErr != nil {
+			return nil, fmt.Errorf("unable to retry request: %w", sleepErr)
+		}
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
-	apiResponse := &APIResponse{
-		Body:         body,
-		HTTPResponse: &httpResponse,
-		Request:      request,
-	}
+	apiResponse.Attempts = attempt
+	apiResponse.Request = request
+	apiResponse.RateLimit = parseRateLimit(apiResponse.HTTPResponse.Header, time.Now())
+	apiResponse.RequestID = apiResponse.HTTPResponse.Header.Get(RequestIDHeader)
 
 	// Verify the HTTP response from the API.
-	apiErrorResponse, err := checkResponseStatus(body, httpResponse.StatusCode, request)
+	apiErrorResponse, err := checkResponseStatus(apiResponse, request)
 	if err != nil {
 		apiResponse.APIErrorResponse = apiErrorResponse
+		apiResponse.LogID = parseLogID(apiErrorResponse.Docs)
 
 		return apiResponse, err
 	}
 
 	// Parse the response into the specified interface.
 	if request.Response != nil {
-		err = json.Unmarshal(body, &request.Response)
+		err = json.Unmarshal(apiResponse.Body, &request.Response)
 		if err != nil {
 			return apiResponse, fmt.Errorf("unable to parse API response: %w", err)
 		}
 	}
 
-	err = httpResponse.Body.Close()
-	if err != nil {
-		return apiResponse, fmt.Errorf("problem closing HTTP response body")
-	}
-
 	return apiResponse, nil
 }
 
-// doRequest performs an API request and returns the response or error.
-func (c *Client) doRequest(request *APIRequest) ([]byte, http.Response, error) {
-	req, err := c.prepareRequest(request)
-	if err != nil {
-		return nil, http.Response{}, err
-	}
-
-	// Perform the request.
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, http.Response{}, fmt.Errorf("unable to make request: %w", err)
-	}
-
-	if res.Body == nil {
-		return nil, *res, fmt.Errorf("response body is nil in %s request to %s", req.Method, req.URL)
-	}
-
-	body, err := io.ReadAll(res.Body)
+// doRequest performs a prepared API request through the client's middleware chain.
+func (c *Client) doRequest(ctx context.Context, request *APIRequest, req *http.Request) (*APIResponse, error) {
+	apiResponse, err := c.handler()(ctx, request, req)
 	if err != nil {
-		return nil, *res, fmt.Errorf("unable to read response: %w", err)
+		return nil, err
 	}
 
-	err = res.Body.Close()
-	if err != nil {
-		return nil, *res, fmt.Errorf("problem closing HTTP response body")
+	if apiResponse == nil || apiResponse.HTTPResponse == nil {
+		return nil, fmt.Errorf("no response returned for %s request to %s", req.Method, req.URL)
 	}
 
-	return body, *res, nil
+	return apiResponse, nil
 }
 
 // checkResponseStatus compares an HTTP response status code against a slice of 'OK' status codes.
 //
 // If the response code matches a provided code listed in okCodes, no error is returned.
-// If the response code doesn't match, an error and APIErrorResponse is returned.
-func checkResponseStatus(body []byte, responseCode int, req *APIRequest) (APIErrorResponse, error) {
+// If the response code doesn't match, an APIErrorResponse and an *APIError are returned.
+// The APIErrorResponse is empty when the body isn't a ReadMe API error, such as an HTML page from
+// a gateway.
+func checkResponseStatus(apiResponse *APIResponse, req *APIRequest) (APIErrorResponse, error) {
 	var apiErrorResponse APIErrorResponse
+	responseCode := apiResponse.HTTPResponse.StatusCode
 	for _, okCode := range req.OkStatusCode {
 		if responseCode == okCode {
 			return apiErrorResponse, nil
 		}
 	}
 
-	err := json.Unmarshal(body, &apiErrorResponse)
+	if err := json.Unmarshal(apiResponse.Body, &apiErrorResponse); err != nil {
+		apiErrorResponse = APIErrorResponse{}
+	}
+
+	return apiErrorResponse, &APIError{
+		StatusCode: responseCode,
+		Method:     req.Method,
+		Endpoint:   req.Endpoint,
+		RequestID:  apiResponse.RequestID,
+		Response:   apiErrorResponse,
+		Body:       apiResponse.Body,
+	}
+}
+
+// parseLogID returns the identifier of a ReadMe Metrics log from its URL, such as
+// "https://docs.readme.com/logs/6883d0ee-cf79-447a-826f-a48f7d5bdf5f", or an empty string if the
+// URL isn't a log URL.
+func parseLogID(docs string) string {
+	parsed, err := url.Parse(docs)
 	if err != nil {
-		return apiErrorResponse, fmt.Errorf("unable to decode API error response: %w", err)
+		return ""
 	}
 
-	return apiErrorResponse,
-		fmt.Errorf("ReadMe API Error: %v on %s %s: %s",
-			responseCode, req.Method, req.Endpoint, body)
+	parts := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(parts) < 2 || parts[len(parts)-2] != "logs" {
+		return ""
+	}
+
+	return parts[len(parts)-1]
 }
 
 // prepareRequest prepares an http.Request for the ReadMe API.
 //
 // This sets common headers and prepares an optional payload for the request.
-func (c *Client) prepareRequest(request *APIRequest) (*http.Request, error) {
+func (c *Client) prepareRequest(ctx context.Context, request *APIRequest) (*http.Request, error) {
 	// Prepare the request.
 	if request.URL == "" {
 		request.URL = c.APIURL + request.Endpoint
 	}
-	req, reqErr := http.NewRequest(request.Method, request.URL, nil)
+	req, reqErr := http.NewRequestWithContext(ctx, request.Method, request.URL, nil)
 
 	if request.Payload != nil {
 		data := bytes.NewBuffer(request.Payload)
-		req, reqErr = http.NewRequest(request.Method, request.URL, data)
+		req, reqErr = http.NewRequestWithContext(ctx, request.Method, request.URL, data)
 	}
 
 	if reqErr != nil {
 		return nil, fmt.Errorf("unable to prepare request: %w", reqErr)
 	}
 
+	for header, value := range c.DefaultHeaders {
+		req.Header.Set(header, value)
+	}
+
 	for _, r := range request.Headers {
 		for header, value := range r {
 			req.Header.Set(header, value)
@@ -307,131 +455,95 @@ func (c *Client) prepareRequest(request *APIRequest) (*http.Request, error) {
 		req.Header.Set("authorization", authHeader)
 	}
 
-	if request.RequestOptions.Version != "" {
-		req.Header.Set("x-readme-version", request.RequestOptions.Version)
+	version := request.RequestOptions.Version
+	if version == "" {
+		version = c.DefaultVersion
+	}
+	if version != "" {
+		req.Header.Set("x-readme-version", version)
+	}
+
+	userAgent := c.UserAgent
+	if userAgent == "" {
+		userAgent = UserAgent
 	}
 
 	req.Header.Set("accept", "application/json")
-	req.Header.Set("User-Agent", UserAgent)
+	req.Header.Set("User-Agent", userAgent)
+
+	c.injectTraceContext(ctx, req.Header)
 
 	return req, nil
 }
 
-// paginatedRequest makes a request to the ReadMe API with pagination query parameters set.
+// paginatedRequest makes a request to the ReadMe API for a page of results and returns the
+// APIResponse struct and the pagination links from the response.
 //
 // An abbreviated *APIRequest struct should be passed, leaving the Headers and Version fields unset.
 // These are derived from the RequestOptions field.
 //
-// This function is intended to be called within a loop and returns the APIResponse struct and a
-// boolean indicating if there is a next page indicated in the pagination header.
-func (c *Client) paginatedRequest(apiRequest *APIRequest, page int) (*APIResponse, bool, error) {
-	// Set default values
-	perPage := 100
-
-	// Check for custom values in RequestOptions
-	if apiRequest.RequestOptions.PerPage != 0 {
-		perPage = apiRequest.RequestOptions.PerPage
-	}
+// When pageURL is empty, the page is requested by setting the pagination query parameters on the
+// request's endpoint. Otherwise pageURL, such as the "next" link from a previous page, is requested
+// as-is.
+func (c *Client) paginatedRequest(
+	ctx context.Context,
+	apiRequest *APIRequest,
+	page int,
+	pageURL string,
+) (*APIResponse, Links, error) {
 	if apiRequest.RequestOptions.Headers != nil {
 		apiRequest.Headers = apiRequest.RequestOptions.Headers
 	}
+	apiRequest.RequestOptions.Page = page
 
-	// Add pagination parameters to endpoint
-	baseEndpoint := apiRequest.Endpoint
-	apiRequest.Endpoint = fmt.Sprintf("%s?perPage=%d&page=%d", baseEndpoint, perPage, page)
-
-	if apiRequest.URL == "" {
+	if pageURL == "" {
+		perPage := perPageOption(&apiRequest.RequestOptions)
+		apiRequest.Endpoint = fmt.Sprintf("%s?perPage=%d&page=%d", apiRequest.Endpoint, perPage, page)
 		apiRequest.URL = c.APIURL + apiRequest.Endpoint
+	} else {
+		apiRequest.Endpoint, apiRequest.URL = c.resolveLink(pageURL)
 	}
 
 	// Make API request
-	apiResponse, err := c.APIRequest(apiRequest)
+	apiResponse, err := c.APIRequestWithContext(ctx, apiRequest)
 	if err != nil {
-		return apiResponse, false, fmt.Errorf("unable to make request: %w", err)
+		return apiResponse, Links{}, fmt.Errorf("unable to make request: %w", err)
 	}
 
-	// Check for next page
-	hasNextPage, err := HasNextPage(apiResponse.HTTPResponse.Header.Get(PaginationHeader))
+	links, err := ParseLinkHeader(apiResponse.HTTPResponse.Header.Get(PaginationHeader))
 	if err != nil {
-		return apiResponse, false, fmt.Errorf(
-			"unable to check pagination link header '%s': %w; ",
+		return apiResponse, Links{}, fmt.Errorf(
+			"unable to check pagination link header '%s': %w",
 			PaginationHeader,
 			err,
 		)
 	}
-	if !hasNextPage {
-		return apiResponse, false, nil
-	}
 
-	// Get total count of items
-	totalCountHeader := apiResponse.HTTPResponse.Header.Get(TotalCountHeader)
-	totalCount, err := strconv.Atoi(totalCountHeader)
-	if err != nil {
-		return apiResponse, false, fmt.Errorf(
-			"unable to parse '%s' header: %w; Response: %v",
-			TotalCountHeader,
-			err,
-			apiResponse,
-		)
+	apiResponse.Pagination = &Pagination{
+		Page:    page,
+		PerPage: perPageOption(&apiRequest.RequestOptions),
+		Links:   links,
 	}
+	apiResponse.Pagination.TotalCount, _ = strconv.Atoi(apiResponse.HTTPResponse.Header.Get(TotalCountHeader))
 
-	// Check if current page is last page
-	if page >= (totalCount / perPage) {
-		return apiResponse, false, nil
-	}
-
-	return apiResponse, true, nil
+	return apiResponse, links, nil
 }
 
-func (c *Client) fetchAllPages(
-	endpoint string,
-	options *RequestOptions,
-	result interface{},
-) (*APIResponse, error) {
-	hasNextPage := false
-	page := 1
-	var apiResponse *APIResponse
-	var err error
-
-	// Convert the result to a reflect.Value to manipulate the underlying slice
-	resultValue := reflect.ValueOf(result)
-	if resultValue.Kind() != reflect.Ptr || resultValue.Elem().Kind() != reflect.Slice {
-		return nil, fmt.Errorf("result argument must be a pointer to a slice")
-	}
-
-	if options != nil && options.Page != 0 {
-		page = options.Page
+// resolveLink returns the endpoint and full URL of a pagination link.
+//
+// ReadMe returns links that are relative to the API base URL, such as "/categories?page=2". Links
+// that include the path of the base URL, or are absolute URLs, are also supported.
+func (c *Client) resolveLink(link string) (string, string) {
+	parsed, err := url.Parse(link)
+	if err != nil || parsed.IsAbs() {
+		return link, link
 	}
 
-	for {
-		pageResults := reflect.New(resultValue.Elem().Type()).Interface()
-
-		apiRequest := &APIRequest{
-			Method:       "GET",
-			Endpoint:     endpoint,
-			UseAuth:      true,
-			OkStatusCode: []int{200},
-			Response:     pageResults,
-		}
-		if options != nil {
-			apiRequest.RequestOptions = *options
-		}
-
-		apiResponse, hasNextPage, err = c.paginatedRequest(apiRequest, page)
-		if err != nil {
-			return apiResponse, fmt.Errorf("unable to retrieve data: %w", err)
-		}
-
-		// Append the current page's results to the original result slice
-		resultValue.Elem().Set(reflect.AppendSlice(resultValue.Elem(), reflect.ValueOf(pageResults).Elem()))
-
-		if !hasNextPage {
-			break
-		}
-		page++
+	if base, err := url.Parse(c.APIURL); err == nil && base.Path != "" && strings.HasPrefix(link, base.Path+"/") {
+		link = strings.TrimPrefix(link, base.Path)
 	}
 
-	return apiResponse, nil
+	return link, c.APIURL + link
 }
 
 // parseRequestOptions is a helper function to parse the RequestOptions slice
@@ -446,35 +558,17 @@ func parseRequestOptions(options []RequestOptions) *RequestOptions {
 // HasNextPage checks if a "next" link is provided in the "links" response header for pagination,
 // indicating the request has a next page.
 //
-// This does a rudimentary parsing of the header value, splitting on the comma-separated links and
-// parsing the value of "rel".
-//
 // A link header looks like:
 // </api-specification?page=2>; rel="next", <>; rel="prev", <>; rel="last"
+//
+// Deprecated: Use ParseLinkHeader() and check the Next link instead.
 func HasNextPage(links string) (bool, error) {
-	// Split links by comma
-	parts := strings.Split(links, ",")
-
-	// Return error if invalid format
-	if len(parts) < 3 {
-		return false, fmt.Errorf("unable to parse link header - invalid format: "+
-			"'%s'; expected "+`'<>; rel="next", <>; rel="prev", <>; rel="last"'`, links)
-	}
-
-	// Check for "rel=next" in parts
-	for _, part := range parts {
-		rel := strings.Split(part, ";")
-		if len(rel) != 2 {
-			return false, fmt.Errorf("unable to parse link header - invalid format: "+
-				"'%s'; expected "+`'<>; rel="next", <>; rel="prev", <>; rel="last"'`, links)
-		}
-		if rel[1] == " rel=\"next\"" && rel[0] != "<>" {
-			return true, nil
-		}
+	parsed, err := ParseLinkHeader(links)
+	if err != nil {
+		return false, err
 	}
 
-	// Return false if "rel=next" is not found
-	return false, nil
+	return parsed.Next != nil, nil
 }
 
 // ValidateID is a helper script for parseID() and parseUUID() that checks a string to determine if
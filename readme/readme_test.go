@@ -1,8 +1,14 @@
 package readme_test
 
 import (
+	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/liveoaklabs/readme-api-go-client/readme"
 	"github.com/stretchr/testify/assert"
@@ -11,7 +17,7 @@ import (
 // Test Client
 const TestClientBaseURL = "http://readme-test.local/api/v1"
 
-var TestClient, TestClientErr = readme.NewClient("test", TestClientBaseURL)
+var TestClient, TestClientErr = readme.NewClient("test", readme.WithAPIURL(TestClientBaseURL))
 
 // TestNewClient tests the package's main setup function.
 func Test_NewClient(t *testing.T) {
@@ -33,16 +39,125 @@ func Test_NewClient(t *testing.T) {
 		assert.Equal(t, "test", TestClient.Token)
 	})
 
-	t.Run("when too many parameters are specified", func(t *testing.T) {
+	t.Run("when an invalid API URL is specified", func(t *testing.T) {
 		// Act
-		_, err := readme.NewClient("atoken", "one", "toomany")
+		_, err := readme.NewClient("atoken", readme.WithAPIURL("not-a-url"))
 
 		// Assert
 		assert.Error(t, err, "it returns an error")
-		assert.ErrorContains(t, err, "too many values specified for API URL", "it returns the expected error")
+		assert.ErrorContains(t, err, "invalid API URL", "it returns the expected error")
+	})
+
+	t.Run("when options are specified", func(t *testing.T) {
+		// Arrange
+		httpClient := &http.Client{}
+
+		// Act
+		client, err := readme.NewClient("atoken",
+			readme.WithHTTPClient(httpClient),
+			readme.WithTimeout(30*time.Second),
+			readme.WithUserAgent("my-tool/1.0"),
+			readme.WithImageAPIURL("http://localhost:8080/images"),
+			readme.WithDefaultVersion("1.2.3"),
+			readme.WithDefaultHeaders(readme.RequestHeader{"x-test": "true"}),
+		)
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, 30*time.Second, client.HTTPClient.Timeout, "it sets the timeout")
+		assert.Equal(t, time.Duration(0), httpClient.Timeout, "it doesn't modify the provided HTTP client")
+		assert.Equal(t, readme.UserAgent+" my-tool/1.0", client.UserAgent, "it appends to the user agent")
+		assert.Equal(t, "http://localhost:8080/images", client.ImageAPIURL, "it sets the image API URL")
+		assert.Equal(t, "1.2.3", client.DefaultVersion, "it sets the default version")
+		assert.Equal(t, readme.RequestHeader{"x-test": "true"}, client.DefaultHeaders,
+			"it sets the default headers")
+	})
+
+	t.Run("when the timeout is set before the HTTP client", func(t *testing.T) {
+		// Arrange
+		httpClient := &http.Client{}
+
+		// Act
+		client, err := readme.NewClient("atoken",
+			readme.WithTimeout(30*time.Second),
+			readme.WithHTTPClient(httpClient),
+		)
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, 30*time.Second, client.HTTPClient.Timeout, "it sets the timeout")
+		assert.Equal(t, time.Duration(0), httpClient.Timeout, "it doesn't modify the provided HTTP client")
+	})
+
+	t.Run("when an option is nil", func(t *testing.T) {
+		tests := map[string]readme.ClientOption{
+			"HTTP client":      readme.WithHTTPClient(nil),
+			"logger":           readme.WithLogger(nil),
+			"log config":       readme.WithLogConfig(nil),
+			"metrics recorder": readme.WithMetricsRecorder(nil),
+			"middleware":       readme.WithMiddleware(nil),
+			"tracer":           readme.WithTracer(nil),
+			"retry policy":     readme.WithRetryPolicy(nil),
+			"rate limiter":     readme.WithRateLimiter(nil),
+			"cache store":      readme.WithCache(nil, time.Minute),
+			"resolver cache":   readme.WithResolverCache(nil),
+			"dry run":          readme.WithDryRun(nil),
+		}
+
+		for name, option := range tests {
+			// Act
+			_, err := readme.NewClient("atoken", option)
+
+			// Assert
+			assert.ErrorContains(t, err, name+" must not be nil", "it returns an error for a nil "+name)
+		}
 	})
 }
 
+// Test_NewClient_Requests tests that client options are applied to requests.
+func Test_NewClient_Requests(t *testing.T) {
+	// Arrange
+	var got []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r)
+		_, _ = w.Write([]byte(`["https://files.readme.io/image.png", "image.png", 1, 1, "#000000"]`))
+	}))
+	defer server.Close()
+
+	client, _ := readme.NewClient("test",
+		readme.WithAPIURL(server.URL+"/api/v1"),
+		readme.WithImageAPIURL(server.URL+"/api/images"),
+		readme.WithHTTPClient(server.Client()),
+		readme.WithUserAgent("my-tool/1.0"),
+		readme.WithDefaultVersion("1.2.3"),
+		readme.WithDefaultHeaders(readme.RequestHeader{"x-test": "default", "x-other": "default"}),
+	)
+	image, _ := base64.StdEncoding.DecodeString(
+		"iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNk+A8AAQUBAScY42YAAAAASUVORK5CYII")
+
+	// Act
+	_, _, _ = client.Project.Get()
+	_, _, _ = client.Doc.Search("test", readme.RequestOptions{Version: "2.0.0"})
+	_, _, _ = client.Image.Upload(image, "image.png")
+	_, _ = client.APIRequest(&readme.APIRequest{
+		Method:       "GET",
+		Endpoint:     readme.ProjectEndpoint,
+		Headers:      []readme.RequestHeader{{"x-test": "request"}},
+		OkStatusCode: []int{200},
+	})
+
+	// Assert
+	assert.Len(t, got, 4, "it makes the expected requests")
+	assert.Equal(t, "/api/v1/", got[0].URL.Path, "it uses the API URL")
+	assert.Equal(t, readme.UserAgent+" my-tool/1.0", got[0].UserAgent(), "it sends the user agent")
+	assert.Equal(t, "1.2.3", got[0].Header.Get("x-readme-version"), "it sends the default version")
+	assert.Equal(t, "default", got[0].Header.Get("x-test"), "it sends the default headers")
+	assert.Equal(t, "2.0.0", got[1].Header.Get("x-readme-version"), "it prefers the request's version")
+	assert.Equal(t, "/api/images/image-upload", got[2].URL.Path, "it uses the image API URL")
+	assert.Equal(t, "request", got[3].Header.Get("x-test"), "it prefers the request's headers")
+	assert.Equal(t, "default", got[3].Header.Get("x-other"), "it keeps other default headers")
+}
+
 // TestHasNextPage tests parsing a 'link' header with a 'next' link for pagination.
 //
 // NOTE: `readme.HasNextPage()` refers to a private `hasNextPage()` function that has been exported for tests.
@@ -88,3 +203,52 @@ func Test_HasNextPage(t *testing.T) {
 		})
 	}
 }
+
+// Test_APIRequestWithContext tests that the request context is attached to the outgoing request.
+func Test_APIRequestWithContext(t *testing.T) {
+	t.Run("when the context deadline is exceeded", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		}))
+		defer server.Close()
+
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL), readme.WithHTTPClient(server.Client()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		// Act
+		_, err := client.APIRequestWithContext(ctx, &readme.APIRequest{
+			Method:       "GET",
+			Endpoint:     readme.ProjectEndpoint,
+			OkStatusCode: []int{200},
+		})
+
+		// Assert
+		assert.Error(t, err, "it returns an error")
+		assert.True(t, errors.Is(err, context.DeadlineExceeded), "it returns the context error")
+	})
+
+	t.Run("when the context is cancelled before paginating", func(t *testing.T) {
+		// Arrange
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL), readme.WithHTTPClient(server.Client()))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// Act
+		_, _, err := client.Category.GetAllWithContext(ctx)
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled, "it returns the context error")
+		assert.Equal(t, 0, requests, "it does not make any requests")
+	})
+}
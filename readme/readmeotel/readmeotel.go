@@ -0,0 +1,155 @@
+// Package readmeotel traces requests to the ReadMe API with OpenTelemetry.
+//
+// Create a Tracer and set it on the client to trace requests. A span is created for every request,
+// with a parent span for operations that make multiple requests, and the trace context is
+// propagated in the request headers:
+//
+//	tracer := readmeotel.NewTracer(otel.GetTracerProvider())
+//
+//	client, err := readme.NewClient(token, readme.WithTracer(tracer))
+package readmeotel
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the OpenTelemetry tracer used to create spans.
+const TracerName = "github.com/liveoaklabs/readme-api-go-client/readme/readmeotel"
+
+// Attribute keys for span attributes specific to the ReadMe API.
+const (
+	// EndpointAttributeKey is the span attribute with the ReadMe API endpoint of a request.
+	EndpointAttributeKey = attribute.Key("readme.endpoint")
+	// ErrorCodeAttributeKey is the span attribute with the error code returned by the ReadMe API.
+	ErrorCodeAttributeKey = attribute.Key("readme.error.code")
+	// VersionAttributeKey is the span attribute with the project version of a request.
+	VersionAttributeKey = attribute.Key("readme.version")
+)
+
+// Tracer is a readme.Tracer that creates OpenTelemetry spans.
+//
+// Request spans are client spans with the HTTP attributes of the OpenTelemetry semantic
+// conventions, along with the endpoint, project version and ReadMe error code attributes above.
+type Tracer struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ readme.Tracer = &Tracer{}
+
+// Option configures a Tracer.
+type Option func(*Tracer)
+
+// WithPropagator sets the propagator used to inject the trace context into the headers of requests.
+// The global propagator from otel.GetTextMapPropagator() is used when this isn't set.
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(t *Tracer) {
+		t.propagator = propagator
+	}
+}
+
+// NewTracer creates a Tracer that creates spans with the provided tracer provider.
+func NewTracer(provider trace.TracerProvider, options ...Option) *Tracer {
+	tracer := &Tracer{tracer: provider.Tracer(TracerName)}
+	for _, option := range options {
+		option(tracer)
+	}
+
+	return tracer
+}
+
+// Start starts a span for an operation or request.
+func (t *Tracer) Start(ctx context.Context, start readme.SpanStart) (context.Context, readme.Span) {
+	kind := trace.SpanKindInternal
+	if start.Kind == readme.SpanKindClient {
+		kind = trace.SpanKindClient
+	}
+
+	var attributes []attribute.KeyValue
+	if start.Method != "" {
+		attributes = append(attributes, semconv.HTTPRequestMethodKey.String(start.Method))
+	}
+	if start.Endpoint != "" {
+		attributes = append(attributes, EndpointAttributeKey.String(start.Endpoint))
+	}
+	if start.Version != "" {
+		attributes = append(attributes, VersionAttributeKey.String(start.Version))
+	}
+
+	ctx, span := t.tracer.Start(ctx, start.Name, trace.WithSpanKind(kind), trace.WithAttributes(attributes...))
+
+	return ctx, otelSpan{span: span}
+}
+
+// Inject adds the trace context headers to a request.
+func (t *Tracer) Inject(ctx context.Context, header http.Header) {
+	propagator := t.propagator
+	if propagator == nil {
+		propagator = otel.GetTextMapPropagator()
+	}
+
+	propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// otelSpan is a readme.Span backed by an OpenTelemetry span.
+type otelSpan struct {
+	span trace.Span
+}
+
+// End records the result of the span's operation or request and ends the span.
+func (s otelSpan) End(end readme.SpanEnd) {
+	if s.span.IsRecording() {
+		s.span.SetAttributes(endAttributes(end)...)
+	}
+
+	if end.Err != nil {
+		s.span.RecordError(end.Err)
+		s.span.SetStatus(codes.Error, end.Err.Error())
+	}
+	s.span.End()
+}
+
+// endAttributes returns the attributes recorded on a span when it ends.
+func endAttributes(end readme.SpanEnd) []attribute.KeyValue {
+	var attributes []attribute.KeyValue
+
+	if end.URL != "" {
+		if parsed, err := url.Parse(end.URL); err == nil {
+			attributes = append(attributes,
+				semconv.URLFull(parsed.Redacted()),
+				semconv.ServerAddress(parsed.Hostname()),
+			)
+		}
+	}
+
+	if end.Retries > 0 {
+		attributes = append(attributes, semconv.HTTPRequestResendCount(end.Retries))
+	}
+
+	if end.StatusCode != 0 {
+		attributes = append(attributes, semconv.HTTPResponseStatusCode(end.StatusCode))
+	}
+
+	var apiErr *readme.APIError
+	if errors.As(end.Err, &apiErr) {
+		attributes = append(attributes, semconv.ErrorTypeKey.String(strconv.Itoa(apiErr.StatusCode)))
+		if apiErr.Response.Error != "" {
+			attributes = append(attributes, ErrorCodeAttributeKey.String(apiErr.Response.Error))
+		}
+	}
+
+	return attributes
+}
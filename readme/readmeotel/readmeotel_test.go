@@ -0,0 +1,190 @@
+package readmeotel_test
+
+import (
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/readme/readmeotel"
+	"github.com/liveoaklabs/readme-api-go-client/tests/testdata"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// testBaseURL is the API URL of the test clients.
+const testBaseURL = "http://readme-test.local/api/v1"
+
+// newTracingClient returns a test client that records spans with an in-memory exporter.
+func newTracingClient(t *testing.T) (*readme.Client, *tracetest.InMemoryExporter) {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	client, err := readme.NewClient("test",
+		readme.WithAPIURL(testBaseURL),
+		readme.WithTracer(readmeotel.NewTracer(provider, readmeotel.WithPropagator(propagation.TraceContext{}))),
+	)
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	return client, exporter
+}
+
+// spanAttributes returns the attributes of a span as a map.
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attributes := map[attribute.Key]attribute.Value{}
+	for _, attr := range span.Attributes {
+		attributes[attr.Key] = attr.Value
+	}
+
+	return attributes
+}
+
+func Test_Tracer(t *testing.T) {
+	t.Run("when a request succeeds", func(t *testing.T) {
+		// Arrange
+		client, exporter := newTracingClient(t)
+		gock.New(testBaseURL).
+			Get(readme.ProjectEndpoint).
+			MatchHeader("traceparent", "^00-[0-9a-f]{32}-[0-9a-f]{16}-01$").
+			Reply(200).
+			JSON(testdata.Project)
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.Project.Get()
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.True(t, gock.IsDone(), "it propagates the trace context")
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 1, "it creates a span for the request")
+		attributes := spanAttributes(spans[0])
+		assert.Equal(t, "readme GET", spans[0].Name, "it names the span after the method")
+		assert.Equal(t, "GET", attributes["http.request.method"].AsString(), "it records the method")
+		assert.Equal(t, int64(200), attributes["http.response.status_code"].AsInt64(), "it records the status")
+		assert.Equal(t, testBaseURL+"/", attributes["url.full"].AsString(), "it records the URL")
+		assert.Equal(t, "readme-test.local", attributes["server.address"].AsString(), "it records the host")
+		assert.Equal(t, readme.ProjectEndpoint, attributes[readmeotel.EndpointAttributeKey].AsString(),
+			"it records the endpoint")
+		assert.Equal(t, codes.Unset, spans[0].Status.Code, "it doesn't set an error status")
+	})
+
+	t.Run("when the API responds with an error", func(t *testing.T) {
+		// Arrange
+		client, exporter := newTracingClient(t)
+		gock.New(testBaseURL).
+			Get(readme.DocEndpoint + "/missing").
+			Reply(404).
+			JSON(readme.APIErrorResponse{Error: "DOC_NOTFOUND"})
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.Doc.Get("missing")
+
+		// Assert
+		assert.Error(t, err, "it returns an error")
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 1, "it creates a span for the request")
+		attributes := spanAttributes(spans[0])
+		assert.Equal(t, codes.Error, spans[0].Status.Code, "it sets an error status")
+		assert.Equal(t, "DOC_NOTFOUND", attributes[readmeotel.ErrorCodeAttributeKey].AsString(),
+			"it records the ReadMe error code")
+		assert.Equal(t, "404", attributes["error.type"].AsString(), "it records the error type")
+	})
+
+	t.Run("when all pages are fetched", func(t *testing.T) {
+		// Arrange
+		client, exporter := newTracingClient(t)
+		gock.New(testBaseURL).
+			Get(readme.CategoryEndpoint).
+			MatchParam("page", "1").
+			Reply(200).
+			SetHeader("x-total-count", "2").
+			SetHeader("link", `</categories?perPage=1&page=2>; rel="next", <>; rel="prev", <>; rel="last"`).
+			JSON([]readme.Category{testdata.Categories[0]})
+		gock.New(testBaseURL).
+			Get(readme.CategoryEndpoint).
+			MatchParam("page", "2").
+			Reply(200).
+			SetHeader("x-total-count", "2").
+			SetHeader("link", `<>; rel="next", </categories?perPage=1&page=1>; rel="prev", <>; rel="last"`).
+			JSON([]readme.Category{testdata.Categories[1]})
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.Category.GetAll(readme.RequestOptions{PerPage: 1, Version: "1.1.2"})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 3, "it creates a span for each page and the operation")
+		parent := spans[2]
+		assert.Equal(t, "readme.fetchAllPages", parent.Name, "it creates a parent span")
+		for _, span := range spans[:2] {
+			assert.Equal(t, parent.SpanContext.SpanID(), span.Parent.SpanID(), "it nests the page spans")
+			assert.Equal(t, "1.1.2", spanAttributes(span)[readmeotel.VersionAttributeKey].AsString(),
+				"it records the version")
+		}
+	})
+
+	t.Run("when a category is retrieved by ID", func(t *testing.T) {
+		// Arrange
+		client, exporter := newTracingClient(t)
+		gock.New(testBaseURL).
+			Get(readme.CategoryEndpoint).
+			Reply(200).
+			SetHeader("x-total-count", "1").
+			SetHeader("link", `<>; rel="next", <>; rel="prev", <>; rel="last"`).
+			JSON([]readme.Category{testdata.Categories[0]})
+		gock.New(testBaseURL).
+			Get(readme.CategoryEndpoint + "/" + testdata.Categories[0].Slug).
+			Reply(200).
+			JSON(testdata.Categories[0])
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.Category.Get("id:" + testdata.Categories[0].ID)
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 4, "it creates spans for each request and operation")
+		parent := spans[3]
+		assert.Equal(t, "readme.Category.Get", parent.Name, "it creates a parent span")
+		assert.Equal(t, parent.SpanContext.SpanID(), spans[1].Parent.SpanID(), "it nests the pagination span")
+		assert.Equal(t, parent.SpanContext.SpanID(), spans[2].Parent.SpanID(), "it nests the request span")
+	})
+
+	t.Run("when a version is resolved by ID", func(t *testing.T) {
+		// Arrange
+		client, exporter := newTracingClient(t)
+		gock.New(testBaseURL).
+			Get(readme.VersionEndpoint).
+			Reply(200).
+			JSON(testdata.VersionSummary)
+		defer gock.Off()
+
+		// Act
+		got, err := client.Version.GetVersion("id:" + testdata.VersionSummary[0].ID)
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, testdata.VersionSummary[0].Version, got, "it returns the version")
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 2, "it creates spans for the request and operation")
+		assert.Equal(t, "readme.Version.GetVersion", spans[1].Name, "it creates a parent span")
+		assert.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID(), "it nests the request span")
+	})
+}
@@ -0,0 +1,127 @@
+// Package readmeprom exports metrics about requests to the ReadMe API to Prometheus.
+//
+// Create a Recorder and set it on the client to export metrics:
+//
+//	recorder, err := readmeprom.NewRecorder(prometheus.DefaultRegisterer)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	client, err := readme.NewClient(token, readme.WithMetricsRecorder(recorder))
+package readmeprom
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Namespace is the prefix of the names of the exported metrics.
+const Namespace = "readme_api"
+
+// Recorder is a readme.MetricsRecorder that exports Prometheus metrics.
+//
+// The following metrics are exported, labeled with the service, operation, endpoint and HTTP
+// method:
+//
+//   - readme_api_requests_total: the number of requests, also labeled with the status code, or
+//     "error" when no response was received.
+//   - readme_api_request_duration_seconds: a histogram of request durations.
+//   - readme_api_request_sent_bytes_total: the number of bytes sent in request payloads.
+//   - readme_api_response_received_bytes_total: the number of bytes received in response bodies.
+//   - readme_api_request_retries_total: the number of times requests were retried.
+type Recorder struct {
+	requests      *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	bytesSent     *prometheus.CounterVec
+	bytesReceived *prometheus.CounterVec
+	retries       *prometheus.CounterVec
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ readme.MetricsRecorder = &Recorder{}
+	_ prometheus.Collector   = &Recorder{}
+)
+
+// NewRecorder creates a Recorder and registers its metrics with the provided registerer.
+func NewRecorder(registerer prometheus.Registerer) (*Recorder, error) {
+	labels := []string{"service", "operation", "endpoint", "method"}
+
+	recorder := &Recorder{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "requests_total",
+			Help:      "Total number of requests made to the ReadMe API.",
+		}, append(labels, "status")),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of requests made to the ReadMe API, including retries.",
+			Buckets:   prometheus.DefBuckets,
+		}, labels),
+		bytesSent: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "request_sent_bytes_total",
+			Help:      "Total number of bytes sent in request payloads to the ReadMe API.",
+		}, labels),
+		bytesReceived: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "response_received_bytes_total",
+			Help:      "Total number of bytes received in response bodies from the ReadMe API.",
+		}, labels),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "request_retries_total",
+			Help:      "Total number of times requests to the ReadMe API were retried.",
+		}, labels),
+	}
+
+	if err := registerer.Register(recorder); err != nil {
+		return nil, fmt.Errorf("unable to register ReadMe API metrics: %w", err)
+	}
+
+	return recorder, nil
+}
+
+// RecordRequest records the metrics of a request to the ReadMe API.
+func (r *Recorder) RecordRequest(_ context.Context, metrics readme.RequestMetrics) {
+	status := "error"
+	if metrics.StatusCode != 0 {
+		status = strconv.Itoa(metrics.StatusCode)
+	}
+
+	r.requests.WithLabelValues(metrics.Service, metrics.Operation, metrics.Endpoint, metrics.Method, status).Inc()
+
+	labels := prometheus.Labels{
+		"service":   metrics.Service,
+		"operation": metrics.Operation,
+		"endpoint":  metrics.Endpoint,
+		"method":    metrics.Method,
+	}
+	r.duration.With(labels).Observe(metrics.Duration.Seconds())
+	r.bytesSent.With(labels).Add(float64(metrics.BytesSent))
+	r.bytesReceived.With(labels).Add(float64(metrics.BytesReceived))
+	r.retries.With(labels).Add(float64(metrics.Retries))
+}
+
+// Describe implements prometheus.Collector.
+func (r *Recorder) Describe(ch chan<- *prometheus.Desc) {
+	r.requests.Describe(ch)
+	r.duration.Describe(ch)
+	r.bytesSent.Describe(ch)
+	r.bytesReceived.Describe(ch)
+	r.retries.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (r *Recorder) Collect(ch chan<- prometheus.Metric) {
+	r.requests.Collect(ch)
+	r.duration.Collect(ch)
+	r.bytesSent.Collect(ch)
+	r.bytesReceived.Collect(ch)
+	r.retries.Collect(ch)
+}
@@ -0,0 +1,83 @@
+package readmeprom_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/readme/readmeprom"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Recorder(t *testing.T) {
+	t.Run("when requests are recorded", func(t *testing.T) {
+		// Arrange
+		registry := prometheus.NewRegistry()
+		recorder, err := readmeprom.NewRecorder(registry)
+		assert.NoError(t, err, "it does not return an error")
+
+		// Act
+		recorder.RecordRequest(context.Background(), readme.RequestMetrics{
+			Service:       "Category",
+			Operation:     "GetAll",
+			Endpoint:      "categories",
+			Method:        "GET",
+			StatusCode:    200,
+			Duration:      250 * time.Millisecond,
+			BytesReceived: 512,
+			Retries:       2,
+		})
+		recorder.RecordRequest(context.Background(), readme.RequestMetrics{
+			Service:   "Doc",
+			Operation: "Create",
+			Endpoint:  "docs",
+			Method:    "POST",
+			BytesSent: 128,
+		})
+
+		// Assert
+		expected := `
+# HELP readme_api_requests_total Total number of requests made to the ReadMe API.
+# TYPE readme_api_requests_total counter
+readme_api_requests_total{endpoint="categories",method="GET",operation="GetAll",service="Category",status="200"} 1
+readme_api_requests_total{endpoint="docs",method="POST",operation="Create",service="Doc",status="error"} 1
+# HELP readme_api_request_retries_total Total number of times requests to the ReadMe API were retried.
+# TYPE readme_api_request_retries_total counter
+readme_api_request_retries_total{endpoint="categories",method="GET",operation="GetAll",service="Category"} 2
+readme_api_request_retries_total{endpoint="docs",method="POST",operation="Create",service="Doc"} 0
+# HELP readme_api_request_sent_bytes_total Total number of bytes sent in request payloads to the ReadMe API.
+# TYPE readme_api_request_sent_bytes_total counter
+readme_api_request_sent_bytes_total{endpoint="categories",method="GET",operation="GetAll",service="Category"} 0
+readme_api_request_sent_bytes_total{endpoint="docs",method="POST",operation="Create",service="Doc"} 128
+# HELP readme_api_response_received_bytes_total Total number of bytes received in response bodies from the ReadMe API.
+# TYPE readme_api_response_received_bytes_total counter
+readme_api_response_received_bytes_total{endpoint="categories",method="GET",operation="GetAll",service="Category"} 512
+readme_api_response_received_bytes_total{endpoint="docs",method="POST",operation="Create",service="Doc"} 0
+`
+		err = testutil.GatherAndCompare(registry, strings.NewReader(expected),
+			"readme_api_requests_total",
+			"readme_api_request_retries_total",
+			"readme_api_request_sent_bytes_total",
+			"readme_api_response_received_bytes_total",
+		)
+		assert.NoError(t, err, "it records the expected metrics")
+		assert.Equal(t, 2, testutil.CollectAndCount(recorder, "readme_api_request_duration_seconds"),
+			"it records the duration of each request")
+	})
+
+	t.Run("when the metrics are already registered", func(t *testing.T) {
+		// Arrange
+		registry := prometheus.NewRegistry()
+		_, _ = readmeprom.NewRecorder(registry)
+
+		// Act
+		_, err := readmeprom.NewRecorder(registry)
+
+		// Assert
+		assert.ErrorContains(t, err, "unable to register ReadMe API metrics", "it returns an error")
+	})
+}
@@ -0,0 +1,158 @@
+package readme
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// ResolverCache remembers the slugs and versions that IDs prefixed with "id:" resolve to, so that
+// operations such as Category.Get("id:...") and Version.Get("id:...") only look them up once.
+//
+// IDs are remembered per project, by API key, and per project version. Concurrent lookups of the
+// same IDs share a single request to the API. The IDs of every category and version returned by a
+// lookup are remembered, so resolving one category ID resolves them all.
+//
+// The slugs and versions are updated when they're changed or deleted with the same client, such as
+// with Category.Update(). Use Clear() when they may have been changed by other means.
+//
+// A ResolverCache may be shared between clients and is safe for concurrent use.
+type ResolverCache struct {
+	mu     sync.Mutex
+	values map[string]string
+	group  singleflight.Group
+}
+
+// NewResolverCache returns an empty ResolverCache.
+func NewResolverCache() *ResolverCache {
+	return &ResolverCache{values: map[string]string{}}
+}
+
+// Kinds of objects with IDs that are resolved by a ResolverCache.
+const (
+	categoryResolverKind = "categories"
+	docResolverKind      = "docs"
+	versionResolverKind  = "versions"
+)
+
+// Clear forgets every remembered ID.
+func (r *ResolverCache) Clear() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.values = map[string]string{}
+}
+
+// Len returns the number of remembered IDs.
+func (r *ResolverCache) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.values)
+}
+
+// resolve returns the value that an ID resolves to in a scope, calling the lookup when the ID isn't
+// remembered. The lookup returns the values of the IDs it finds, keyed by ID, and the API response
+// of its request. It returns false if the lookup doesn't find the ID, and the API response of the
+// lookup when it's called.
+//
+// Concurrent calls with the same scope and lookup key share a single call to the lookup, which isn't
+// canceled with the context of the call that started it. Each call stops waiting for the lookup
+// when its own context is done. The lookup is called directly when the cache is nil.
+func (r *ResolverCache) resolve(
+	ctx context.Context,
+	scope, id, lookupKey string,
+	lookup func(ctx context.Context) (map[string]string, *APIResponse, error),
+) (string, bool, *APIResponse, error) {
+	if r == nil {
+		values, apiResponse, err := lookup(ctx)
+		value, ok := values[id]
+
+		return value, ok, apiResponse, err
+	}
+
+	if value, ok := r.get(scope, id); ok {
+		return value, true, nil, nil
+	}
+
+	result := r.group.DoChan(scope+" "+lookupKey, func() (interface{}, error) {
+		values, apiResponse, err := lookup(context.WithoutCancel(ctx))
+		if err != nil {
+			return apiResponse, err
+		}
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		for valueID, value := range values {
+			r.values[scope+" "+valueID] = value
+		}
+
+		return apiResponse, nil
+	})
+
+	var apiResponse *APIResponse
+	select {
+	case <-ctx.Done():
+		return "", false, nil, fmt.Errorf("unable to resolve ID %s: %w", id, ctx.Err())
+	case res := <-result:
+		apiResponse, _ = res.Val.(*APIResponse)
+		if res.Err != nil {
+			return "", false, apiResponse, res.Err
+		}
+	}
+
+	value, ok := r.get(scope, id)
+
+	return value, ok, apiResponse, nil
+}
+
+// get returns the remembered value of an ID in a scope.
+func (r *ResolverCache) get(scope, id string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	value, ok := r.values[scope+" "+id]
+
+	return value, ok
+}
+
+// update remembers the value of an ID in a scope after it's changed, and forgets any other IDs that
+// resolved to the previous value. The ID is only forgotten when it's empty.
+func (r *ResolverCache) update(scope, id, previous, value string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, remembered := range r.values {
+		if remembered == previous && strings.HasPrefix(key, scope+" ") {
+			delete(r.values, key)
+		}
+	}
+
+	if id != "" && value != "" {
+		r.values[scope+" "+id] = value
+	}
+}
+
+// resolverScope returns the scope of the IDs of a kind of object for the client's project and a
+// project version, which defaults to the client's default version.
+//
+// Versions aren't scoped to a project version.
+func (c *Client) resolverScope(kind, version string) string {
+	if kind == versionResolverKind {
+		version = ""
+	} else if version == "" {
+		version = c.DefaultVersion
+	}
+	project := sha256.Sum256([]byte(c.Token))
+
+	return kind + " " + hex.EncodeToString(project[:8]) + " " + version
+}
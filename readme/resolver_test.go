@@ -0,0 +1,313 @@
+package readme_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/h2non/gock"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+// newResolverClient returns a client with a ResolverCache for the test API URL.
+func newResolverClient(t *testing.T) (*readme.Client, *readme.ResolverCache) {
+	t.Helper()
+
+	cache := readme.NewResolverCache()
+	client, err := readme.NewClient("test", readme.WithAPIURL(TestClientBaseURL), readme.WithResolverCache(cache))
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	return client, cache
+}
+
+func Test_ResolverCache_Category(t *testing.T) {
+	t.Run("when category IDs are resolved", func(t *testing.T) {
+		// Arrange
+		client, cache := newResolverClient(t)
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			Times(1).
+			Reply(200).
+			SetHeader("link", `<>; rel="next", <>; rel="prev", <>; rel="last"`).
+			JSON(testdata.Categories)
+		for _, category := range testdata.Categories[:2] {
+			gock.New(TestClient.APIURL).
+				Get(readme.CategoryEndpoint + "/" + category.Slug).
+				Times(2).
+				Reply(200).
+				JSON(category)
+		}
+		defer gock.Off()
+
+		// Act
+		var errs []error
+		for _, category := range []readme.Category{
+			testdata.Categories[0], testdata.Categories[1], testdata.Categories[0], testdata.Categories[1],
+		} {
+			_, _, err := client.Category.Get("id:" + category.ID)
+			errs = append(errs, err)
+		}
+
+		// Assert
+		assert.Equal(t, []error{nil, nil, nil, nil}, errs, "it does not return an error")
+		assert.True(t, gock.IsDone(), "it looks up the categories once")
+		assert.Equal(t, len(testdata.Categories), cache.Len(), "it remembers the ID of every category")
+	})
+
+	t.Run("when category IDs are resolved concurrently", func(t *testing.T) {
+		// Arrange
+		var lookups atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != readme.CategoryEndpoint {
+				_, _ = w.Write([]byte(testdata.ToJSON(testdata.Categories[0])))
+
+				return
+			}
+
+			lookups.Add(1)
+			time.Sleep(50 * time.Millisecond)
+			w.Header().Set("link", `<>; rel="next", <>; rel="prev", <>; rel="last"`)
+			_, _ = w.Write([]byte(testdata.ToJSON(testdata.Categories)))
+		}))
+		defer server.Close()
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL),
+			readme.WithResolverCache(readme.NewResolverCache()))
+		ctx, cancel := context.WithCancel(context.Background())
+
+		// Act
+		var wg sync.WaitGroup
+		errs := make([]error, 5)
+		for i := range errs {
+			callCtx := context.Background()
+			if i == 0 {
+				// The canceled call starts the lookup.
+				callCtx = ctx
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _, errs[i] = client.Category.GetWithContext(callCtx, "id:"+testdata.Categories[0].ID)
+			}()
+			time.Sleep(5 * time.Millisecond)
+		}
+		cancel()
+		wg.Wait()
+
+		// Assert
+		assert.Equal(t, int32(1), lookups.Load(), "it looks up the categories once")
+		assert.ErrorIs(t, errs[0], context.Canceled, "it returns an error to the canceled call")
+		assert.Equal(t, []error{nil, nil, nil, nil}, errs[1:], "it resolves the ID for the other calls")
+	})
+
+	t.Run("when a concurrent lookup fails", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			time.Sleep(20 * time.Millisecond)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL),
+			readme.WithResolverCache(readme.NewResolverCache()))
+
+		// Act
+		var wg sync.WaitGroup
+		responses := make([]*readme.APIResponse, 5)
+		errs := make([]error, 5)
+		for i := range responses {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, responses[i], errs[i] = client.Category.Get("id:" + testdata.Categories[0].ID)
+			}()
+		}
+		wg.Wait()
+
+		// Assert
+		for i := range responses {
+			assert.ErrorIs(t, errs[i], readme.ErrServer, "it returns the error of the lookup")
+			if assert.NotNil(t, responses[i], "it returns the response of the lookup to every call") {
+				assert.Equal(t, http.StatusInternalServerError, responses[i].HTTPResponse.StatusCode,
+					"it returns the response of the lookup to every call")
+			}
+		}
+	})
+
+	t.Run("when a category slug is changed", func(t *testing.T) {
+		// Arrange
+		client, _ := newResolverClient(t)
+		category := testdata.Categories[0]
+		updated := category
+		updated.Slug = "renamed"
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			Reply(200).
+			SetHeader("link", `<>; rel="next", <>; rel="prev", <>; rel="last"`).
+			JSON([]readme.Category{category})
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint + "/" + category.Slug).
+			Reply(200).
+			JSON(category)
+		gock.New(TestClient.APIURL).
+			Put(readme.CategoryEndpoint + "/" + category.Slug).
+			Reply(200).
+			JSON(updated)
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint + "/renamed").
+			Reply(200).
+			JSON(updated)
+		defer gock.Off()
+
+		// Act
+		_, _, _ = client.Category.Get("id:" + category.ID)
+		_, _, _ = client.Category.Update(category.Slug, readme.CategoryParams{Title: "Renamed", Type: "guide"})
+		got, _, err := client.Category.Get("id:" + category.ID)
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, "renamed", got.Slug, "it uses the new slug")
+		assert.True(t, gock.IsDone(), "it doesn't look up the category again")
+	})
+
+	t.Run("when a category is deleted", func(t *testing.T) {
+		// Arrange
+		client, cache := newResolverClient(t)
+		category := testdata.Categories[0]
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			Reply(200).
+			SetHeader("link", `<>; rel="next", <>; rel="prev", <>; rel="last"`).
+			JSON([]readme.Category{category})
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint + "/" + category.Slug).
+			Reply(200).
+			JSON(category)
+		gock.New(TestClient.APIURL).
+			Delete(readme.CategoryEndpoint + "/" + category.Slug).
+			Reply(204)
+		defer gock.Off()
+
+		// Act
+		_, _, _ = client.Category.Get("id:" + category.ID)
+		_, _, err := client.Category.Delete(category.Slug)
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, 0, cache.Len(), "it forgets the deleted category")
+	})
+}
+
+func Test_ResolverCache_Doc(t *testing.T) {
+	// Arrange
+	client, _ := newResolverClient(t)
+	doc := testdata.Docs[0]
+	gock.New(TestClient.APIURL).
+		Post(readme.DocEndpoint+"/search").
+		MatchParam("search", doc.ID).
+		Times(1).
+		Reply(200).
+		JSON(readme.DocSearchResults{Results: []readme.DocSearchResult{{ReferenceID: doc.ID, Slug: doc.Slug}}})
+	gock.New(TestClient.APIURL).
+		Get(readme.DocEndpoint + "/" + doc.Slug).
+		Times(2).
+		Reply(200).
+		JSON(doc)
+	defer gock.Off()
+
+	// Act
+	_, _, firstErr := client.Doc.Get("id:" + doc.ID)
+	got, _, secondErr := client.Doc.Get("id:" + doc.ID)
+
+	// Assert
+	assert.NoError(t, firstErr, "it does not return an error")
+	assert.NoError(t, secondErr, "it does not return an error")
+	assert.Equal(t, doc, got, "it returns the doc")
+	assert.True(t, gock.IsDone(), "it searches for the doc once")
+}
+
+func Test_ResolverCache_Version(t *testing.T) {
+	t.Run("when versions are resolved concurrently", func(t *testing.T) {
+		// Arrange
+		var lookups atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			lookups.Add(1)
+			time.Sleep(20 * time.Millisecond)
+			_, _ = w.Write([]byte(testdata.ToJSON(testdata.VersionSummary)))
+		}))
+		defer server.Close()
+		client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL),
+			readme.WithResolverCache(readme.NewResolverCache()))
+
+		// Act
+		var wg sync.WaitGroup
+		results := make([]string, 10)
+		for i := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i], _ = client.Version.GetVersion("id:" + testdata.Versions[i%2].ID)
+			}()
+		}
+		wg.Wait()
+
+		// Assert
+		assert.Equal(t, int32(1), lookups.Load(), "it looks up the versions once")
+		for i, got := range results {
+			assert.Equal(t, testdata.Versions[i%2].Version, got, "it resolves each version")
+		}
+	})
+
+	t.Run("when a version is renamed", func(t *testing.T) {
+		// Arrange
+		client, _ := newResolverClient(t)
+		version := testdata.Versions[0]
+		updated := version
+		updated.Version = "1.0.1"
+		gock.New(TestClient.APIURL).
+			Get(readme.VersionEndpoint).
+			Reply(200).
+			JSON(testdata.VersionSummary)
+		gock.New(TestClient.APIURL).
+			Put(readme.VersionEndpoint + "/" + version.Version).
+			Reply(200).
+			JSON(updated)
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.Version.Update("id:"+version.ID, readme.VersionParams{Version: "1.0.1"})
+		got, resolveErr := client.Version.GetVersion("id:" + version.ID)
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.NoError(t, resolveErr, "it does not return an error")
+		assert.Equal(t, "1.0.1", got, "it resolves the ID to the new version")
+		assert.True(t, gock.IsDone(), "it doesn't look up the versions again")
+	})
+
+	t.Run("when the cache is cleared", func(t *testing.T) {
+		// Arrange
+		client, cache := newResolverClient(t)
+		gock.New(TestClient.APIURL).
+			Get(readme.VersionEndpoint).
+			Times(2).
+			Reply(200).
+			JSON(testdata.VersionSummary)
+		defer gock.Off()
+
+		// Act
+		_, _ = client.Version.GetVersion("id:" + testdata.Versions[0].ID)
+		cache.Clear()
+		_, err := client.Version.GetVersion("id:" + testdata.Versions[0].ID)
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.True(t, gock.IsDone(), "it looks up the versions again")
+	})
+}
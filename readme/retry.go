@@ -0,0 +1,188 @@
+package readme
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryAfterHeader is the name of the HTTP response header the API uses to indicate how long to wait
+// before making another request.
+const RetryAfterHeader = "Retry-After"
+
+// RetryPolicy configures how the client retries requests that fail with a transient error.
+//
+// A request is retried when it can't be sent or its response can't be read (for example, a
+// connection reset) or when the API responds with one of the RetryableStatusCodes. Other errors,
+// such as an invalid URL or an error returned by middleware, aren't retried. Only idempotent requests
+// are retried unless RetryNonIdempotent is enabled.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts for a single request, including the first one.
+	// A value less than 2 disables retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. The delay doubles with each retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts, including delays requested by the API with the
+	// Retry-After header.
+	MaxBackoff time.Duration
+	// Jitter is the fraction of each delay, between 0 and 1, that is randomized to avoid multiple
+	// clients retrying at the same moment.
+	Jitter float64
+	// RetryableStatusCodes lists the HTTP response status codes that are retried.
+	RetryableStatusCodes []int
+	// RetryNonIdempotent toggles retrying requests that use a non-idempotent HTTP method, such as
+	// POST. These aren't retried by default since the API may have processed the failed request.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy that makes up to 3 attempts with an exponential backoff
+// starting at 500ms and retries rate limited (429) and gateway (502, 503, 504) responses.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Jitter:         0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// transportError is an error from sending a request or reading its response, such as a connection
+// reset, which may not happen again when the request is retried.
+type transportError struct {
+	err error
+}
+
+// Error returns the message of the wrapped error.
+func (e *transportError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e *transportError) Unwrap() error {
+	return e.err
+}
+
+// idempotentMethod returns true if the HTTP method is idempotent and safe to retry.
+func idempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// shouldRetry determines whether a request should be attempted again after the given attempt and
+// returns the delay to wait before the next attempt.
+//
+// The `err` parameter is the error from performing the HTTP request, not from checking the
+// response status. Only transport errors are retried.
+func (p *RetryPolicy) shouldRetry(
+	request *APIRequest,
+	attempt int,
+	response *http.Response,
+	err error,
+) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	if !p.RetryNonIdempotent && !idempotentMethod(request.Method) {
+		return 0, false
+	}
+
+	if err != nil {
+		// Only retry transport errors, and not when the request was cancelled or its deadline was
+		// reached.
+		var transportErr *transportError
+		if !errors.As(err, &transportErr) ||
+			errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, false
+		}
+
+		return p.backoff(attempt), true
+	}
+
+	if response == nil || !slices.Contains(p.RetryableStatusCodes, response.StatusCode) {
+		return 0, false
+	}
+
+	if delay, ok := parseRetryAfter(response.Header.Get(RetryAfterHeader)); ok {
+		return p.capDelay(delay), true
+	}
+
+	return p.backoff(attempt), true
+}
+
+// backoff returns the exponential backoff delay, with jitter, for the given attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := float64(p.InitialBackoff) * math.Pow(2, float64(attempt-1))
+
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		delay -= delay * jitter * rand.Float64() //nolint:gosec // Jitter doesn't need a secure source.
+	}
+
+	return p.capDelay(time.Duration(delay))
+}
+
+// capDelay limits a delay to MaxBackoff, if set.
+func (p *RetryPolicy) capDelay(delay time.Duration) time.Duration {
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+
+	return delay
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which may either be a number of seconds
+// or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	delay := time.Until(date)
+	if delay < 0 {
+		delay = 0
+	}
+
+	return delay, true
+}
+
+// sleep waits for the delay to pass or for the context to be done, whichever happens first.
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
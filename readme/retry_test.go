@@ -0,0 +1,298 @@
+package readme_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/h2non/gock"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+// newRetryClient returns a test client with a retry policy that doesn't wait between attempts.
+func newRetryClient(t *testing.T, policy *readme.RetryPolicy) *readme.Client {
+	t.Helper()
+
+	client, err := readme.NewClient("test", readme.WithAPIURL(TestClientBaseURL))
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+	client.RetryPolicy = policy
+
+	return client
+}
+
+func Test_RetryPolicy(t *testing.T) {
+	t.Run("when the API recovers after a gateway error", func(t *testing.T) {
+		// Arrange
+		client := newRetryClient(t, &readme.RetryPolicy{MaxAttempts: 3, RetryableStatusCodes: []int{502}})
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(502).
+			BodyString("<html>Bad Gateway</html>")
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(200).
+			JSON(testdata.Project)
+		defer gock.Off()
+
+		// Act
+		got, apiResponse, err := client.Project.Get()
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, testdata.Project, got, "it returns the expected project")
+		assert.Equal(t, 2, apiResponse.Attempts, "it makes two attempts")
+		assert.True(t, gock.IsDone(), "it makes the expected API calls")
+	})
+
+	t.Run("when every attempt fails", func(t *testing.T) {
+		// Arrange
+		client := newRetryClient(t, &readme.RetryPolicy{MaxAttempts: 2, RetryableStatusCodes: []int{503}})
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Times(2).
+			Reply(503).
+			JSON(readme.APIErrorResponse{Error: "UNAVAILABLE"})
+		defer gock.Off()
+
+		// Act
+		_, apiResponse, err := client.Project.Get()
+
+		// Assert
+		assert.ErrorContains(t, err, "ReadMe API Error: 503 on GET", "it returns the last error")
+		assert.Equal(t, 2, apiResponse.Attempts, "it stops after the maximum attempts")
+		assert.True(t, gock.IsDone(), "it makes the expected API calls")
+	})
+
+	t.Run("when the status code isn't retryable", func(t *testing.T) {
+		// Arrange
+		client := newRetryClient(t, &readme.RetryPolicy{MaxAttempts: 3, RetryableStatusCodes: []int{503}})
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(404).
+			JSON(readme.APIErrorResponse{Error: "NOT_FOUND"})
+		defer gock.Off()
+
+		// Act
+		_, apiResponse, err := client.Project.Get()
+
+		// Assert
+		assert.ErrorContains(t, err, "ReadMe API Error: 404 on GET", "it returns the error")
+		assert.Equal(t, 1, apiResponse.Attempts, "it doesn't retry the request")
+	})
+
+	t.Run("when the API responds with Retry-After", func(t *testing.T) {
+		// Arrange
+		client := newRetryClient(t, &readme.RetryPolicy{
+			MaxAttempts:          2,
+			InitialBackoff:       time.Hour,
+			RetryableStatusCodes: []int{429},
+		})
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(429).
+			SetHeader("Retry-After", "0").
+			JSON(readme.APIErrorResponse{Error: "RATE_LIMITED"})
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(200).
+			JSON(testdata.Project)
+		defer gock.Off()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// Act
+		_, apiResponse, err := client.Project.GetWithContext(ctx)
+
+		// Assert
+		assert.NoError(t, err, "it waits for the Retry-After delay instead of the backoff")
+		assert.Equal(t, 2, apiResponse.Attempts, "it makes two attempts")
+	})
+
+	t.Run("when the context is cancelled while waiting to retry", func(t *testing.T) {
+		// Arrange
+		client := newRetryClient(t, &readme.RetryPolicy{
+			MaxAttempts:          2,
+			InitialBackoff:       time.Hour,
+			RetryableStatusCodes: []int{503},
+		})
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(503).
+			JSON(readme.APIErrorResponse{Error: "UNAVAILABLE"})
+		defer gock.Off()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		// Act
+		_, _, err := client.Project.GetWithContext(ctx)
+
+		// Assert
+		assert.ErrorIs(t, err, context.DeadlineExceeded, "it returns the context error")
+	})
+
+	t.Run("when a page fails while paginating", func(t *testing.T) {
+		// Arrange
+		client := newRetryClient(t, &readme.RetryPolicy{MaxAttempts: 2, RetryableStatusCodes: []int{504}})
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			MatchParam("page", "1").
+			Reply(200).
+			SetHeader("x-total-count", "2").
+			SetHeader("link", `</categories?perPage=1&page=2>; rel="next", <>; rel="prev", <>; rel="last"`).
+			JSON([]readme.Category{testdata.Categories[0]})
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			MatchParam("page", "2").
+			Reply(504).
+			BodyString("Gateway Timeout")
+		gock.New(TestClient.APIURL).
+			Get(readme.CategoryEndpoint).
+			MatchParam("page", "2").
+			Reply(200).
+			SetHeader("x-total-count", "2").
+			SetHeader("link", `<>; rel="next", </categories?perPage=1&page=1>; rel="prev", <>; rel="last"`).
+			JSON([]readme.Category{testdata.Categories[1]})
+		defer gock.Off()
+
+		// Act
+		got, _, err := client.Category.GetAll(readme.RequestOptions{PerPage: 1})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, testdata.Categories[:2], got, "it returns the results of every page")
+		assert.True(t, gock.IsDone(), "it makes the expected API calls")
+	})
+
+	t.Run("when a non-idempotent request fails", func(t *testing.T) {
+		// Arrange
+		client := newRetryClient(t, &readme.RetryPolicy{MaxAttempts: 3, RetryableStatusCodes: []int{503}})
+		gock.New(TestClient.APIURL).
+			Post(readme.ChangelogEndpoint).
+			Reply(503).
+			JSON(readme.APIErrorResponse{Error: "UNAVAILABLE"})
+		defer gock.Off()
+
+		// Act
+		_, apiResponse, err := client.Changelog.Create(readme.ChangelogParams{Title: "Test", Body: "Test"})
+
+		// Assert
+		assert.Error(t, err, "it returns an error")
+		assert.Equal(t, 1, apiResponse.Attempts, "it doesn't retry the request by default")
+	})
+
+	t.Run("when the connection fails", func(t *testing.T) {
+		// Arrange
+		client := newRetryClient(t, &readme.RetryPolicy{MaxAttempts: 3})
+		calls := 0
+		client.HTTPClient = &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			calls++
+			if calls == 1 {
+				return nil, errors.New("connection reset by peer")
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{"name": "Test"}`)),
+				Request:    r,
+			}, nil
+		})}
+
+		// Act
+		_, apiResponse, err := client.Project.Get()
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, 2, apiResponse.Attempts, "it retries the request")
+	})
+
+	t.Run("when the request can't be prepared", func(t *testing.T) {
+		// Arrange
+		client := newRetryClient(t, &readme.RetryPolicy{MaxAttempts: 3})
+		limiter := &countingLimiter{}
+		client.RateLimiter = limiter
+
+		// Act
+		_, err := client.APIRequest(&readme.APIRequest{
+			Method:       "GET",
+			URL:          TestClientBaseURL + "/%zz",
+			OkStatusCode: []int{200},
+		})
+
+		// Assert
+		assert.ErrorContains(t, err, "unable to prepare request", "it returns the error")
+		assert.Equal(t, 1, limiter.waits, "it doesn't retry the request")
+	})
+
+	t.Run("when middleware returns an error", func(t *testing.T) {
+		// Arrange
+		calls := 0
+		client, _ := readme.NewClient("test",
+			readme.WithAPIURL(TestClientBaseURL),
+			readme.WithRetryPolicy(&readme.RetryPolicy{MaxAttempts: 3}),
+			readme.WithMiddleware(func(readme.Handler) readme.Handler {
+				return func(context.Context, *readme.APIRequest, *http.Request) (*readme.APIResponse, error) {
+					calls++
+
+					return nil, errors.New("request blocked")
+				}
+			}),
+		)
+
+		// Act
+		_, _, err := client.Project.Get()
+
+		// Assert
+		assert.ErrorContains(t, err, "request blocked", "it returns the error")
+		assert.Equal(t, 1, calls, "it doesn't retry the request")
+	})
+}
+
+func Test_RetryPolicy_Multipart(t *testing.T) {
+	// Arrange
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"_id": "0123456789", "title": "Test"}`))
+	}))
+	defer server.Close()
+
+	client, _ := readme.NewClient("test", readme.WithAPIURL(server.URL), readme.WithHTTPClient(server.Client()))
+	client.RetryPolicy = &readme.RetryPolicy{
+		MaxAttempts:          2,
+		RetryableStatusCodes: []int{503},
+		RetryNonIdempotent:   true,
+	}
+	definition := testdata.ToJSON(testdata.APIDefinition)
+
+	// Act
+	got, apiResponse, err := client.APISpecification.Create(definition)
+
+	// Assert
+	assert.NoError(t, err, "it does not return an error")
+	assert.Equal(t, "0123456789", got.ID, "it returns the created specification")
+	assert.Equal(t, 2, apiResponse.Attempts, "it retries the upload")
+	assert.Len(t, bodies, 2, "it sends the request twice")
+	assert.Equal(t, bodies[0], bodies[1], "it sends the same payload with each attempt")
+	assert.True(t, strings.Contains(bodies[1], definition), "it sends the full definition")
+}
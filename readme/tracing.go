@@ -0,0 +1,124 @@
+package readme
+
+import (
+	"context"
+	"net/http"
+)
+
+// Tracer traces the operations and requests of a Client with spans.
+//
+// Start is called before every request made with Client.APIRequest(), including each page of a
+// paginated request, and before operations that make multiple requests. The context it returns is
+// used for the requests of the operation, so their spans are nested under it. Inject is called with
+// the context of every request before it's sent. Implementations must be safe for concurrent use.
+//
+// See the readmeotel package for an implementation that uses OpenTelemetry.
+type Tracer interface {
+	// Start starts a span and returns a context holding it.
+	Start(ctx context.Context, start SpanStart) (context.Context, Span)
+	// Inject adds the trace context of ctx to the headers of a request.
+	Inject(ctx context.Context, header http.Header)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	// End records the result of the span's operation or request and ends the span.
+	End(end SpanEnd)
+}
+
+// SpanKind is the kind of operation traced by a span.
+type SpanKind int
+
+const (
+	// SpanKindInternal is the kind of the spans of client operations that make multiple requests.
+	SpanKindInternal SpanKind = iota
+	// SpanKindClient is the kind of the spans of requests to the ReadMe API.
+	SpanKindClient
+)
+
+// SpanStart describes the operation or request traced by a span when it starts.
+type SpanStart struct {
+	// Name is the name of the span, such as "readme GET" for a request or "readme.Category.Get" for
+	// an operation.
+	Name string
+	// Kind is the kind of the span.
+	Kind SpanKind
+	// Method is the HTTP method of a request. This is empty for operations.
+	Method string
+	// Endpoint is the ReadMe API endpoint of the request or operation, if any.
+	Endpoint string
+	// Version is the project version of a request, if any.
+	Version string
+}
+
+// SpanEnd describes the result of the operation or request traced by a span when it ends.
+type SpanEnd struct {
+	// URL is the URL of a request. This is empty for operations.
+	URL string
+	// StatusCode is the HTTP status code of the response to a request, or 0 if no response was
+	// received.
+	StatusCode int
+	// Retries is the number of times a request was retried.
+	Retries int
+	// Err is the error returned by the operation or request, if any. It wraps an *APIError when the
+	// API responded with an error.
+	Err error
+}
+
+// noopSpan is the span of operations and requests when tracing isn't enabled.
+type noopSpan struct{}
+
+func (noopSpan) End(SpanEnd) {}
+
+// startSpan starts a span for an operation that makes one or more API requests.
+//
+// A no-op span is returned when tracing isn't enabled on the client.
+func (c *Client) startSpan(ctx context.Context, start SpanStart) (context.Context, Span) {
+	if c.Tracer == nil {
+		return ctx, noopSpan{}
+	}
+
+	return c.Tracer.Start(ctx, start)
+}
+
+// startRequestSpan starts a client span for an API request.
+func (c *Client) startRequestSpan(ctx context.Context, request *APIRequest) (context.Context, Span) {
+	version := request.RequestOptions.Version
+	if version == "" {
+		version = c.DefaultVersion
+	}
+
+	return c.startSpan(ctx, SpanStart{
+		Name:     "readme " + request.Method,
+		Kind:     SpanKindClient,
+		Method:   request.Method,
+		Endpoint: request.Endpoint,
+		Version:  version,
+	})
+}
+
+// endRequestSpan records the result of an API request on its span and ends it.
+func endRequestSpan(span Span, request *APIRequest, response *APIResponse, err error) {
+	end := SpanEnd{
+		URL: request.URL,
+		Err: err,
+	}
+
+	if response != nil {
+		if response.HTTPResponse != nil {
+			end.StatusCode = response.HTTPResponse.StatusCode
+		}
+		if response.Attempts > 1 {
+			end.Retries = response.Attempts - 1
+		}
+	}
+
+	span.End(end)
+}
+
+// injectTraceContext adds the trace context headers to an outgoing request when tracing is enabled.
+func (c *Client) injectTraceContext(ctx context.Context, header http.Header) {
+	if c.Tracer != nil {
+		c.Tracer.Inject(ctx, header)
+	}
+}
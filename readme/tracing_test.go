@@ -0,0 +1,119 @@
+package readme_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTracer is a Tracer that keeps the started and ended spans in memory.
+type fakeTracer struct {
+	mu     sync.Mutex
+	starts []readme.SpanStart
+	ends   []readme.SpanEnd
+}
+
+func (t *fakeTracer) Start(ctx context.Context, start readme.SpanStart) (context.Context, readme.Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.starts = append(t.starts, start)
+
+	return ctx, fakeSpan{tracer: t}
+}
+
+func (t *fakeTracer) Inject(_ context.Context, header http.Header) {
+	header.Set("x-trace", "test")
+}
+
+// fakeSpan is a Span that records its end on a fakeTracer.
+type fakeSpan struct {
+	tracer *fakeTracer
+}
+
+func (s fakeSpan) End(end readme.SpanEnd) {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	s.tracer.ends = append(s.tracer.ends, end)
+}
+
+func Test_Tracer(t *testing.T) {
+	t.Run("when the API responds with an error", func(t *testing.T) {
+		// Arrange
+		tracer := &fakeTracer{}
+		client, _ := readme.NewClient("test",
+			readme.WithAPIURL(TestClientBaseURL),
+			readme.WithTracer(tracer),
+		)
+		gock.New(TestClient.APIURL).
+			Get(readme.DocEndpoint+"/missing").
+			MatchHeader("x-trace", "test").
+			Reply(404).
+			JSON(readme.APIErrorResponse{Error: "DOC_NOTFOUND"})
+		defer gock.Off()
+
+		// Act
+		_, _, err := client.Doc.Get("missing", readme.RequestOptions{Version: "1.1.2"})
+
+		// Assert
+		assert.ErrorIs(t, err, readme.ErrNotFound, "it returns an error")
+		assert.True(t, gock.IsDone(), "it injects the trace context")
+		assert.Equal(t, []readme.SpanStart{{
+			Name:     "readme GET",
+			Kind:     readme.SpanKindClient,
+			Method:   "GET",
+			Endpoint: readme.DocEndpoint + "/missing",
+			Version:  "1.1.2",
+		}}, tracer.starts, "it starts a span for the request")
+		assert.Len(t, tracer.ends, 1, "it ends the span")
+		assert.Equal(t, TestClientBaseURL+readme.DocEndpoint+"/missing", tracer.ends[0].URL, "it records the URL")
+		assert.Equal(t, 404, tracer.ends[0].StatusCode, "it records the status code")
+		assert.ErrorIs(t, tracer.ends[0].Err, readme.ErrNotFound, "it records the error")
+	})
+
+	t.Run("when a version is resolved by ID", func(t *testing.T) {
+		// Arrange
+		tracer := &fakeTracer{}
+		client, _ := readme.NewClient("test",
+			readme.WithAPIURL(TestClientBaseURL),
+			readme.WithTracer(tracer),
+		)
+		gock.New(TestClient.APIURL).
+			Get(readme.VersionEndpoint).
+			Reply(200).
+			JSON(testdata.VersionSummary)
+		defer gock.Off()
+
+		// Act
+		_, err := client.Version.GetVersion("id:" + testdata.VersionSummary[0].ID)
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Len(t, tracer.starts, 2, "it starts spans for the operation and request")
+		assert.Equal(t, readme.SpanStart{Name: "readme.Version.GetVersion", Kind: readme.SpanKindInternal},
+			tracer.starts[0], "it starts a span for the operation")
+		assert.Len(t, tracer.ends, 2, "it ends every span")
+	})
+
+	t.Run("when tracing isn't enabled", func(t *testing.T) {
+		// Arrange
+		gock.New(TestClient.APIURL).
+			Get(readme.ProjectEndpoint).
+			Reply(200).
+			JSON(testdata.Project)
+		defer gock.Off()
+
+		// Act
+		_, apiResponse, err := TestClient.Project.Get()
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Empty(t, apiResponse.HTTPResponse.Request.Header.Get("traceparent"),
+			"it doesn't propagate a trace context")
+	})
+}
@@ -1,6 +1,7 @@
 package readme
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -17,6 +18,9 @@ type VersionService interface {
 	// API Reference: https://docs.readme.com/main/reference/createversion
 	Create(prams VersionParams) (Version, *APIResponse, error)
 
+	// CreateWithContext creates a new version within a project using the provided context.
+	CreateWithContext(ctx context.Context, params VersionParams) (Version, *APIResponse, error)
+
 	// Delete a version.
 	//
 	// The version may be provided using either the semver identifier for the project version
@@ -28,6 +32,11 @@ type VersionService interface {
 	// API Reference: https://docs.readme.com/main/reference/deleteversion
 	Delete(version string) (bool, *APIResponse, error)
 
+	// DeleteWithContext deletes a version using the provided context.
+	//
+	// The context is also used to resolve the version when it's provided as an ID.
+	DeleteWithContext(ctx context.Context, version string) (bool, *APIResponse, error)
+
 	// Get a single version.
 	//
 	// The version may be provided using either the semver identifier for the project version
@@ -39,11 +48,20 @@ type VersionService interface {
 	// API Reference: https://docs.readme.com/main/reference/getversion
 	Get(version string) (Version, *APIResponse, error)
 
+	// GetWithContext retrieves a single version using the provided context.
+	//
+	// The context is also used to resolve the version when it's provided as an ID.
+	GetWithContext(ctx context.Context, version string) (Version, *APIResponse, error)
+
 	// GetAll retrieves a list of versions associated with an API key.
 	//
 	// API Reference: https://docs.readme.com/main/reference/getversions
 	GetAll() ([]VersionSummary, *APIResponse, error)
 
+	// GetAllWithContext retrieves a list of versions associated with an API key using the provided
+	// context.
+	GetAllWithContext(ctx context.Context) ([]VersionSummary, *APIResponse, error)
+
 	// Update an existing version.
 	//
 	// The version may be provided using either the semver identifier for the project version
@@ -55,12 +73,21 @@ type VersionService interface {
 	// API Reference: https://docs.readme.com/main/reference/updateversion
 	Update(version string, params VersionParams) (Version, *APIResponse, error)
 
+	// UpdateWithContext updates an existing version using the provided context.
+	//
+	// The context is also used to resolve the version when it's provided as an ID.
+	UpdateWithContext(ctx context.Context, version string, params VersionParams) (Version, *APIResponse, error)
+
 	// GetVersion parses a provided string to determine if it it's a semantic version identifier (1.0.0)
 	// or an API version identifier (id:63ac899d11c4680047ec5970). If it's an API version identifier,
 	// the value is compared with the results from GetAll() to return the semantic version that's used
 	// for API requests. If the specified version is already a semantic version string, it will be
 	// returned as-is.
 	GetVersion(version string) (string, error)
+
+	// GetVersionWithContext resolves a version identifier like GetVersion() using the provided context
+	// for the lookup of all versions.
+	GetVersionWithContext(ctx context.Context, version string) (string, error)
 }
 
 // VersionClient handles communication with the Project related methods of the ReadMe.com API.
@@ -135,32 +162,73 @@ var _ VersionService = &VersionClient{}
 // for API requests. If the specified version is already a semantic version string, it will be
 // returned as-is.
 func (c VersionClient) GetVersion(version string) (string, error) {
+	return c.GetVersionWithContext(context.Background(), version)
+}
+
+// GetVersionWithContext resolves a version identifier like GetVersion() using the provided context
+// for the lookup of all versions.
+func (c VersionClient) GetVersionWithContext(ctx context.Context, version string) (string, error) {
+	ctx = withOperation(ctx, "Version", "GetVersion")
+
 	isID, reqID := ParseID(version)
 	if !isID {
 		return version, nil
 	}
 
-	all, _, err := c.client.Version.GetAll()
+	ctx, span := c.client.startSpan(ctx, SpanStart{
+		Name: "readme.Version.GetVersion",
+		Kind: SpanKindInternal,
+	})
+	resolved, err := c.resolve(ctx, reqID)
+	span.End(SpanEnd{Err: err})
+
+	return resolved, err
+}
+
+// resolve returns the version with the provided ID, looking it up in the list of all versions
+// unless it has already been resolved.
+func (c VersionClient) resolve(ctx context.Context, reqID string) (string, error) {
+	scope := c.client.resolverScope(versionResolverKind, "")
+	resolved, ok, _, err := c.client.ResolverCache.resolve(ctx, scope, reqID, "",
+		func(ctx context.Context) (map[string]string, *APIResponse, error) {
+			all, apiResponse, err := c.client.Version.GetAllWithContext(ctx)
+			if err != nil {
+				return nil, apiResponse, err
+			}
+
+			versions := map[string]string{}
+			for _, vers := range all {
+				versions[vers.ID] = vers.Version
+			}
+
+			return versions, apiResponse, nil
+		})
 	if err != nil {
 		return "", fmt.Errorf("unable to get list of versions: %w", err)
 	}
 
-	for _, vers := range all {
-		if vers.ID == reqID {
-			return vers.Version, nil
-		}
+	if !ok {
+		return "", fmt.Errorf("no match for version ID %s: %w", reqID, ErrNotFound)
 	}
 
-	return "", fmt.Errorf("no match for version ID %s", reqID)
+	return resolved, nil
 }
 
 // GetAll retrieves a list of versions associated with an API key.
 //
 // API Reference: https://docs.readme.com/main/reference/getversions
 func (c VersionClient) GetAll() ([]VersionSummary, *APIResponse, error) {
+	return c.GetAllWithContext(context.Background())
+}
+
+// GetAllWithContext retrieves a list of versions associated with an API key using the provided
+// context.
+func (c VersionClient) GetAllWithContext(ctx context.Context) ([]VersionSummary, *APIResponse, error) {
+	ctx = withOperation(ctx, "Version", "GetAll")
+
 	var versions []VersionSummary
 
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "GET",
 		Endpoint:     VersionEndpoint,
 		UseAuth:      true,
@@ -181,13 +249,22 @@ func (c VersionClient) GetAll() ([]VersionSummary, *APIResponse, error) {
 //
 // API Reference: https://docs.readme.com/main/reference/getversion
 func (c VersionClient) Get(version string) (Version, *APIResponse, error) {
-	version, err := c.GetVersion(version)
+	return c.GetWithContext(context.Background(), version)
+}
+
+// GetWithContext retrieves a single version using the provided context.
+//
+// The context is also used to resolve the version when it's provided as an ID.
+func (c VersionClient) GetWithContext(ctx context.Context, version string) (Version, *APIResponse, error) {
+	ctx = withOperation(ctx, "Version", "Get")
+
+	version, err := c.GetVersionWithContext(ctx, version)
 	if err != nil {
 		return Version{}, nil, err
 	}
 
 	versionResponse := Version{}
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "GET",
 		Endpoint:     fmt.Sprintf("%s/%s", VersionEndpoint, version),
 		UseAuth:      true,
@@ -202,13 +279,20 @@ func (c VersionClient) Get(version string) (Version, *APIResponse, error) {
 //
 // API Reference: https://docs.readme.com/main/reference/createversion
 func (c VersionClient) Create(params VersionParams) (Version, *APIResponse, error) {
+	return c.CreateWithContext(context.Background(), params)
+}
+
+// CreateWithContext creates a new version within a project using the provided context.
+func (c VersionClient) CreateWithContext(ctx context.Context, params VersionParams) (Version, *APIResponse, error) {
+	ctx = withOperation(ctx, "Version", "Create")
+
 	payload, err := json.Marshal(params)
 	if err != nil {
 		return Version{}, nil, fmt.Errorf("unable to parse request: %w", err)
 	}
 
 	response := Version{}
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "POST",
 		Endpoint:     VersionEndpoint,
 		UseAuth:      true,
@@ -231,7 +315,20 @@ func (c VersionClient) Create(params VersionParams) (Version, *APIResponse, erro
 //
 // API Reference: https://docs.readme.com/main/reference/updateversion
 func (c VersionClient) Update(version string, params VersionParams) (Version, *APIResponse, error) {
-	version, err := c.GetVersion(version)
+	return c.UpdateWithContext(context.Background(), version, params)
+}
+
+// UpdateWithContext updates an existing version using the provided context.
+//
+// The context is also used to resolve the version when it's provided as an ID.
+func (c VersionClient) UpdateWithContext(
+	ctx context.Context,
+	version string,
+	params VersionParams,
+) (Version, *APIResponse, error) {
+	ctx = withOperation(ctx, "Version", "Update")
+
+	version, err := c.GetVersionWithContext(ctx, version)
 	if err != nil {
 		return Version{}, nil, err
 	}
@@ -242,7 +339,7 @@ func (c VersionClient) Update(version string, params VersionParams) (Version, *A
 	}
 
 	response := Version{}
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "PUT",
 		Endpoint:     fmt.Sprintf("%s/%s", VersionEndpoint, version),
 		UseAuth:      true,
@@ -251,6 +348,10 @@ func (c VersionClient) Update(version string, params VersionParams) (Version, *A
 		OkStatusCode: []int{200},
 		Response:     &response,
 	})
+	if err == nil {
+		c.client.ResolverCache.update(c.client.resolverScope(versionResolverKind, ""), response.ID, version,
+			response.Version)
+	}
 
 	return response, apiResponse, err
 }
@@ -265,12 +366,21 @@ func (c VersionClient) Update(version string, params VersionParams) (Version, *A
 //
 // API Reference: https://docs.readme.com/main/reference/deleteversion
 func (c VersionClient) Delete(version string) (bool, *APIResponse, error) {
-	version, err := c.GetVersion(version)
+	return c.DeleteWithContext(context.Background(), version)
+}
+
+// DeleteWithContext deletes a version using the provided context.
+//
+// The context is also used to resolve the version when it's provided as an ID.
+func (c VersionClient) DeleteWithContext(ctx context.Context, version string) (bool, *APIResponse, error) {
+	ctx = withOperation(ctx, "Version", "Delete")
+
+	version, err := c.GetVersionWithContext(ctx, version)
 	if err != nil {
 		return false, nil, err
 	}
 
-	apiResponse, err := c.client.APIRequest(&APIRequest{
+	apiResponse, err := c.client.APIRequestWithContext(ctx, &APIRequest{
 		Method:       "DELETE",
 		Endpoint:     fmt.Sprintf("%s/%s", VersionEndpoint, version),
 		UseAuth:      true,
@@ -280,5 +390,7 @@ func (c VersionClient) Delete(version string) (bool, *APIResponse, error) {
 		return false, apiResponse, err
 	}
 
+	c.client.ResolverCache.update(c.client.resolverScope(versionResolverKind, ""), "", version, "")
+
 	return true, apiResponse, nil
 }
@@ -0,0 +1,362 @@
+// Package cassette records the HTTP interactions of a ReadMe API client to a file and replays them
+// in tests without making requests to the API.
+//
+// A Cassette is an http.RoundTripper that wraps the transport of the client's *http.Client:
+//
+//	c, err := cassette.Load("testdata/categories.yaml", cassette.ModeReplay)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer c.Stop()
+//
+//	client, _ := readme.NewClient(apiKey, readme.WithHTTPClient(c.Wrap(http.DefaultClient)))
+//
+// Cassettes are stored as YAML, or as JSON when the file name ends with ".json". The authorization
+// header and the project's JWT secret are scrubbed before an interaction is stored.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Mode is the mode of a Cassette.
+type Mode int
+
+const (
+	// ModeReplay replays the recorded interactions and never makes requests.
+	ModeReplay Mode = iota
+	// ModeRecord makes requests with the wrapped transport and records every interaction, replacing
+	// any interactions that were recorded before.
+	ModeRecord
+	// ModeReplayOrRecord replays the recorded interactions when the cassette file exists, and
+	// records them otherwise.
+	ModeReplayOrRecord
+)
+
+// scrubbed replaces sensitive values in recorded interactions.
+const scrubbed = "[REDACTED]"
+
+// VersionHeader is the name of the HTTP request header with the project version.
+const VersionHeader = "x-readme-version"
+
+// ErrNotRecorded is returned when a request doesn't match any of the recorded interactions while
+// replaying a cassette.
+var ErrNotRecorded = errors.New("no recorded interaction matches the request")
+
+// scrubbedHeaders lists the request headers that are never recorded.
+var scrubbedHeaders = []string{"Authorization"}
+
+// scrubbedJSONFields matches JSON fields in request and response bodies that are never recorded.
+var scrubbedJSONFields = regexp.MustCompile(`("jwtSecret"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"  yaml:"request"`
+	Response Response `json:"response" yaml:"response"`
+}
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Method  string      `json:"method"            yaml:"method"`
+	URL     string      `json:"url"               yaml:"url"`
+	Headers http.Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"    yaml:"body,omitempty"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode int         `json:"statusCode"        yaml:"statusCode"`
+	Headers    http.Header `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"    yaml:"body,omitempty"`
+}
+
+// Matcher reports whether a request matches a recorded request. The body of the request has
+// already been read and scrubbed.
+type Matcher func(request *http.Request, body string, recorded Request) bool
+
+// Cassette records and replays HTTP interactions. It's safe for concurrent use.
+type Cassette struct {
+	// Matcher matches requests to recorded interactions when replaying. DefaultMatcher is used when
+	// this is nil.
+	Matcher Matcher
+
+	mu           sync.Mutex
+	path         string
+	recording    bool
+	interactions []Interaction
+	replayed     []bool
+	transport    http.RoundTripper
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ http.RoundTripper = &Cassette{}
+
+// Load returns a Cassette for the file at path.
+//
+// In ModeReplay, the file must exist. In ModeRecord, the file is created or replaced when the
+// cassette is stopped.
+func Load(path string, mode Mode) (*Cassette, error) {
+	cassette := &Cassette{path: path, recording: mode == ModeRecord}
+
+	if mode == ModeReplayOrRecord {
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			cassette.recording = true
+		}
+	}
+
+	if cassette.recording {
+		return cassette, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read cassette: %w", err)
+	}
+
+	if isJSON(path) {
+		err = json.Unmarshal(data, &cassette.interactions)
+	} else {
+		err = yaml.Unmarshal(data, &cassette.interactions)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse cassette '%s': %w", path, err)
+	}
+	cassette.replayed = make([]bool, len(cassette.interactions))
+
+	return cassette, nil
+}
+
+// Wrap returns a copy of the HTTP client that sends its requests through the cassette. When
+// recording, requests are made with the client's transport, or http.DefaultTransport if it isn't
+// set.
+func (c *Cassette) Wrap(client *http.Client) *http.Client {
+	wrapped := &http.Client{}
+	if client != nil {
+		*wrapped = *client
+	}
+
+	c.mu.Lock()
+	c.transport = wrapped.Transport
+	c.mu.Unlock()
+	wrapped.Transport = c
+
+	return wrapped
+}
+
+// Recording reports whether the cassette records interactions instead of replaying them.
+func (c *Cassette) Recording() bool {
+	return c.recording
+}
+
+// Interactions returns the recorded interactions.
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Interaction(nil), c.interactions...)
+}
+
+// RoundTrip records or replays a request.
+func (c *Cassette) RoundTrip(request *http.Request) (*http.Response, error) {
+	body, err := readBody(&request.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read request body: %w", err)
+	}
+
+	if c.recording {
+		return c.record(request, body)
+	}
+
+	return c.replay(request, scrub(body))
+}
+
+// record makes a request with the wrapped transport and records the interaction.
+func (c *Cassette) record(request *http.Request, body []byte) (*http.Response, error) {
+	c.mu.Lock()
+	transport := c.transport
+	c.mu.Unlock()
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	response, err := transport.RoundTrip(request)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // The transport's error is returned as-is.
+	}
+
+	responseBody, err := readBody(&response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response body: %w", err)
+	}
+
+	headers := request.Header.Clone()
+	for _, header := range scrubbedHeaders {
+		if headers.Get(header) != "" {
+			headers.Set(header, scrubbed)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.interactions = append(c.interactions, Interaction{
+		Request: Request{
+			Method:  request.Method,
+			URL:     request.URL.String(),
+			Headers: headers,
+			Body:    scrub(body),
+		},
+		Response: Response{
+			StatusCode: response.StatusCode,
+			Headers:    response.Header.Clone(),
+			Body:       scrub(responseBody),
+		},
+	})
+
+	return response, nil
+}
+
+// replay returns the response of the first recorded interaction that matches the request and
+// hasn't been replayed yet.
+func (c *Cassette) replay(request *http.Request, body string) (*http.Response, error) {
+	matcher := c.Matcher
+	if matcher == nil {
+		matcher = DefaultMatcher
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, interaction := range c.interactions {
+		if c.replayed[i] || !matcher(request, body, interaction.Request) {
+			continue
+		}
+		c.replayed[i] = true
+
+		statusCode := interaction.Response.StatusCode
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+			StatusCode:    statusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Headers.Clone(),
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       request,
+		}, nil
+	}
+
+	version := request.Header.Get(VersionHeader)
+	if version == "" {
+		version = "none"
+	}
+
+	return nil, fmt.Errorf("%w: %s %s (version: %s) in cassette '%s'",
+		ErrNotRecorded, request.Method, request.URL.RequestURI(), version, c.path)
+}
+
+// Stop saves the recorded interactions to the cassette file when recording. It does nothing when
+// replaying.
+func (c *Cassette) Stop() error {
+	if !c.recording {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var data []byte
+	var err error
+	if isJSON(c.path) {
+		data, err = json.MarshalIndent(c.interactions, "", "  ")
+	} else {
+		data, err = yaml.Marshal(c.interactions)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to encode cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("unable to create cassette directory: %w", err)
+	}
+
+	if err := os.WriteFile(c.path, data, 0o600); err != nil {
+		return fmt.Errorf("unable to write cassette: %w", err)
+	}
+
+	return nil
+}
+
+// DefaultMatcher matches requests with the same method, path, query parameters, version header and
+// body. Query parameters match in any order, and JSON bodies match regardless of formatting.
+func DefaultMatcher(request *http.Request, body string, recorded Request) bool {
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+
+	return request.Method == recorded.Method &&
+		request.URL.Path == recordedURL.Path &&
+		reflect.DeepEqual(request.URL.Query(), recordedURL.Query()) &&
+		request.Header.Get(VersionHeader) == recorded.Headers.Get(VersionHeader) &&
+		equalBodies(body, recorded.Body)
+}
+
+// equalBodies reports whether two request bodies are equal, comparing JSON bodies by value.
+func equalBodies(body, recorded string) bool {
+	if body == recorded {
+		return true
+	}
+
+	var bodyValue, recordedValue interface{}
+	if json.Unmarshal([]byte(body), &bodyValue) != nil || json.Unmarshal([]byte(recorded), &recordedValue) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(bodyValue, recordedValue)
+}
+
+// readBody reads a request or response body and replaces it with a copy so it can be read again.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(*body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read body: %w", err)
+	}
+
+	if err := (*body).Close(); err != nil {
+		return nil, fmt.Errorf("unable to close body: %w", err)
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+
+	return data, nil
+}
+
+// scrub returns a request or response body as a string with sensitive fields replaced.
+func scrub(body []byte) string {
+	return scrubbedJSONFields.ReplaceAllString(string(body), `$1"`+scrubbed+`"`)
+}
+
+// isJSON reports whether a cassette is stored as JSON.
+func isJSON(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".json")
+}
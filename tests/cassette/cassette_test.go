@@ -0,0 +1,186 @@
+package cassette_test
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/cassette"
+	"github.com/liveoaklabs/readme-api-go-client/tests/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+const testToken = "rdme_secret_token"
+
+// newServer returns a test server that responds with the project, the categories or a created
+// category.
+func newServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == readme.ProjectEndpoint:
+			_, _ = w.Write([]byte(testdata.ToJSON(testdata.Project)))
+		case r.Method == http.MethodGet && r.URL.Path == readme.CategoryEndpoint:
+			w.Header().Set("link", `<>; rel="next", <>; rel="prev", <>; rel="last"`)
+			_, _ = w.Write([]byte(testdata.ToJSON(testdata.Categories)))
+		default:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(testdata.ToJSON(testdata.CategorySaved)))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// record records the requests made by the function to a cassette.
+func record(t *testing.T, path, apiURL string, requests func(client *readme.Client)) {
+	t.Helper()
+
+	recorder, err := cassette.Load(path, cassette.ModeRecord)
+	if err != nil {
+		t.Fatalf("unable to load cassette: %s", err)
+	}
+
+	client, _ := readme.NewClient(testToken, readme.WithAPIURL(apiURL),
+		readme.WithHTTPClient(recorder.Wrap(http.DefaultClient)))
+	requests(client)
+
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("unable to save cassette: %s", err)
+	}
+}
+
+// replay returns a client that replays the interactions in a cassette.
+func replay(t *testing.T, path, apiURL string) (*readme.Client, *cassette.Cassette) {
+	t.Helper()
+
+	player, err := cassette.Load(path, cassette.ModeReplay)
+	if err != nil {
+		t.Fatalf("unable to load cassette: %s", err)
+	}
+
+	client, _ := readme.NewClient(testToken, readme.WithAPIURL(apiURL),
+		readme.WithHTTPClient(player.Wrap(nil)))
+
+	return client, player
+}
+
+func Test_Cassette(t *testing.T) {
+	for _, file := range []string{"cassette.yaml", "cassette.json"} {
+		t.Run("when interactions are recorded to "+file, func(t *testing.T) {
+			// Arrange
+			server := newServer(t)
+			path := filepath.Join(t.TempDir(), "fixtures", file)
+			record(t, path, server.URL, func(client *readme.Client) {
+				_, _, _ = client.Project.Get()
+				_, _, _ = client.Category.GetAll(readme.RequestOptions{Version: "1.0"})
+			})
+			server.Close()
+
+			// Act
+			client, player := replay(t, path, server.URL)
+			project, _, projectErr := client.Project.Get()
+			categories, apiResponse, categoriesErr := client.Category.GetAll(readme.RequestOptions{Version: "1.0"})
+
+			// Assert
+			assert.NoError(t, projectErr, "it does not return an error")
+			assert.NoError(t, categoriesErr, "it does not return an error")
+			assert.Equal(t, testdata.Project.Name, project.Name, "it replays the project")
+			assert.Equal(t, testdata.Categories, categories, "it replays the categories")
+			assert.Equal(t, 200, apiResponse.HTTPResponse.StatusCode, "it replays the status code")
+			assert.False(t, player.Recording(), "it replays the cassette")
+			assert.Len(t, player.Interactions(), 2, "it loads every interaction")
+
+			data, _ := os.ReadFile(path)
+			credentials := base64.StdEncoding.EncodeToString([]byte(testToken))
+			for _, interaction := range player.Interactions() {
+				assert.Equal(t, []string{"[REDACTED]"}, interaction.Request.Headers.Values("Authorization"),
+					"it scrubs the authorization header")
+			}
+			assert.NotContains(t, string(data), credentials, "it scrubs the API key")
+			assert.NotContains(t, string(data), testdata.Project.JWTSecret, "it scrubs the JWT secret")
+			assert.Contains(t, string(data), "[REDACTED]", "it replaces the scrubbed values")
+		})
+	}
+
+	t.Run("when a request wasn't recorded", func(t *testing.T) {
+		// Arrange
+		server := newServer(t)
+		path := filepath.Join(t.TempDir(), "cassette.yaml")
+		record(t, path, server.URL, func(client *readme.Client) {
+			_, _, _ = client.Category.GetAll(readme.RequestOptions{Version: "1.0"})
+		})
+		client, _ := replay(t, path, server.URL)
+
+		// Act
+		_, _, versionErr := client.Category.GetAll(readme.RequestOptions{Version: "2.0"})
+		_, _, firstErr := client.Category.GetAll(readme.RequestOptions{Version: "1.0"})
+		_, _, repeatErr := client.Category.GetAll(readme.RequestOptions{Version: "1.0"})
+
+		// Assert
+		assert.ErrorIs(t, versionErr, cassette.ErrNotRecorded, "it doesn't match another version")
+		assert.ErrorContains(t, versionErr, "GET /categories?perPage=100&page=1 (version: 2.0)",
+			"it describes the request")
+		assert.NoError(t, firstErr, "it replays the recorded request")
+		assert.ErrorIs(t, repeatErr, cassette.ErrNotRecorded, "it replays each interaction once")
+	})
+
+	t.Run("when requests have bodies", func(t *testing.T) {
+		// Arrange
+		server := newServer(t)
+		path := filepath.Join(t.TempDir(), "cassette.yaml")
+		params := readme.CategoryParams{Title: "Test", Type: "guide"}
+		record(t, path, server.URL, func(client *readme.Client) {
+			_, _ = client.Category.Create(&readme.CategorySaved{}, params)
+		})
+		client, _ := replay(t, path, server.URL)
+
+		// Act
+		other := readme.CategoryParams{Title: "Other", Type: "guide"}
+		_, otherErr := client.Category.Create(&readme.CategorySaved{}, other)
+		got := &readme.CategorySaved{}
+		_, err := client.Category.Create(got, params)
+
+		// Assert
+		assert.ErrorIs(t, otherErr, cassette.ErrNotRecorded, "it doesn't match another body")
+		assert.NoError(t, err, "it matches the same body")
+		assert.Equal(t, testdata.CategorySaved, *got, "it replays the response")
+	})
+
+	t.Run("when the cassette doesn't exist", func(t *testing.T) {
+		// Act
+		_, err := cassette.Load(filepath.Join(t.TempDir(), "missing.yaml"), cassette.ModeReplay)
+		recorder, recordErr := cassette.Load(filepath.Join(t.TempDir(), "missing.yaml"), cassette.ModeReplayOrRecord)
+
+		// Assert
+		assert.ErrorContains(t, err, "unable to read cassette", "it returns an error when replaying")
+		assert.NoError(t, recordErr, "it does not return an error when it may record")
+		assert.True(t, recorder.Recording(), "it records the cassette")
+	})
+}
+
+func Test_DefaultMatcher(t *testing.T) {
+	// Arrange
+	recorded := cassette.Request{
+		Method:  "POST",
+		URL:     "https://dash.readme.com/api/v1/docs/search?search=test&page=1",
+		Headers: http.Header{"X-Readme-Version": []string{"1.0"}},
+		Body:    `{"title": "Test", "type": "guide"}`,
+	}
+	request, _ := http.NewRequest("POST", "http://localhost/api/v1/docs/search?page=1&search=test", nil)
+	request.Header.Set("x-readme-version", "1.0")
+
+	// Assert
+	assert.True(t, cassette.DefaultMatcher(request, `{"type":"guide","title":"Test"}`, recorded),
+		"it matches query parameters in any order and JSON bodies by value")
+	assert.False(t, cassette.DefaultMatcher(request, `{"title":"Other"}`, recorded), "it doesn't match another body")
+
+	request.Header.Del("x-readme-version")
+	assert.False(t, cassette.DefaultMatcher(request, recorded.Body, recorded), "it doesn't match another version")
+}
@@ -0,0 +1,382 @@
+// Package fault injects failures into the HTTP requests of a ReadMe API client so tests can verify
+// that code using the client survives API hiccups.
+//
+// A Transport is an http.RoundTripper that wraps the transport of the client's *http.Client and
+// injects faults according to its rules:
+//
+//	transport, err := fault.NewTransport(nil, 42,
+//		fault.Rule{Method: "GET", Path: "/categories", Kind: fault.RateLimit, Times: 1},
+//		fault.Rule{Kind: fault.ServerError, Probability: 0.1},
+//	)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//
+//	client, _ := readme.NewClient(apiKey, readme.WithHTTPClient(transport.Wrap(http.DefaultClient)))
+//
+// Faults are chosen with a pseudo-random source seeded with the transport's seed, so the same
+// sequence of requests always results in the same faults.
+package fault
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Kind is a kind of fault.
+type Kind string
+
+const (
+	// Latency delays the request by the rule's Latency before it's sent. Other rules still apply to
+	// the request afterward.
+	Latency Kind = "latency"
+	// ConnectionReset fails the request with a connection reset error without sending it.
+	ConnectionReset Kind = "connection_reset"
+	// TruncatedBody sends the request and cuts off the response body halfway, failing the read with
+	// io.ErrUnexpectedEOF.
+	TruncatedBody Kind = "truncated_body"
+	// RateLimit responds with a 429 status code and a Retry-After header without sending the
+	// request.
+	RateLimit Kind = "rate_limit"
+	// ServerError responds with a 5xx status code without sending the request.
+	ServerError Kind = "server_error"
+	// MalformedJSON sends the request and replaces the response body with invalid JSON.
+	MalformedJSON Kind = "malformed_json"
+)
+
+const (
+	// defaultRetryAfter is the Retry-After delay of a RateLimit fault when the rule doesn't set one.
+	defaultRetryAfter = time.Second
+	// malformedBody is the response body of a MalformedJSON fault.
+	malformedBody = `{"message": "malformed`
+)
+
+// ErrUnknownKind is returned by NewTransport when a rule has an unknown Kind.
+var ErrUnknownKind = errors.New("unknown fault kind")
+
+// Rule describes a fault and the requests it's injected into.
+type Rule struct {
+	// Method matches the HTTP method of requests. Every method matches when this is empty.
+	Method string
+	// Path is a regular expression matched against the URL path of requests, such as "/categories"
+	// or "^/api/v1/docs/[^/]+$". Every path matches when this is empty.
+	Path string
+	// Kind is the kind of fault to inject.
+	Kind Kind
+	// Probability is the chance, between 0 and 1, that the fault is injected into a matching
+	// request. The fault is injected into every matching request when this is 0.
+	Probability float64
+	// Times limits the number of times the fault is injected. The number isn't limited when this is
+	// 0.
+	Times int
+	// Latency is the delay of a Latency fault.
+	Latency time.Duration
+	// StatusCode is the status code of a ServerError fault, which defaults to 503.
+	StatusCode int
+	// RetryAfter is the delay in the Retry-After header of a RateLimit fault, which defaults to one
+	// second. It's rounded down to whole seconds.
+	RetryAfter time.Duration
+}
+
+// Injection is a fault that was injected into a request.
+type Injection struct {
+	// Rule is the index of the rule that injected the fault.
+	Rule int
+	// Kind is the kind of fault.
+	Kind Kind
+	// Method is the HTTP method of the request.
+	Method string
+	// URL is the URL of the request.
+	URL string
+}
+
+// rule is a Rule with its compiled path and the number of times it was injected.
+type rule struct {
+	Rule
+
+	path     *regexp.Regexp
+	injected int
+}
+
+// Transport injects faults into HTTP requests. It's safe for concurrent use, although faults are
+// only reproducible when requests are made in the same order.
+type Transport struct {
+	// Base sends the requests. http.DefaultTransport is used when this is nil.
+	Base http.RoundTripper
+
+	mu         sync.Mutex
+	random     *rand.Rand
+	rules      []*rule
+	injections []Injection
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ http.RoundTripper = &Transport{}
+
+// NewTransport returns a Transport that sends requests with the base transport and injects faults
+// according to the rules, in order. The seed makes the choice of faults reproducible.
+func NewTransport(base http.RoundTripper, seed uint64, rules ...Rule) (*Transport, error) {
+	transport := &Transport{
+		Base:   base,
+		random: rand.New(rand.NewPCG(seed, seed)), //nolint:gosec // Faults don't need a secure source.
+	}
+
+	for i, r := range rules {
+		switch r.Kind {
+		case Latency, ConnectionReset, TruncatedBody, RateLimit, ServerError, MalformedJSON:
+		default:
+			return nil, fmt.Errorf("rule %d: %w: '%s'", i, ErrUnknownKind, r.Kind)
+		}
+
+		path, err := regexp.Compile(r.Path)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: invalid path: %w", i, err)
+		}
+
+		transport.rules = append(transport.rules, &rule{Rule: r, path: path})
+	}
+
+	return transport, nil
+}
+
+// Wrap returns a copy of the HTTP client that sends its requests through the transport. When the
+// transport's Base isn't set, it's set to the client's transport.
+func (t *Transport) Wrap(client *http.Client) *http.Client {
+	wrapped := &http.Client{}
+	if client != nil {
+		*wrapped = *client
+	}
+
+	t.mu.Lock()
+	if t.Base == nil {
+		t.Base = wrapped.Transport
+	}
+	t.mu.Unlock()
+	wrapped.Transport = t
+
+	return wrapped
+}
+
+// Injections returns the faults that were injected, in order.
+func (t *Transport) Injections() []Injection {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return append([]Injection(nil), t.injections...)
+}
+
+// RoundTrip sends a request, injecting the faults of the rules that match it.
+//
+// The rules are matched in order. A ConnectionReset, RateLimit or ServerError fault fails the
+// request without sending it, so the rules after it aren't matched.
+func (t *Transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	var after []int
+
+	for i := t.match(request, 0); i < len(t.rules); i = t.match(request, i+1) {
+		r := t.rules[i]
+
+		switch r.Kind {
+		case Latency:
+			if err := sleep(request.Context(), r.Latency); err != nil {
+				t.release(r)
+				closeBody(request)
+
+				return nil, err
+			}
+			t.record(i, request)
+		case ConnectionReset:
+			t.record(i, request)
+			closeBody(request)
+
+			return nil, &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+		case RateLimit:
+			t.record(i, request)
+			closeBody(request)
+			retryAfter := r.RetryAfter
+			if retryAfter == 0 {
+				retryAfter = defaultRetryAfter
+			}
+			response := errorResponse(request, http.StatusTooManyRequests, "RATE_LIMIT_EXCEEDED")
+			response.Header.Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+
+			return response, nil
+		case ServerError:
+			t.record(i, request)
+			closeBody(request)
+			statusCode := r.StatusCode
+			if statusCode == 0 {
+				statusCode = http.StatusServiceUnavailable
+			}
+
+			return errorResponse(request, statusCode, "INTERNAL_ERROR"), nil
+		case TruncatedBody, MalformedJSON:
+			after = append(after, i)
+		}
+	}
+
+	t.mu.Lock()
+	base := t.Base
+	t.mu.Unlock()
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	response, err := base.RoundTrip(request)
+	if err != nil {
+		for _, i := range after {
+			t.release(t.rules[i])
+		}
+
+		return nil, err //nolint:wrapcheck // The transport's error is returned as-is.
+	}
+
+	for n, i := range after {
+		if err := corrupt(response, t.rules[i].Kind); err != nil {
+			for _, j := range after[n:] {
+				t.release(t.rules[j])
+			}
+
+			return nil, err
+		}
+		t.record(i, request)
+	}
+
+	return response, nil
+}
+
+// match returns the index of the first rule from the index on that injects a fault into a request,
+// or the number of rules when none does. The fault counts toward the rule's Times until it's
+// released.
+func (t *Transport) match(request *http.Request, from int) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i := from; i < len(t.rules); i++ {
+		r := t.rules[i]
+		if r.Method != "" && !strings.EqualFold(r.Method, request.Method) {
+			continue
+		}
+		if !r.path.MatchString(request.URL.Path) {
+			continue
+		}
+		if r.Times > 0 && r.injected >= r.Times {
+			continue
+		}
+		if r.Probability > 0 && t.random.Float64() >= r.Probability {
+			continue
+		}
+
+		r.injected++
+
+		return i
+	}
+
+	return len(t.rules)
+}
+
+// record records the injection of the fault of a rule into a request.
+func (t *Transport) record(index int, request *http.Request) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.injections = append(t.injections, Injection{
+		Rule:   index,
+		Kind:   t.rules[index].Kind,
+		Method: request.Method,
+		URL:    request.URL.String(),
+	})
+}
+
+// release stops counting a fault that was matched but not injected toward the rule's Times.
+func (t *Transport) release(r *rule) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	r.injected--
+}
+
+// closeBody closes the body of a request that isn't sent, as a RoundTripper must.
+func closeBody(request *http.Request) {
+	if request.Body != nil {
+		_ = request.Body.Close()
+	}
+}
+
+// corrupt replaces the body of a response for a TruncatedBody or MalformedJSON fault.
+func corrupt(response *http.Response, kind Kind) error {
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("unable to read response body: %w", err)
+	}
+	if err := response.Body.Close(); err != nil {
+		return fmt.Errorf("unable to close response body: %w", err)
+	}
+
+	switch kind {
+	case TruncatedBody:
+		response.Body = io.NopCloser(io.MultiReader(
+			bytes.NewReader(body[:len(body)/2]),
+			&errorReader{err: io.ErrUnexpectedEOF},
+		))
+	case MalformedJSON:
+		response.Body = io.NopCloser(strings.NewReader(malformedBody))
+		response.ContentLength = int64(len(malformedBody))
+		response.Header.Del("Content-Length")
+	}
+
+	return nil
+}
+
+// errorResponse returns a response with a status code and a ReadMe API error body.
+func errorResponse(request *http.Request, statusCode int, code string) *http.Response {
+	body := fmt.Sprintf(`{"error":"%s","message":"Injected %d fault.","suggestion":"","docs":"","help":"","poem":[]}`,
+		code, statusCode)
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+}
+
+// errorReader is an io.Reader that always fails.
+type errorReader struct {
+	err error
+}
+
+// Read returns the reader's error.
+func (r *errorReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+// sleep waits for the duration or until the context is done.
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("unable to inject latency: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
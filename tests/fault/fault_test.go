@@ -0,0 +1,180 @@
+package fault_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/fault"
+	"github.com/liveoaklabs/readme-api-go-client/tests/readmetest"
+	"github.com/stretchr/testify/assert"
+)
+
+// newClient returns a client for a fake server that injects faults according to the rules.
+func newClient(t *testing.T, seed uint64, rules ...fault.Rule) (*readme.Client, *fault.Transport) {
+	t.Helper()
+
+	server := readmetest.NewServer()
+	t.Cleanup(server.Close)
+
+	transport, err := fault.NewTransport(nil, seed, rules...)
+	if err != nil {
+		t.Fatalf("unable to create transport: %s", err)
+	}
+
+	client, err := server.NewClient(
+		readme.WithHTTPClient(transport.Wrap(http.DefaultClient)),
+		readme.WithRetryPolicy(&readme.RetryPolicy{
+			MaxAttempts:          3,
+			InitialBackoff:       time.Millisecond,
+			MaxBackoff:           time.Millisecond,
+			RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+		}),
+	)
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	return client, transport
+}
+
+func Test_Transport(t *testing.T) {
+	t.Run("when a request is rate limited", func(t *testing.T) {
+		// Arrange
+		client, transport := newClient(t, 1, fault.Rule{Kind: fault.RateLimit, Times: 1})
+
+		// Act
+		_, apiResponse, err := client.Project.Get()
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error after retrying")
+		assert.Equal(t, 2, apiResponse.Attempts, "it retries the rate limited request")
+		assert.Equal(t, []fault.Injection{{
+			Rule:   0,
+			Kind:   fault.RateLimit,
+			Method: "GET",
+			URL:    apiResponse.HTTPResponse.Request.URL.String(),
+		}}, transport.Injections(), "it records the injected fault")
+	})
+
+	t.Run("when faults fail requests", func(t *testing.T) {
+		// Arrange
+		client, _ := newClient(t, 1,
+			fault.Rule{Path: "/outbound-ips$", Kind: fault.ConnectionReset},
+			fault.Rule{Path: "/version$", Kind: fault.ServerError, StatusCode: 500},
+			fault.Rule{Path: "/categories", Kind: fault.TruncatedBody},
+			fault.Rule{Method: "GET", Path: "/$", Kind: fault.MalformedJSON},
+		)
+
+		// Act
+		_, _, resetErr := client.OutboundIP.Get()
+		_, _, serverErr := client.Version.GetAll()
+		_, _, truncatedErr := client.Category.GetAll()
+		_, _, malformedErr := client.Project.Get()
+
+		// Assert
+		assert.ErrorIs(t, resetErr, syscall.ECONNRESET, "it resets the connection")
+		assert.ErrorIs(t, serverErr, readme.ErrServer, "it responds with a server error")
+		assert.ErrorContains(t, truncatedErr, "unexpected EOF", "it truncates the response body")
+		assert.ErrorContains(t, malformedErr, "unable to parse API response", "it returns malformed JSON")
+	})
+
+	t.Run("when several rules match a request", func(t *testing.T) {
+		// Arrange
+		client, transport := newClient(t, 1,
+			fault.Rule{Kind: fault.ServerError, Times: 1},
+			fault.Rule{Kind: fault.RateLimit, Times: 1},
+		)
+
+		// Act
+		_, apiResponse, err := client.Project.Get()
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error after retrying")
+		assert.Equal(t, 3, apiResponse.Attempts, "it injects one fault into each attempt")
+		kinds := []fault.Kind{}
+		for _, injection := range transport.Injections() {
+			kinds = append(kinds, injection.Kind)
+		}
+		assert.Equal(t, []fault.Kind{fault.ServerError, fault.RateLimit}, kinds,
+			"it only records the faults that were injected")
+	})
+
+	t.Run("when a request with a body fails without being sent", func(t *testing.T) {
+		// Arrange
+		transport, _ := fault.NewTransport(nil, 1, fault.Rule{Kind: fault.ConnectionReset})
+		body := &closeRecorder{Reader: strings.NewReader("{}")}
+		request, _ := http.NewRequest(http.MethodPost, "https://dash.readme.com/api/v1/categories", body)
+
+		// Act
+		_, err := transport.RoundTrip(request)
+
+		// Assert
+		assert.ErrorIs(t, err, syscall.ECONNRESET, "it resets the connection")
+		assert.True(t, body.closed, "it closes the request body")
+	})
+
+	t.Run("when a request is delayed", func(t *testing.T) {
+		// Arrange
+		client, _ := newClient(t, 1, fault.Rule{Kind: fault.Latency, Latency: 50 * time.Millisecond})
+
+		// Act
+		start := time.Now()
+		_, _, err := client.Project.Get()
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond, "it delays the request")
+	})
+
+	t.Run("when faults are injected with a probability", func(t *testing.T) {
+		// Arrange
+		rule := fault.Rule{Kind: fault.ServerError, Probability: 0.5}
+		first, firstTransport := newClient(t, 42, rule)
+		second, secondTransport := newClient(t, 42, rule)
+
+		// Act
+		var firstAttempts, secondAttempts []int
+		for range 20 {
+			_, firstResponse, _ := first.Project.Get()
+			_, secondResponse, _ := second.Project.Get()
+			firstAttempts = append(firstAttempts, firstResponse.Attempts)
+			secondAttempts = append(secondAttempts, secondResponse.Attempts)
+		}
+
+		// Assert
+		injected := len(firstTransport.Injections())
+		assert.Greater(t, injected, 0, "it injects some faults")
+		assert.Less(t, injected, 60, "it doesn't inject every fault")
+		assert.Equal(t, injected, len(secondTransport.Injections()), "it injects as many faults with the same seed")
+		assert.Equal(t, firstAttempts, secondAttempts, "it injects the same faults with the same seed")
+	})
+
+	t.Run("when a rule is invalid", func(t *testing.T) {
+		// Act
+		_, kindErr := fault.NewTransport(nil, 1, fault.Rule{Kind: "unknown"})
+		_, pathErr := fault.NewTransport(nil, 1, fault.Rule{Kind: fault.RateLimit, Path: "("})
+
+		// Assert
+		assert.ErrorIs(t, kindErr, fault.ErrUnknownKind, "it returns an error for an unknown kind")
+		assert.ErrorContains(t, pathErr, "invalid path", "it returns an error for an invalid path")
+	})
+}
+
+// closeRecorder is a request body that records whether it was closed.
+type closeRecorder struct {
+	io.Reader
+
+	closed bool
+}
+
+// Close records that the body was closed.
+func (c *closeRecorder) Close() error {
+	c.closed = true
+
+	return nil
+}
@@ -0,0 +1,393 @@
+package mocks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/testdata"
+)
+
+const (
+	// DefaultFakeVersion is the stable version of a new FakeStore.
+	DefaultFakeVersion = "1.0"
+
+	// fakeProjectID is the project ID set on the items created in a FakeStore.
+	fakeProjectID = "fake-project"
+
+	// fakePerPage is the number of items in a page when RequestOptions.PerPage isn't set.
+	fakePerPage = 100
+)
+
+// FakeStore is an in-memory ReadMe project shared by the fake service implementations.
+//
+// Unlike the mocks, which have to be told what each call returns, the fakes behave like the API:
+// a category created with the fake CategoryService is returned by its GetDocs() and can be used by
+// the fake DocService. No HTTP requests are made. The store is safe for concurrent use.
+type FakeStore struct {
+	mu sync.Mutex
+
+	lastID       int
+	project      readme.Project
+	outboundIPs  []readme.OutboundIP
+	openRoles    []readme.OpenRole
+	applications []readme.Application
+	versions     []*readme.Version
+	categories   []*readme.Category
+	docs         []*readme.Doc
+	changelogs   []*readme.Changelog
+	customPages  []*readme.CustomPage
+	specs        []*readme.APISpecification
+	registries   map[string]string
+	images       []readme.Image
+}
+
+// NewFakeStore returns an empty FakeStore with a stable version named DefaultFakeVersion.
+func NewFakeStore() *FakeStore {
+	store := &FakeStore{
+		project: readme.Project{
+			BaseURL:   "https://fake-project.readme.io",
+			Name:      "Fake Project",
+			Plan:      "free",
+			SubDomain: fakeProjectID,
+		},
+		registries: map[string]string{},
+	}
+
+	store.versions = []*readme.Version{{
+		CreatedAt:    now(),
+		ID:           store.newID(),
+		IsStable:     true,
+		Project:      fakeProjectID,
+		ReleaseDate:  now(),
+		Version:      DefaultFakeVersion,
+		VersionClean: DefaultFakeVersion,
+	}}
+
+	return store
+}
+
+// NewFake provides a client for use in tests that's backed by in-memory fake implementations of
+// every service, all sharing the returned store.
+//
+// Call LoadTestdata() on the store to preload it with the fixtures in the testdata package.
+func NewFake(t *testing.T) (*readme.Client, *FakeStore) {
+	client, err := readme.NewClient("test", readme.WithAPIURL("http://api.example.com/v1"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+
+	store := NewFakeStore()
+
+	client.APIRegistry = NewFakeAPIRegistryService(store)
+	client.APISpecification = NewFakeAPISpecificationService(store)
+	client.Apply = NewFakeApplyService(store)
+	client.Category = NewFakeCategoryService(store)
+	client.Changelog = NewFakeChangelogService(store)
+	client.CustomPage = NewFakeCustomPageService(store)
+	client.Doc = NewFakeDocService(store)
+	client.Image = NewFakeImageService(store)
+	client.OutboundIP = NewFakeOutboundIPService(store)
+	client.Project = NewFakeProjectService(store)
+	client.Version = NewFakeVersionService(store)
+
+	return client, store
+}
+
+// LoadTestdata replaces the data in the store with the fixtures in the testdata package.
+//
+// The API specification fixtures reference a version that isn't in the version fixtures, so
+// they're assigned to the stable version.
+func (s *FakeStore) LoadTestdata() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.project = testdata.Project
+	s.outboundIPs = append([]readme.OutboundIP(nil), testdata.OutboundIPs...)
+	s.openRoles = append([]readme.OpenRole(nil), testdata.ApplyOpenRoles...)
+	s.applications = nil
+	s.versions = clone(testdata.Versions)
+	s.categories = clone(testdata.Categories)
+	s.docs = clone(testdata.Docs)
+	s.changelogs = clone(testdata.Changelogs)
+	s.customPages = clone(testdata.CustomPages)
+	s.specs = clone(testdata.APISpecifications)
+	s.registries = map[string]string{}
+	s.images = nil
+
+	stable := s.stableVersion()
+	for _, spec := range s.specs {
+		if s.findVersion(spec.Version) == nil {
+			spec.Version = stable.ID
+		}
+	}
+}
+
+// Applications returns the job applications submitted with the fake ApplyService.
+func (s *FakeStore) Applications() []readme.Application {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]readme.Application(nil), s.applications...)
+}
+
+// Images returns the images uploaded with the fake ImageService.
+func (s *FakeStore) Images() []readme.Image {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]readme.Image(nil), s.images...)
+}
+
+// lock locks the store, unless the context is done.
+func (s *FakeStore) lock(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("unable to make request: %w", err)
+	}
+
+	s.mu.Lock()
+
+	return nil
+}
+
+// newID returns a new object ID.
+func (s *FakeStore) newID() string {
+	s.lastID++
+
+	return fmt.Sprintf("%024x", s.lastID)
+}
+
+// findVersion returns the version with a name or ID, or nil if it doesn't exist.
+func (s *FakeStore) findVersion(version string) *readme.Version {
+	for _, v := range s.versions {
+		if v.Version == version || v.ID == version {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// stableVersion returns the stable version.
+func (s *FakeStore) stableVersion() *readme.Version {
+	for _, v := range s.versions {
+		if v.IsStable {
+			return v
+		}
+	}
+
+	return s.versions[0]
+}
+
+// requestVersion returns the version requested with the request options, which is the stable
+// version when the options don't set one, or an API error when it doesn't exist.
+func (s *FakeStore) requestVersion(
+	method, endpoint string,
+	options readme.RequestOptions,
+) (*readme.Version, *readme.APIResponse, error) {
+	if options.Version == "" {
+		return s.stableVersion(), nil, nil
+	}
+
+	if v := s.findVersion(options.Version); v != nil {
+		return v, nil, nil
+	}
+
+	apiResponse, err := apiError(method, endpoint, http.StatusNotFound, "VERSION_NOTFOUND",
+		fmt.Sprintf("The version '%s' couldn't be found.", options.Version))
+
+	return nil, apiResponse, err
+}
+
+// inVersion reports whether an item's version, which is either a version name or ID, is a version.
+func inVersion(version string, v *readme.Version) bool {
+	return version == v.ID || version == v.Version
+}
+
+// requestOptions returns the first request options, or empty options.
+func requestOptions(options []readme.RequestOptions) readme.RequestOptions {
+	if len(options) > 0 {
+		return options[0]
+	}
+
+	return readme.RequestOptions{}
+}
+
+// now returns the current time in the format used by the API.
+func now() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// clone returns pointers to copies of items.
+func clone[T any](items []T) []*T {
+	cloned := make([]*T, 0, len(items))
+	for _, item := range items {
+		cloned = append(cloned, &item)
+	}
+
+	return cloned
+}
+
+// values returns copies of the items that match a filter, or every item when the filter is nil.
+func values[T any](items []*T, filter func(*T) bool) []T {
+	var matched []T
+	for _, item := range items {
+		if filter == nil || filter(item) {
+			matched = append(matched, *item)
+		}
+	}
+
+	return matched
+}
+
+// fakeResponse returns the API response of a successful request with the body encoded as JSON.
+func fakeResponse(method, endpoint string, statusCode int, body any) *readme.APIResponse {
+	apiResponse := &readme.APIResponse{
+		Attempts:     1,
+		HTTPResponse: &http.Response{StatusCode: statusCode, Header: http.Header{}},
+		Request: &readme.APIRequest{
+			Method:       method,
+			Endpoint:     endpoint,
+			OkStatusCode: []int{statusCode},
+			UseAuth:      true,
+		},
+	}
+
+	if body != nil {
+		apiResponse.Body, _ = json.Marshal(body)
+		apiResponse.HTTPResponse.Header.Set("Content-Type", "application/json")
+	}
+
+	return apiResponse
+}
+
+// apiError returns the API response and error of a request the API responds to with an error.
+func apiError(method, endpoint string, statusCode int, code, message string) (*readme.APIResponse, error) {
+	errorResponse := readme.APIErrorResponse{
+		Error:   code,
+		Message: message,
+	}
+
+	apiResponse := fakeResponse(method, endpoint, statusCode, errorResponse)
+	apiResponse.APIErrorResponse = errorResponse
+
+	return apiResponse, &readme.APIError{
+		StatusCode: statusCode,
+		Method:     method,
+		Endpoint:   endpoint,
+		Response:   errorResponse,
+		Body:       apiResponse.Body,
+	}
+}
+
+// notFound returns the API response and error of a request for an item that doesn't exist.
+func notFound(method, endpoint, kind, slug string) (*readme.APIResponse, error) {
+	code := strings.ToUpper(strings.ReplaceAll(kind, " ", "")) + "_NOTFOUND"
+
+	return apiError(method, endpoint, http.StatusNotFound, code,
+		fmt.Sprintf("The %s with the slug '%s' couldn't be found.", kind, slug))
+}
+
+// pages returns an iterator over the pages of the items returned by a function, which is called
+// with the store locked when the iteration starts.
+func pages[T any](
+	ctx context.Context,
+	store *FakeStore,
+	endpoint string,
+	options readme.RequestOptions,
+	list func() ([]T, *readme.APIResponse, error),
+) iter.Seq2[readme.Page[T], error] {
+	return func(yield func(readme.Page[T], error) bool) {
+		if err := store.lock(ctx); err != nil {
+			yield(readme.Page[T]{}, err)
+
+			return
+		}
+		items, apiResponse, err := list()
+		store.mu.Unlock()
+
+		if err != nil {
+			yield(readme.Page[T]{Response: apiResponse}, err)
+
+			return
+		}
+
+		perPage := options.PerPage
+		if perPage <= 0 {
+			perPage = fakePerPage
+		}
+
+		number := max(options.Page, 1)
+		for start := (number - 1) * perPage; start < len(items) || number == 1; start += perPage {
+			end := min(start+perPage, len(items))
+			pageItems := items[min(start, end):end]
+			endpoint := fmt.Sprintf("%s?perPage=%d&page=%d", endpoint, perPage, number)
+
+			apiResponse := fakeResponse("GET", endpoint, http.StatusOK, pageItems)
+			apiResponse.Pagination = &readme.Pagination{
+				Count:      len(pageItems),
+				Page:       number,
+				PerPage:    perPage,
+				TotalCount: len(items),
+			}
+
+			page := readme.Page[T]{
+				Items:      pageItems,
+				Number:     number,
+				PerPage:    perPage,
+				TotalCount: len(items),
+				Response:   apiResponse,
+			}
+			if !yield(page, nil) {
+				return
+			}
+
+			number++
+		}
+	}
+}
+
+// all returns an iterator over the items in each page.
+func all[T any](pageSeq iter.Seq2[readme.Page[T], error]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page, err := range pageSeq {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+
+				return
+			}
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// collect returns the items in every page, with the response of the last page, or nil when there
+// aren't any items.
+func collect[T any](pageSeq iter.Seq2[readme.Page[T], error]) ([]T, *readme.APIResponse, error) {
+	var items []T
+	var apiResponse *readme.APIResponse
+
+	for page, err := range pageSeq {
+		apiResponse = page.Response
+		if err != nil {
+			return nil, apiResponse, err
+		}
+
+		items = append(items, page.Items...)
+	}
+
+	return items, apiResponse, nil
+}
@@ -0,0 +1,98 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// FakeAPIRegistryService is an in-memory implementation of readme.APIRegistryService backed by a
+// FakeStore.
+type FakeAPIRegistryService struct {
+	store *FakeStore
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ readme.APIRegistryService = &FakeAPIRegistryService{}
+
+// NewFakeAPIRegistryService returns a fake APIRegistryService backed by a store.
+func NewFakeAPIRegistryService(store *FakeStore) *FakeAPIRegistryService {
+	return &FakeAPIRegistryService{store: store}
+}
+
+// Create stores a JSON or YAML API definition in the API registry.
+func (f *FakeAPIRegistryService) Create(
+	definition string,
+	version ...string,
+) (readme.APIRegistrySaved, *readme.APIResponse, error) {
+	return f.CreateWithContext(context.Background(), definition, version...)
+}
+
+// CreateWithContext stores an API definition in the API registry using the provided context.
+func (f *FakeAPIRegistryService) CreateWithContext(
+	ctx context.Context,
+	definition string,
+	_ ...string,
+) (readme.APIRegistrySaved, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return readme.APIRegistrySaved{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	return f.store.createRegistry("POST", readme.APIRegistryEndpoint, definition)
+}
+
+// Get returns an API definition in the API registry as JSON.
+func (f *FakeAPIRegistryService) Get(uuid string) (string, *readme.APIResponse, error) {
+	return f.GetWithContext(context.Background(), uuid)
+}
+
+// GetWithContext returns an API definition in the API registry as JSON using the provided context.
+func (f *FakeAPIRegistryService) GetWithContext(ctx context.Context, uuid string) (string, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return "", nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.APIRegistryEndpoint + "/" + uuid
+	raw, ok := f.store.registries[uuid]
+	if !ok {
+		apiResponse, err := registryNotFound("GET", endpoint, uuid)
+
+		return "", apiResponse, err
+	}
+
+	// Definitions are only stored after they're parsed.
+	def, _ := parseDefinition(raw)
+	apiResponse := fakeResponse("GET", endpoint, http.StatusOK, def.parsed)
+
+	return string(apiResponse.Body), apiResponse, nil
+}
+
+// registryNotFound returns the API response and error of a request for an API definition that
+// isn't in the API registry.
+func registryNotFound(method, endpoint, uuid string) (*readme.APIResponse, error) {
+	return apiError(method, endpoint, http.StatusNotFound, "REGISTRY_NOTFOUND",
+		fmt.Sprintf("The API registry '%s' couldn't be found.", uuid))
+}
+
+// createRegistry stores an API definition in the API registry.
+func (s *FakeStore) createRegistry(
+	method, endpoint, raw string,
+) (readme.APIRegistrySaved, *readme.APIResponse, error) {
+	def, err := parseDefinition(raw)
+	if err != nil {
+		apiResponse, err := apiError(method, endpoint, http.StatusBadRequest, "ERROR_SPEC_INVALID",
+			"The API definition is invalid: "+err.Error())
+
+		return readme.APIRegistrySaved{}, apiResponse, err
+	}
+
+	uuid := s.newID()[8:]
+	s.registries[uuid] = raw
+	saved := readme.APIRegistrySaved{Definition: def.parsed, RegistryUUID: uuid}
+
+	return saved, fakeResponse(method, endpoint, http.StatusCreated, saved), nil
+}
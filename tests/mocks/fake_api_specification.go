@@ -0,0 +1,400 @@
+package mocks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"slices"
+	"strings"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+	"gopkg.in/yaml.v3"
+)
+
+// FakeAPISpecificationService is an in-memory implementation of readme.APISpecificationService
+// backed by a FakeStore.
+//
+// Like the API, creating an API specification creates a reference category for it, and deleting
+// it deletes the category and its docs.
+type FakeAPISpecificationService struct {
+	store *FakeStore
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ readme.APISpecificationService = &FakeAPISpecificationService{}
+
+// NewFakeAPISpecificationService returns a fake APISpecificationService backed by a store.
+func NewFakeAPISpecificationService(store *FakeStore) *FakeAPISpecificationService {
+	return &FakeAPISpecificationService{store: store}
+}
+
+// All returns an iterator over the API specifications in a version.
+func (f *FakeAPISpecificationService) All(
+	ctx context.Context,
+	options ...readme.RequestOptions,
+) iter.Seq2[readme.APISpecification, error] {
+	return all(f.Pages(ctx, options...))
+}
+
+// Pages returns an iterator over the pages of API specifications in a version.
+func (f *FakeAPISpecificationService) Pages(
+	ctx context.Context,
+	options ...readme.RequestOptions,
+) iter.Seq2[readme.Page[readme.APISpecification], error] {
+	opts := requestOptions(options)
+
+	return pages(ctx, f.store, readme.APISpecificationEndpoint, opts,
+		func() ([]readme.APISpecification, *readme.APIResponse, error) {
+			v, apiResponse, err := f.store.requestVersion("GET", readme.APISpecificationEndpoint, opts)
+			if err != nil {
+				return nil, apiResponse, err
+			}
+
+			return values(f.store.specs, func(spec *readme.APISpecification) bool {
+				return inVersion(spec.Version, v)
+			}), nil, nil
+		})
+}
+
+// GetAll returns every API specification in a version.
+func (f *FakeAPISpecificationService) GetAll(
+	options ...readme.RequestOptions,
+) ([]readme.APISpecification, *readme.APIResponse, error) {
+	return f.GetAllWithContext(context.Background(), options...)
+}
+
+// GetAllWithContext returns every API specification in a version using the provided context.
+func (f *FakeAPISpecificationService) GetAllWithContext(
+	ctx context.Context,
+	options ...readme.RequestOptions,
+) ([]readme.APISpecification, *readme.APIResponse, error) {
+	return collect(f.Pages(ctx, options...))
+}
+
+// Get returns the API specification in a version with an ID.
+func (f *FakeAPISpecificationService) Get(
+	specID string,
+	options ...readme.RequestOptions,
+) (readme.APISpecification, *readme.APIResponse, error) {
+	return f.GetWithContext(context.Background(), specID, options...)
+}
+
+// GetWithContext returns the API specification in a version with an ID using the provided
+// context.
+func (f *FakeAPISpecificationService) GetWithContext(
+	ctx context.Context,
+	specID string,
+	options ...readme.RequestOptions,
+) (readme.APISpecification, *readme.APIResponse, error) {
+	specifications, apiResponse, err := f.GetAllWithContext(ctx, options...)
+	if err != nil {
+		return readme.APISpecification{}, apiResponse, fmt.Errorf("unable to retrieve API specifications: %w", err)
+	}
+
+	for _, specification := range specifications {
+		if specification.ID == specID {
+			return specification, apiResponse, nil
+		}
+	}
+
+	return readme.APISpecification{}, apiResponse, fmt.Errorf("API specification %w", readme.ErrNotFound)
+}
+
+// Create creates an API specification from a JSON or YAML definition, or from the API registry
+// with a UUID prefixed with "uuid:".
+func (f *FakeAPISpecificationService) Create(
+	definition string,
+	options ...readme.RequestOptions,
+) (readme.APISpecificationSaved, *readme.APIResponse, error) {
+	return f.CreateWithContext(context.Background(), definition, options...)
+}
+
+// CreateWithContext creates an API specification using the provided context.
+func (f *FakeAPISpecificationService) CreateWithContext(
+	ctx context.Context,
+	definition string,
+	options ...readme.RequestOptions,
+) (readme.APISpecificationSaved, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return readme.APISpecificationSaved{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.APISpecificationEndpoint
+	raw, apiResponse, err := f.store.registryDefinition("POST", endpoint, definition)
+	if err != nil {
+		return readme.APISpecificationSaved{}, apiResponse, err
+	}
+
+	return f.store.saveSpecification("POST", endpoint, raw, requestOptions(options).Version, "")
+}
+
+// Update replaces the definition of an API specification in any version.
+func (f *FakeAPISpecificationService) Update(
+	specID, definition string,
+) (readme.APISpecificationSaved, *readme.APIResponse, error) {
+	return f.UpdateWithContext(context.Background(), specID, definition)
+}
+
+// UpdateWithContext replaces the definition of an API specification using the provided context.
+func (f *FakeAPISpecificationService) UpdateWithContext(
+	ctx context.Context,
+	specID, definition string,
+) (readme.APISpecificationSaved, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return readme.APISpecificationSaved{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.APISpecificationEndpoint + "/" + specID
+	raw, apiResponse, err := f.store.registryDefinition("PUT", endpoint, definition)
+	if err != nil {
+		return readme.APISpecificationSaved{}, apiResponse, err
+	}
+
+	return f.store.saveSpecification("PUT", endpoint, raw, "", specID)
+}
+
+// Delete deletes an API specification in any version, with its category and docs.
+func (f *FakeAPISpecificationService) Delete(specID string) (bool, *readme.APIResponse, error) {
+	return f.DeleteWithContext(context.Background(), specID)
+}
+
+// DeleteWithContext deletes an API specification using the provided context.
+func (f *FakeAPISpecificationService) DeleteWithContext(
+	ctx context.Context,
+	specID string,
+) (bool, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return false, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.APISpecificationEndpoint + "/" + specID
+	spec := f.store.findSpecification(specID)
+	if spec == nil {
+		apiResponse, err := specificationNotFound("DELETE", endpoint, specID)
+
+		return false, apiResponse, err
+	}
+
+	f.store.specs = slices.DeleteFunc(f.store.specs, func(found *readme.APISpecification) bool {
+		return found == spec
+	})
+	if category := f.store.findCategoryByID(spec.Category.ID); category != nil {
+		f.store.deleteCategory(category)
+	}
+
+	return true, fakeResponse("DELETE", endpoint, http.StatusNoContent, nil), nil
+}
+
+// UploadDefinition creates an API specification when the URL is the API specification endpoint,
+// updates one when the URL is its endpoint, or stores the definition in the API registry when the
+// URL is the API registry endpoint, and decodes the result into the response.
+func (f *FakeAPISpecificationService) UploadDefinition(
+	method, content, url, version string,
+	response interface{},
+) (interface{}, *readme.APIResponse, error) {
+	return f.UploadDefinitionWithContext(context.Background(), method, content, url, version, response)
+}
+
+// UploadDefinitionWithContext uploads an API specification definition using the provided context.
+func (f *FakeAPISpecificationService) UploadDefinitionWithContext(
+	ctx context.Context,
+	method, content, url, version string,
+	response interface{},
+) (interface{}, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return nil, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	var apiResponse *readme.APIResponse
+	var err error
+	if url == readme.APIRegistryEndpoint {
+		_, apiResponse, err = f.store.createRegistry(method, url, content)
+	} else {
+		specID := strings.TrimPrefix(url, readme.APISpecificationEndpoint+"/")
+		if specID == url {
+			specID = ""
+		}
+		_, apiResponse, err = f.store.saveSpecification(method, url, content, version, specID)
+	}
+	if err != nil {
+		return nil, apiResponse, err
+	}
+
+	if response != nil {
+		if err := json.Unmarshal(apiResponse.Body, response); err != nil {
+			return nil, apiResponse, fmt.Errorf("unable to parse API response: %w", err)
+		}
+	}
+
+	return response, apiResponse, nil
+}
+
+// findSpecification returns the API specification with an ID in any version, or nil if it doesn't
+// exist.
+func (s *FakeStore) findSpecification(id string) *readme.APISpecification {
+	for _, spec := range s.specs {
+		if spec.ID == id {
+			return spec
+		}
+	}
+
+	return nil
+}
+
+// specificationNotFound returns the API response and error of a request for an API specification
+// that doesn't exist.
+func specificationNotFound(method, endpoint, id string) (*readme.APIResponse, error) {
+	return apiError(method, endpoint, http.StatusNotFound, "SPEC_NOTFOUND",
+		fmt.Sprintf("The API specification with the ID '%s' couldn't be found.", id))
+}
+
+// registryDefinition returns the definition in the API registry when the definition is a UUID
+// prefixed with "uuid:", or the definition as provided.
+func (s *FakeStore) registryDefinition(method, endpoint, definition string) (string, *readme.APIResponse, error) {
+	isUUID, uuid := readme.ParseUUID(definition)
+	if !isUUID {
+		return definition, nil, nil
+	}
+
+	raw, ok := s.registries[uuid]
+	if !ok {
+		apiResponse, err := registryNotFound(method, endpoint, uuid)
+
+		return "", apiResponse, err
+	}
+
+	return raw, nil, nil
+}
+
+// saveSpecification creates an API specification in a version with a reference category named after
+// its title, reusing the version's category when it already exists, or updates the API
+// specification with an ID in any version when the ID is set.
+func (s *FakeStore) saveSpecification(
+	method, endpoint, raw, version, specID string,
+) (readme.APISpecificationSaved, *readme.APIResponse, error) {
+	def, err := parseDefinition(raw)
+	if err != nil {
+		apiResponse, err := apiError(method, endpoint, http.StatusBadRequest, "ERROR_SPEC_INVALID",
+			"The API definition is invalid: "+err.Error())
+
+		return readme.APISpecificationSaved{}, apiResponse, err
+	}
+
+	if specID != "" {
+		spec := s.findSpecification(specID)
+		if spec == nil {
+			apiResponse, err := specificationNotFound(method, endpoint, specID)
+
+			return readme.APISpecificationSaved{}, apiResponse, err
+		}
+
+		spec.LastSynced = now()
+		spec.Title = def.title
+		spec.Type = def.kind
+		saved := readme.APISpecificationSaved{ID: spec.ID, Title: spec.Title}
+
+		return saved, fakeResponse(method, endpoint, http.StatusOK, saved), nil
+	}
+
+	v, apiResponse, err := s.requestVersion(method, endpoint, readme.RequestOptions{Version: version})
+	if err != nil {
+		return readme.APISpecificationSaved{}, apiResponse, err
+	}
+
+	category := s.referenceCategory(v, def.title)
+	if category == nil {
+		category = &readme.Category{
+			CategoryType: "reference",
+			CreatedAt:    now(),
+			ID:           s.newID(),
+			Order:        len(s.versionCategories(v)),
+			Project:      fakeProjectID,
+			Reference:    true,
+			Slug:         s.categorySlug(v, def.title),
+			Title:        def.title,
+			Type:         "reference",
+			Version:      v.ID,
+		}
+		s.categories = append(s.categories, category)
+	}
+
+	spec := &readme.APISpecification{
+		Category: readme.CategorySummary{
+			ID:    category.ID,
+			Order: category.Order,
+			Slug:  category.Slug,
+			Title: category.Title,
+			Type:  category.Type,
+		},
+		ID:         s.newID(),
+		LastSynced: now(),
+		Source:     "api",
+		Title:      def.title,
+		Type:       def.kind,
+		Version:    v.ID,
+	}
+	s.specs = append(s.specs, spec)
+	saved := readme.APISpecificationSaved{ID: spec.ID, Title: spec.Title}
+
+	return saved, fakeResponse(method, endpoint, http.StatusCreated, saved), nil
+}
+
+// referenceCategory returns the reference category in a version with a title, or nil when there
+// isn't one. API specifications are added to it instead of a new category.
+func (s *FakeStore) referenceCategory(v *readme.Version, title string) *readme.Category {
+	for _, category := range s.versionCategories(v) {
+		if category.Type == "reference" && category.Title == title {
+			return category
+		}
+	}
+
+	return nil
+}
+
+// definition is a parsed API definition.
+type definition struct {
+	// parsed is the decoded definition.
+	parsed map[string]interface{}
+	// title is the title in the definition's "info" object.
+	title string
+	// kind is the type of the definition, which is "oas" for OpenAPI definitions and "swagger" for
+	// Swagger definitions.
+	kind string
+}
+
+// parseDefinition parses a JSON or YAML API definition.
+func parseDefinition(raw string) (*definition, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, fmt.Errorf("the definition is empty")
+	}
+
+	def := &definition{}
+	if err := json.Unmarshal([]byte(raw), &def.parsed); err != nil {
+		if yamlErr := yaml.Unmarshal([]byte(raw), &def.parsed); yamlErr != nil {
+			return nil, fmt.Errorf("the definition isn't valid JSON or YAML: %w", yamlErr)
+		}
+	}
+
+	switch {
+	case def.parsed["openapi"] != nil:
+		def.kind = "oas"
+	case def.parsed["swagger"] != nil:
+		def.kind = "swagger"
+	default:
+		return nil, fmt.Errorf("the definition isn't an OpenAPI or Swagger definition")
+	}
+
+	info, _ := def.parsed["info"].(map[string]interface{})
+	def.title, _ = info["title"].(string)
+	if def.title == "" {
+		return nil, fmt.Errorf("the definition doesn't have a title")
+	}
+
+	return def, nil
+}
@@ -0,0 +1,81 @@
+package mocks
+
+import (
+	"context"
+	"net/http"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// FakeApplyService is an in-memory implementation of readme.ApplyService backed by a FakeStore.
+//
+// Applications are recorded in the store and can be retrieved with FakeStore.Applications().
+type FakeApplyService struct {
+	store *FakeStore
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ readme.ApplyService = &FakeApplyService{}
+
+// NewFakeApplyService returns a fake ApplyService backed by a store.
+func NewFakeApplyService(store *FakeStore) *FakeApplyService {
+	return &FakeApplyService{store: store}
+}
+
+// Get returns the open roles in the store.
+func (f *FakeApplyService) Get() ([]readme.OpenRole, *readme.APIResponse, error) {
+	return f.GetWithContext(context.Background())
+}
+
+// GetWithContext returns the open roles in the store using the provided context.
+func (f *FakeApplyService) GetWithContext(ctx context.Context) ([]readme.OpenRole, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return nil, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	roles := append([]readme.OpenRole(nil), f.store.openRoles...)
+
+	return roles, fakeResponse("GET", readme.ApplyEndpoint, http.StatusOK, roles), nil
+}
+
+// Apply records an application. The name, email and job are required.
+func (f *FakeApplyService) Apply(application readme.Application) (readme.ApplyResponse, *readme.APIResponse, error) {
+	return f.ApplyWithContext(context.Background(), application)
+}
+
+// ApplyWithContext records an application using the provided context.
+func (f *FakeApplyService) ApplyWithContext(
+	ctx context.Context,
+	application readme.Application,
+) (readme.ApplyResponse, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return readme.ApplyResponse{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	var code, message string
+	switch {
+	case application.Name == "":
+		code, message = "APPLY_INVALID_NAME", "You need to provide a name."
+	case application.Email == "":
+		code, message = "APPLY_INVALID_EMAIL", "You need to provide a valid email."
+	case application.Job == "":
+		code, message = "APPLY_INVALID_JOB", "You need to provide the job you're applying to."
+	}
+	if code != "" {
+		apiResponse, err := apiError("POST", readme.ApplyEndpoint, http.StatusBadRequest, code, message)
+
+		return readme.ApplyResponse{}, apiResponse, err
+	}
+
+	f.store.applications = append(f.store.applications, application)
+	applied := readme.ApplyResponse{
+		Careers:   "https://readme.com/careers",
+		Keyvalues: "https://www.keyvalues.com/readme",
+		Message:   "Thanks for applying, " + application.Name + "! We'll reach out to you soon!",
+		Poem:      []string{},
+	}
+
+	return applied, fakeResponse("POST", readme.ApplyEndpoint, http.StatusOK, applied), nil
+}
@@ -0,0 +1,411 @@
+package mocks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"slices"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/readmetest"
+)
+
+// FakeCategoryService is an in-memory implementation of readme.CategoryService backed by a
+// FakeStore.
+type FakeCategoryService struct {
+	store *FakeStore
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ readme.CategoryService = &FakeCategoryService{}
+
+// NewFakeCategoryService returns a fake CategoryService backed by a store.
+func NewFakeCategoryService(store *FakeStore) *FakeCategoryService {
+	return &FakeCategoryService{store: store}
+}
+
+// All returns an iterator over the categories in a version.
+func (f *FakeCategoryService) All(
+	ctx context.Context,
+	options ...readme.RequestOptions,
+) iter.Seq2[readme.Category, error] {
+	return all(f.Pages(ctx, options...))
+}
+
+// Pages returns an iterator over the pages of categories in a version.
+func (f *FakeCategoryService) Pages(
+	ctx context.Context,
+	options ...readme.RequestOptions,
+) iter.Seq2[readme.Page[readme.Category], error] {
+	opts := requestOptions(options)
+
+	return pages(ctx, f.store, readme.CategoryEndpoint, opts,
+		func() ([]readme.Category, *readme.APIResponse, error) {
+			v, apiResponse, err := f.store.requestVersion("GET", readme.CategoryEndpoint, opts)
+			if err != nil {
+				return nil, apiResponse, err
+			}
+
+			return values(f.store.categories, func(category *readme.Category) bool {
+				return inVersion(category.Version, v)
+			}), nil, nil
+		})
+}
+
+// GetAll returns every category in a version.
+func (f *FakeCategoryService) GetAll(options ...readme.RequestOptions) ([]readme.Category, *readme.APIResponse, error) {
+	return f.GetAllWithContext(context.Background(), options...)
+}
+
+// GetAllWithContext returns every category in a version using the provided context.
+func (f *FakeCategoryService) GetAllWithContext(
+	ctx context.Context,
+	options ...readme.RequestOptions,
+) ([]readme.Category, *readme.APIResponse, error) {
+	return collect(f.Pages(ctx, options...))
+}
+
+// Get returns a category by its slug, or by its ID prefixed with "id:".
+func (f *FakeCategoryService) Get(
+	category string,
+	options ...readme.RequestOptions,
+) (readme.Category, *readme.APIResponse, error) {
+	return f.GetWithContext(context.Background(), category, options...)
+}
+
+// GetWithContext returns a category by its slug, or by its ID prefixed with "id:", using the
+// provided context.
+func (f *FakeCategoryService) GetWithContext(
+	ctx context.Context,
+	category string,
+	options ...readme.RequestOptions,
+) (readme.Category, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return readme.Category{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.CategoryEndpoint + "/" + category
+	found, apiResponse, err := f.store.requestCategory("GET", endpoint, category, requestOptions(options))
+	if err != nil {
+		return readme.Category{}, apiResponse, err
+	}
+
+	return *found, fakeResponse("GET", endpoint, http.StatusOK, found), nil
+}
+
+// GetDocs returns the docs in a category, with their child docs.
+func (f *FakeCategoryService) GetDocs(
+	slug string,
+	options ...readme.RequestOptions,
+) ([]readme.CategoryDocs, *readme.APIResponse, error) {
+	return f.GetDocsWithContext(context.Background(), slug, options...)
+}
+
+// GetDocsWithContext returns the docs in a category, with their child docs, using the provided
+// context.
+func (f *FakeCategoryService) GetDocsWithContext(
+	ctx context.Context,
+	slug string,
+	options ...readme.RequestOptions,
+) ([]readme.CategoryDocs, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return nil, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := fmt.Sprintf("%s/%s/docs", readme.CategoryEndpoint, slug)
+	category, apiResponse, err := f.store.requestCategory("GET", endpoint, slug, requestOptions(options))
+	if err != nil {
+		return nil, apiResponse, err
+	}
+
+	docs := f.store.categoryDocs(category.ID, "")
+
+	return docs, fakeResponse("GET", endpoint, http.StatusOK, docs), nil
+}
+
+// Create creates a category and decodes it into the response, which should be a pointer to a
+// readme.CategorySaved, or to a readme.CategoryVersionSaved when a version is requested.
+func (f *FakeCategoryService) Create(
+	response any,
+	params readme.CategoryParams,
+	options ...readme.RequestOptions,
+) (*readme.APIResponse, error) {
+	return f.CreateWithContext(context.Background(), response, params, options...)
+}
+
+// CreateWithContext creates a category and decodes it into the response using the provided
+// context.
+func (f *FakeCategoryService) CreateWithContext(
+	ctx context.Context,
+	response any,
+	params readme.CategoryParams,
+	options ...readme.RequestOptions,
+) (*readme.APIResponse, error) {
+	if params.Type != "guide" && params.Type != "reference" {
+		return nil, fmt.Errorf("type must be 'guide' or 'reference'")
+	}
+
+	if err := f.store.lock(ctx); err != nil {
+		return nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	opts := requestOptions(options)
+	v, apiResponse, err := f.store.requestVersion("POST", readme.CategoryEndpoint, opts)
+	if err != nil {
+		return apiResponse, err
+	}
+
+	category := &readme.Category{
+		CategoryType: params.Type,
+		CreatedAt:    now(),
+		ID:           f.store.newID(),
+		Order:        len(f.store.versionCategories(v)),
+		Project:      fakeProjectID,
+		Reference:    params.Type == "reference",
+		Slug:         f.store.categorySlug(v, params.Title),
+		Title:        params.Title,
+		Type:         params.Type,
+		Version:      v.ID,
+	}
+	f.store.categories = append(f.store.categories, category)
+
+	var saved any = f.store.categorySaved(category, v)
+	if opts.Version != "" {
+		saved = f.store.categoryVersionSaved(category, v)
+	}
+
+	apiResponse = fakeResponse("POST", readme.CategoryEndpoint, http.StatusCreated, saved)
+	if response != nil {
+		if err := json.Unmarshal(apiResponse.Body, &response); err != nil {
+			return apiResponse, fmt.Errorf("unable to parse API response: %w", err)
+		}
+	}
+
+	return apiResponse, nil
+}
+
+// Update updates the title and type of a category. The slug is regenerated when the title changes.
+func (f *FakeCategoryService) Update(
+	slug string,
+	params readme.CategoryParams,
+	options ...readme.RequestOptions,
+) (readme.Category, *readme.APIResponse, error) {
+	return f.UpdateWithContext(context.Background(), slug, params, options...)
+}
+
+// UpdateWithContext updates a category using the provided context.
+func (f *FakeCategoryService) UpdateWithContext(
+	ctx context.Context,
+	slug string,
+	params readme.CategoryParams,
+	options ...readme.RequestOptions,
+) (readme.Category, *readme.APIResponse, error) {
+	if params.Type != "guide" && params.Type != "reference" {
+		return readme.Category{}, nil, fmt.Errorf("type must be 'guide' or 'reference'")
+	}
+
+	if err := f.store.lock(ctx); err != nil {
+		return readme.Category{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.CategoryEndpoint + "/" + slug
+	category, apiResponse, err := f.store.requestCategory("PUT", endpoint, slug, requestOptions(options))
+	if err != nil {
+		return readme.Category{}, apiResponse, err
+	}
+
+	if params.Title != category.Title {
+		category.Slug = f.store.categorySlug(f.store.findVersion(category.Version), params.Title)
+		category.Title = params.Title
+	}
+	category.CategoryType = params.Type
+	category.Reference = params.Type == "reference"
+	category.Type = params.Type
+
+	return *category, fakeResponse("PUT", endpoint, http.StatusOK, category), nil
+}
+
+// Delete deletes a category and its docs.
+func (f *FakeCategoryService) Delete(slug string, options ...readme.RequestOptions) (bool, *readme.APIResponse, error) {
+	return f.DeleteWithContext(context.Background(), slug, options...)
+}
+
+// DeleteWithContext deletes a category and its docs using the provided context.
+func (f *FakeCategoryService) DeleteWithContext(
+	ctx context.Context,
+	slug string,
+	options ...readme.RequestOptions,
+) (bool, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return false, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.CategoryEndpoint + "/" + slug
+	category, apiResponse, err := f.store.requestCategory("DELETE", endpoint, slug, requestOptions(options))
+	if err != nil {
+		return false, apiResponse, err
+	}
+
+	f.store.deleteCategory(category)
+
+	return true, fakeResponse("DELETE", endpoint, http.StatusNoContent, nil), nil
+}
+
+// versionCategories returns the categories in a version.
+func (s *FakeStore) versionCategories(v *readme.Version) []*readme.Category {
+	var categories []*readme.Category
+	for _, category := range s.categories {
+		if inVersion(category.Version, v) {
+			categories = append(categories, category)
+		}
+	}
+
+	return categories
+}
+
+// findCategory returns the category in a version with a slug, or with an ID prefixed with "id:", or
+// nil if it doesn't exist.
+func (s *FakeStore) findCategory(v *readme.Version, category string) *readme.Category {
+	isID, id := readme.ParseID(category)
+	for _, found := range s.versionCategories(v) {
+		if (isID && found.ID == id) || (!isID && found.Slug == category) {
+			return found
+		}
+	}
+
+	return nil
+}
+
+// findCategoryByID returns the category with an ID in any version, or nil if it doesn't exist.
+func (s *FakeStore) findCategoryByID(id string) *readme.Category {
+	for _, category := range s.categories {
+		if category.ID == id {
+			return category
+		}
+	}
+
+	return nil
+}
+
+// requestCategory returns the category requested in the version of the request options, or an API
+// error when it doesn't exist.
+func (s *FakeStore) requestCategory(
+	method, endpoint, category string,
+	options readme.RequestOptions,
+) (*readme.Category, *readme.APIResponse, error) {
+	v, apiResponse, err := s.requestVersion(method, endpoint, options)
+	if err != nil {
+		return nil, apiResponse, err
+	}
+
+	found := s.findCategory(v, category)
+	if found == nil {
+		apiResponse, err := notFound(method, endpoint, "category", category)
+
+		return nil, apiResponse, err
+	}
+
+	return found, nil, nil
+}
+
+// categorySlug returns a slug for a category title that's unique in a version.
+func (s *FakeStore) categorySlug(v *readme.Version, title string) string {
+	return readmetest.Slugify(title, func(slug string) bool {
+		return s.findCategory(v, slug) != nil
+	})
+}
+
+// categoryDocs returns the docs in a category with a parent doc, sorted by order, with their child
+// docs. The top-level docs have an empty parent doc.
+func (s *FakeStore) categoryDocs(categoryID, parentDoc string) []readme.CategoryDocs {
+	var docs []*readme.Doc
+	for _, doc := range s.docs {
+		if doc.Category == categoryID && doc.ParentDoc == parentDoc {
+			docs = append(docs, doc)
+		}
+	}
+	slices.SortStableFunc(docs, func(a, b *readme.Doc) int {
+		return a.Order - b.Order
+	})
+
+	categoryDocs := make([]readme.CategoryDocs, 0, len(docs))
+	for _, doc := range docs {
+		categoryDocs = append(categoryDocs, readme.CategoryDocs{
+			Children: s.categoryDocs(categoryID, doc.ID),
+			Hidden:   doc.Hidden,
+			ID:       doc.ID,
+			Order:    doc.Order,
+			Slug:     doc.Slug,
+			Title:    doc.Title,
+		})
+	}
+
+	return categoryDocs
+}
+
+// categorySaved returns the response to a request to create a category.
+func (s *FakeStore) categorySaved(category *readme.Category, v *readme.Version) readme.CategorySaved {
+	return readme.CategorySaved{
+		CreatedAt: category.CreatedAt,
+		ID:        category.ID,
+		Order:     category.Order,
+		Project:   category.Project,
+		Reference: category.Reference,
+		Slug:      category.Slug,
+		Title:     category.Title,
+		Type:      category.Type,
+		Version:   *v,
+	}
+}
+
+// categoryVersionSaved returns the response to a request to create a category in a requested
+// version.
+func (s *FakeStore) categoryVersionSaved(category *readme.Category, v *readme.Version) readme.CategoryVersionSaved {
+	forkedFrom := readme.CategoryVersionForkedFrom{ID: v.ForkedFrom}
+	if fork := s.findVersion(v.ForkedFrom); fork != nil {
+		forkedFrom.Version = fork.Version
+	}
+
+	return readme.CategoryVersionSaved{
+		CreatedAt: category.CreatedAt,
+		ID:        category.ID,
+		Order:     category.Order,
+		Project:   category.Project,
+		Reference: category.Reference,
+		Slug:      category.Slug,
+		Title:     category.Title,
+		Type:      category.Type,
+		Version: readme.CategoryVersion{
+			Categories:   values(s.versionCategories(v), nil),
+			Codename:     v.Codename,
+			CreatedAt:    v.CreatedAt,
+			ForkedFrom:   forkedFrom,
+			ID:           v.ID,
+			IsBeta:       v.IsBeta,
+			IsDeprecated: v.IsDeprecated,
+			IsHidden:     v.IsHidden,
+			IsStable:     v.IsStable,
+			Project:      v.Project,
+			ReleaseDate:  v.ReleaseDate,
+			Version:      v.Version,
+			VersionClean: v.VersionClean,
+		},
+	}
+}
+
+// deleteCategory deletes a category and its docs.
+func (s *FakeStore) deleteCategory(category *readme.Category) {
+	s.categories = slices.DeleteFunc(s.categories, func(found *readme.Category) bool {
+		return found == category
+	})
+	s.docs = slices.DeleteFunc(s.docs, func(doc *readme.Doc) bool {
+		return doc.Category == category.ID
+	})
+}
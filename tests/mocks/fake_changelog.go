@@ -0,0 +1,227 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"slices"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/readmetest"
+)
+
+// FakeChangelogService is an in-memory implementation of readme.ChangelogService backed by a
+// FakeStore.
+type FakeChangelogService struct {
+	store *FakeStore
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ readme.ChangelogService = &FakeChangelogService{}
+
+// NewFakeChangelogService returns a fake ChangelogService backed by a store.
+func NewFakeChangelogService(store *FakeStore) *FakeChangelogService {
+	return &FakeChangelogService{store: store}
+}
+
+// All returns an iterator over the changelogs.
+func (f *FakeChangelogService) All(
+	ctx context.Context,
+	options ...readme.RequestOptions,
+) iter.Seq2[readme.Changelog, error] {
+	return all(f.Pages(ctx, options...))
+}
+
+// Pages returns an iterator over the pages of changelogs.
+func (f *FakeChangelogService) Pages(
+	ctx context.Context,
+	options ...readme.RequestOptions,
+) iter.Seq2[readme.Page[readme.Changelog], error] {
+	return pages(ctx, f.store, readme.ChangelogEndpoint, requestOptions(options),
+		func() ([]readme.Changelog, *readme.APIResponse, error) {
+			return values(f.store.changelogs, nil), nil, nil
+		})
+}
+
+// GetAll returns every changelog.
+func (f *FakeChangelogService) GetAll(
+	options ...readme.RequestOptions,
+) ([]readme.Changelog, *readme.APIResponse, error) {
+	return f.GetAllWithContext(context.Background(), options...)
+}
+
+// GetAllWithContext returns every changelog using the provided context.
+func (f *FakeChangelogService) GetAllWithContext(
+	ctx context.Context,
+	options ...readme.RequestOptions,
+) ([]readme.Changelog, *readme.APIResponse, error) {
+	return collect(f.Pages(ctx, options...))
+}
+
+// Get returns a changelog by its slug.
+func (f *FakeChangelogService) Get(slug string) (readme.Changelog, *readme.APIResponse, error) {
+	return f.GetWithContext(context.Background(), slug)
+}
+
+// GetWithContext returns a changelog by its slug using the provided context.
+func (f *FakeChangelogService) GetWithContext(
+	ctx context.Context,
+	slug string,
+) (readme.Changelog, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return readme.Changelog{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.ChangelogEndpoint + "/" + slug
+	changelog := f.store.findChangelog(slug)
+	if changelog == nil {
+		apiResponse, err := notFound("GET", endpoint, "changelog", slug)
+
+		return readme.Changelog{}, apiResponse, err
+	}
+
+	return *changelog, fakeResponse("GET", endpoint, http.StatusOK, changelog), nil
+}
+
+// Create creates a changelog. A changelog is hidden unless the parameters set Hidden.
+func (f *FakeChangelogService) Create(params readme.ChangelogParams) (readme.Changelog, *readme.APIResponse, error) {
+	return f.CreateWithContext(context.Background(), params)
+}
+
+// CreateWithContext creates a changelog using the provided context.
+func (f *FakeChangelogService) CreateWithContext(
+	ctx context.Context,
+	params readme.ChangelogParams,
+) (readme.Changelog, *readme.APIResponse, error) {
+	if err := validChangelogParams(params); err != nil {
+		return readme.Changelog{}, nil, err
+	}
+
+	if err := f.store.lock(ctx); err != nil {
+		return readme.Changelog{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	changelog := &readme.Changelog{
+		CreatedAt: now(),
+		Hidden:    true,
+		ID:        f.store.newID(),
+		Project:   fakeProjectID,
+		Revision:  1,
+		Slug: readmetest.Slugify(params.Title, func(slug string) bool {
+			return f.store.findChangelog(slug) != nil
+		}),
+		Type: "added",
+	}
+	applyChangelogParams(changelog, params)
+	changelog.UpdatedAt = changelog.CreatedAt
+	f.store.changelogs = append(f.store.changelogs, changelog)
+
+	return *changelog, fakeResponse("POST", readme.ChangelogEndpoint, http.StatusCreated, changelog), nil
+}
+
+// Update updates a changelog. Its slug doesn't change.
+func (f *FakeChangelogService) Update(
+	slug string,
+	params readme.ChangelogParams,
+) (readme.Changelog, *readme.APIResponse, error) {
+	return f.UpdateWithContext(context.Background(), slug, params)
+}
+
+// UpdateWithContext updates a changelog using the provided context.
+func (f *FakeChangelogService) UpdateWithContext(
+	ctx context.Context,
+	slug string,
+	params readme.ChangelogParams,
+) (readme.Changelog, *readme.APIResponse, error) {
+	if err := validChangelogParams(params); err != nil {
+		return readme.Changelog{}, nil, err
+	}
+
+	if err := f.store.lock(ctx); err != nil {
+		return readme.Changelog{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.ChangelogEndpoint + "/" + slug
+	changelog := f.store.findChangelog(slug)
+	if changelog == nil {
+		apiResponse, err := notFound("PUT", endpoint, "changelog", slug)
+
+		return readme.Changelog{}, apiResponse, err
+	}
+
+	applyChangelogParams(changelog, params)
+	changelog.Revision++
+	changelog.UpdatedAt = now()
+
+	return *changelog, fakeResponse("PUT", endpoint, http.StatusOK, changelog), nil
+}
+
+// Delete deletes a changelog.
+func (f *FakeChangelogService) Delete(slug string) (bool, *readme.APIResponse, error) {
+	return f.DeleteWithContext(context.Background(), slug)
+}
+
+// DeleteWithContext deletes a changelog using the provided context.
+func (f *FakeChangelogService) DeleteWithContext(ctx context.Context, slug string) (bool, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return false, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.ChangelogEndpoint + "/" + slug
+	changelog := f.store.findChangelog(slug)
+	if changelog == nil {
+		apiResponse, err := notFound("DELETE", endpoint, "changelog", slug)
+
+		return false, apiResponse, err
+	}
+
+	f.store.changelogs = slices.DeleteFunc(f.store.changelogs, func(found *readme.Changelog) bool {
+		return found == changelog
+	})
+
+	return true, fakeResponse("DELETE", endpoint, http.StatusNoContent, nil), nil
+}
+
+// findChangelog returns the changelog with a slug, or nil if it doesn't exist.
+func (s *FakeStore) findChangelog(slug string) *readme.Changelog {
+	for _, changelog := range s.changelogs {
+		if changelog.Slug == slug {
+			return changelog
+		}
+	}
+
+	return nil
+}
+
+// validChangelogParams returns the error returned by the API client for invalid changelog
+// parameters.
+func validChangelogParams(params readme.ChangelogParams) error {
+	if params.Title == "" {
+		return fmt.Errorf("title must be provided")
+	}
+
+	switch params.Type {
+	case "", "added", "fixed", "improved", "deprecated", "removed":
+		return nil
+	}
+
+	return fmt.Errorf("type must be added, fixed, improved, deprecated, removed, or left unspecified")
+}
+
+// applyChangelogParams sets the fields of a changelog that are set in the parameters.
+func applyChangelogParams(changelog *readme.Changelog, params readme.ChangelogParams) {
+	changelog.Body = params.Body
+	changelog.HTML = params.Body
+	changelog.Title = params.Title
+	if params.Hidden != nil {
+		changelog.Hidden = *params.Hidden
+	}
+	if params.Type != "" {
+		changelog.Type = params.Type
+	}
+}
@@ -0,0 +1,219 @@
+package mocks
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"slices"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/readmetest"
+)
+
+// FakeCustomPageService is an in-memory implementation of readme.CustomPageService backed by a
+// FakeStore.
+type FakeCustomPageService struct {
+	store *FakeStore
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ readme.CustomPageService = &FakeCustomPageService{}
+
+// NewFakeCustomPageService returns a fake CustomPageService backed by a store.
+func NewFakeCustomPageService(store *FakeStore) *FakeCustomPageService {
+	return &FakeCustomPageService{store: store}
+}
+
+// All returns an iterator over the custom pages.
+func (f *FakeCustomPageService) All(
+	ctx context.Context,
+	options ...readme.RequestOptions,
+) iter.Seq2[readme.CustomPage, error] {
+	return all(f.Pages(ctx, options...))
+}
+
+// Pages returns an iterator over the pages of custom pages.
+func (f *FakeCustomPageService) Pages(
+	ctx context.Context,
+	options ...readme.RequestOptions,
+) iter.Seq2[readme.Page[readme.CustomPage], error] {
+	return pages(ctx, f.store, readme.CustomPageEndpoint, requestOptions(options),
+		func() ([]readme.CustomPage, *readme.APIResponse, error) {
+			return values(f.store.customPages, nil), nil, nil
+		})
+}
+
+// GetAll returns every custom page.
+func (f *FakeCustomPageService) GetAll(
+	options ...readme.RequestOptions,
+) ([]readme.CustomPage, *readme.APIResponse, error) {
+	return f.GetAllWithContext(context.Background(), options...)
+}
+
+// GetAllWithContext returns every custom page using the provided context.
+func (f *FakeCustomPageService) GetAllWithContext(
+	ctx context.Context,
+	options ...readme.RequestOptions,
+) ([]readme.CustomPage, *readme.APIResponse, error) {
+	return collect(f.Pages(ctx, options...))
+}
+
+// Get returns a custom page by its slug.
+func (f *FakeCustomPageService) Get(slug string) (readme.CustomPage, *readme.APIResponse, error) {
+	return f.GetWithContext(context.Background(), slug)
+}
+
+// GetWithContext returns a custom page by its slug using the provided context.
+func (f *FakeCustomPageService) GetWithContext(
+	ctx context.Context,
+	slug string,
+) (readme.CustomPage, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return readme.CustomPage{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.CustomPageEndpoint + "/" + slug
+	customPage := f.store.findCustomPage(slug)
+	if customPage == nil {
+		apiResponse, err := notFound("GET", endpoint, "custom page", slug)
+
+		return readme.CustomPage{}, apiResponse, err
+	}
+
+	return *customPage, fakeResponse("GET", endpoint, http.StatusOK, customPage), nil
+}
+
+// Create creates a custom page. A custom page is hidden unless the parameters set Hidden.
+func (f *FakeCustomPageService) Create(params readme.CustomPageParams) (readme.CustomPage, *readme.APIResponse, error) {
+	return f.CreateWithContext(context.Background(), params)
+}
+
+// CreateWithContext creates a custom page using the provided context.
+func (f *FakeCustomPageService) CreateWithContext(
+	ctx context.Context,
+	params readme.CustomPageParams,
+) (readme.CustomPage, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return readme.CustomPage{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	if params.Title == "" {
+		apiResponse, err := apiError("POST", readme.CustomPageEndpoint, http.StatusBadRequest, "CUSTOMPAGE_INVALID",
+			"The custom page title is required.")
+
+		return readme.CustomPage{}, apiResponse, err
+	}
+
+	customPage := &readme.CustomPage{
+		CreatedAt: now(),
+		Hidden:    true,
+		ID:        f.store.newID(),
+		Revision:  1,
+		Slug: readmetest.Slugify(params.Title, func(slug string) bool {
+			return f.store.findCustomPage(slug) != nil
+		}),
+	}
+	applyCustomPageParams(customPage, params)
+	customPage.UpdatedAt = customPage.CreatedAt
+	f.store.customPages = append(f.store.customPages, customPage)
+
+	return *customPage, fakeResponse("POST", readme.CustomPageEndpoint, http.StatusCreated, customPage), nil
+}
+
+// Update updates a custom page. Its slug doesn't change.
+func (f *FakeCustomPageService) Update(
+	slug string,
+	params readme.CustomPageParams,
+) (readme.CustomPage, *readme.APIResponse, error) {
+	return f.UpdateWithContext(context.Background(), slug, params)
+}
+
+// UpdateWithContext updates a custom page using the provided context.
+func (f *FakeCustomPageService) UpdateWithContext(
+	ctx context.Context,
+	slug string,
+	params readme.CustomPageParams,
+) (readme.CustomPage, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return readme.CustomPage{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.CustomPageEndpoint + "/" + slug
+	customPage := f.store.findCustomPage(slug)
+	if customPage == nil {
+		apiResponse, err := notFound("PUT", endpoint, "custom page", slug)
+
+		return readme.CustomPage{}, apiResponse, err
+	}
+
+	if params.Title == "" {
+		apiResponse, err := apiError("PUT", endpoint, http.StatusBadRequest, "CUSTOMPAGE_INVALID",
+			"The custom page title is required.")
+
+		return readme.CustomPage{}, apiResponse, err
+	}
+
+	applyCustomPageParams(customPage, params)
+	customPage.Revision++
+	customPage.UpdatedAt = now()
+
+	return *customPage, fakeResponse("PUT", endpoint, http.StatusOK, customPage), nil
+}
+
+// Delete deletes a custom page.
+func (f *FakeCustomPageService) Delete(slug string) (bool, *readme.APIResponse, error) {
+	return f.DeleteWithContext(context.Background(), slug)
+}
+
+// DeleteWithContext deletes a custom page using the provided context.
+func (f *FakeCustomPageService) DeleteWithContext(ctx context.Context, slug string) (bool, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return false, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.CustomPageEndpoint + "/" + slug
+	customPage := f.store.findCustomPage(slug)
+	if customPage == nil {
+		apiResponse, err := notFound("DELETE", endpoint, "custom page", slug)
+
+		return false, apiResponse, err
+	}
+
+	f.store.customPages = slices.DeleteFunc(f.store.customPages, func(found *readme.CustomPage) bool {
+		return found == customPage
+	})
+
+	return true, fakeResponse("DELETE", endpoint, http.StatusNoContent, nil), nil
+}
+
+// findCustomPage returns the custom page with a slug, or nil if it doesn't exist.
+func (s *FakeStore) findCustomPage(slug string) *readme.CustomPage {
+	for _, customPage := range s.customPages {
+		if customPage.Slug == slug {
+			return customPage
+		}
+	}
+
+	return nil
+}
+
+// applyCustomPageParams sets the fields of a custom page that are set in the parameters.
+func applyCustomPageParams(customPage *readme.CustomPage, params readme.CustomPageParams) {
+	customPage.Title = params.Title
+	if params.Body != "" {
+		customPage.Body = params.Body
+	}
+	if params.HTML != "" {
+		customPage.HTML = params.HTML
+	}
+	if params.Hidden != nil {
+		customPage.Hidden = *params.Hidden
+	}
+	if params.HTMLMode != nil {
+		customPage.HTMLMode = *params.HTMLMode
+	}
+}
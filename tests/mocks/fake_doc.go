@@ -0,0 +1,372 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/readmetest"
+)
+
+const (
+	// defaultDocOrder is the order of a doc when it's created without one.
+	defaultDocOrder = 999
+
+	// defaultDocType is the type of a doc when it's created without one.
+	defaultDocType = "basic"
+)
+
+// FakeDocService is an in-memory implementation of readme.DocService backed by a FakeStore.
+type FakeDocService struct {
+	store *FakeStore
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ readme.DocService = &FakeDocService{}
+
+// NewFakeDocService returns a fake DocService backed by a store.
+func NewFakeDocService(store *FakeStore) *FakeDocService {
+	return &FakeDocService{store: store}
+}
+
+// Get returns a doc by its slug, or by its ID prefixed with "id:". Like the API client, only docs
+// that aren't hidden can be found by ID.
+func (f *FakeDocService) Get(doc string, options ...readme.RequestOptions) (readme.Doc, *readme.APIResponse, error) {
+	return f.GetWithContext(context.Background(), doc, options...)
+}
+
+// GetWithContext returns a doc by its slug, or by its ID prefixed with "id:", using the provided
+// context.
+func (f *FakeDocService) GetWithContext(
+	ctx context.Context,
+	doc string,
+	options ...readme.RequestOptions,
+) (readme.Doc, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return readme.Doc{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	opts := requestOptions(options)
+
+	if isID, id := readme.ParseID(doc); isID {
+		v, apiResponse, err := f.store.requestVersion("GET", readme.DocEndpoint, opts)
+		if err != nil {
+			return readme.Doc{}, apiResponse, err
+		}
+
+		found := f.store.findDocByID(v, id)
+		if found == nil || found.Hidden {
+			return readme.Doc{}, nil, fmt.Errorf("no doc found matching id %s (is it hidden?): %w", id,
+				readme.ErrNotFound)
+		}
+		doc = found.Slug
+	}
+
+	endpoint := readme.DocEndpoint + "/" + doc
+	if opts.ProductionDoc {
+		endpoint += "/production"
+	}
+
+	found, apiResponse, err := f.store.requestDoc("GET", endpoint, doc, opts)
+	if err != nil {
+		return readme.Doc{}, apiResponse, err
+	}
+
+	return *found, fakeResponse("GET", endpoint, http.StatusOK, found), nil
+}
+
+// Create creates a doc in a category. A doc is hidden unless the parameters set Hidden.
+func (f *FakeDocService) Create(
+	params readme.DocParams,
+	options ...readme.RequestOptions,
+) (readme.Doc, *readme.APIResponse, error) {
+	return f.CreateWithContext(context.Background(), params, options...)
+}
+
+// CreateWithContext creates a doc in a category using the provided context.
+func (f *FakeDocService) CreateWithContext(
+	ctx context.Context,
+	params readme.DocParams,
+	options ...readme.RequestOptions,
+) (readme.Doc, *readme.APIResponse, error) {
+	if params.Title == "" {
+		return readme.Doc{}, nil, fmt.Errorf("doc title is required")
+	}
+
+	if params.Category == "" && params.CategorySlug == "" {
+		return readme.Doc{}, nil, fmt.Errorf("doc category or category slug is required")
+	}
+
+	if err := f.store.lock(ctx); err != nil {
+		return readme.Doc{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	v, apiResponse, err := f.store.requestVersion("POST", readme.DocEndpoint, requestOptions(options))
+	if err != nil {
+		return readme.Doc{}, apiResponse, err
+	}
+
+	doc := &readme.Doc{
+		CreatedAt: now(),
+		Hidden:    true,
+		ID:        f.store.newID(),
+		Order:     defaultDocOrder,
+		Project:   fakeProjectID,
+		Revision:  1,
+		Slug: readmetest.Slugify(params.Title, func(slug string) bool {
+			return f.store.findDoc(v, slug) != nil
+		}),
+		Type:    defaultDocType,
+		Version: v.ID,
+	}
+
+	if message := f.store.applyDocParams(v, doc, params); message != "" {
+		apiResponse, err := apiError("POST", readme.DocEndpoint, http.StatusBadRequest, "DOC_INVALID", message)
+
+		return readme.Doc{}, apiResponse, err
+	}
+	f.store.docs = append(f.store.docs, doc)
+
+	return *doc, fakeResponse("POST", readme.DocEndpoint, http.StatusCreated, doc), nil
+}
+
+// Update updates a doc. Its slug doesn't change.
+func (f *FakeDocService) Update(
+	slug string,
+	params readme.DocParams,
+	options ...readme.RequestOptions,
+) (readme.Doc, *readme.APIResponse, error) {
+	return f.UpdateWithContext(context.Background(), slug, params, options...)
+}
+
+// UpdateWithContext updates a doc using the provided context.
+func (f *FakeDocService) UpdateWithContext(
+	ctx context.Context,
+	slug string,
+	params readme.DocParams,
+	options ...readme.RequestOptions,
+) (readme.Doc, *readme.APIResponse, error) {
+	if params.Title == "" {
+		return readme.Doc{}, nil, fmt.Errorf("doc title is required")
+	}
+
+	if params.Category == "" && params.CategorySlug == "" {
+		return readme.Doc{}, nil, fmt.Errorf("doc category or category slug is required")
+	}
+
+	if err := f.store.lock(ctx); err != nil {
+		return readme.Doc{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.DocEndpoint + "/" + slug
+	opts := requestOptions(options)
+
+	found, apiResponse, err := f.store.requestDoc("PUT", endpoint, slug, opts)
+	if err != nil {
+		return readme.Doc{}, apiResponse, err
+	}
+
+	updated := *found
+	v := f.store.findVersion(found.Version)
+	if message := f.store.applyDocParams(v, &updated, params); message != "" {
+		apiResponse, err := apiError("PUT", endpoint, http.StatusBadRequest, "DOC_INVALID", message)
+
+		return readme.Doc{}, apiResponse, err
+	}
+	updated.Revision++
+	updated.UpdatedAt = now()
+	*found = updated
+
+	return updated, fakeResponse("PUT", endpoint, http.StatusOK, found), nil
+}
+
+// Delete deletes a doc and its child docs.
+func (f *FakeDocService) Delete(slug string, options ...readme.RequestOptions) (bool, *readme.APIResponse, error) {
+	return f.DeleteWithContext(context.Background(), slug, options...)
+}
+
+// DeleteWithContext deletes a doc and its child docs using the provided context.
+func (f *FakeDocService) DeleteWithContext(
+	ctx context.Context,
+	slug string,
+	options ...readme.RequestOptions,
+) (bool, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return false, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.DocEndpoint + "/" + slug
+	doc, apiResponse, err := f.store.requestDoc("DELETE", endpoint, slug, requestOptions(options))
+	if err != nil {
+		return false, apiResponse, err
+	}
+
+	f.store.docs = slices.DeleteFunc(f.store.docs, func(found *readme.Doc) bool {
+		return found == doc || found.ParentDoc == doc.ID
+	})
+
+	return true, fakeResponse("DELETE", endpoint, http.StatusNoContent, nil), nil
+}
+
+// Search returns the docs that aren't hidden with an ID, title, slug or body that contains the
+// query.
+func (f *FakeDocService) Search(
+	query string,
+	options ...readme.RequestOptions,
+) ([]readme.DocSearchResult, *readme.APIResponse, error) {
+	return f.SearchWithContext(context.Background(), query, options...)
+}
+
+// SearchWithContext searches docs using the provided context.
+func (f *FakeDocService) SearchWithContext(
+	ctx context.Context,
+	query string,
+	options ...readme.RequestOptions,
+) ([]readme.DocSearchResult, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return nil, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := fmt.Sprintf("%s/search?search=%s", readme.DocEndpoint, url.QueryEscape(query))
+	v, apiResponse, err := f.store.requestVersion("POST", endpoint, requestOptions(options))
+	if err != nil {
+		return nil, apiResponse, err
+	}
+
+	var results []readme.DocSearchResult
+	for _, doc := range f.store.docs {
+		if doc.Hidden || !inVersion(doc.Version, v) {
+			continue
+		}
+
+		if doc.ID != query &&
+			!strings.Contains(doc.Title, query) &&
+			!strings.Contains(doc.Slug, query) &&
+			!strings.Contains(doc.Body, query) {
+			continue
+		}
+
+		results = append(results, readme.DocSearchResult{
+			IndexName:   "Page",
+			IsReference: doc.IsReference,
+			LinkURL:     doc.LinkURL,
+			ObjectID:    doc.ID + "-0",
+			Project:     fakeProjectID,
+			ReferenceID: doc.ID,
+			Slug:        doc.Slug,
+			Title:       doc.Title,
+			Type:        doc.Type,
+			URL:         "/docs/" + doc.Slug,
+			Version:     v.ID,
+		})
+	}
+
+	return results, fakeResponse("POST", endpoint, http.StatusOK, readme.DocSearchResults{Results: results}), nil
+}
+
+// findDoc returns the doc in a version with a slug, or nil if it doesn't exist.
+func (s *FakeStore) findDoc(v *readme.Version, slug string) *readme.Doc {
+	for _, doc := range s.docs {
+		if doc.Slug == slug && inVersion(doc.Version, v) {
+			return doc
+		}
+	}
+
+	return nil
+}
+
+// findDocByID returns the doc in a version with an ID, or nil if it doesn't exist.
+func (s *FakeStore) findDocByID(v *readme.Version, id string) *readme.Doc {
+	for _, doc := range s.docs {
+		if doc.ID == id && inVersion(doc.Version, v) {
+			return doc
+		}
+	}
+
+	return nil
+}
+
+// requestDoc returns the doc requested in the version of the request options, or an API error
+// when it doesn't exist.
+func (s *FakeStore) requestDoc(
+	method, endpoint, slug string,
+	options readme.RequestOptions,
+) (*readme.Doc, *readme.APIResponse, error) {
+	v, apiResponse, err := s.requestVersion(method, endpoint, options)
+	if err != nil {
+		return nil, apiResponse, err
+	}
+
+	doc := s.findDoc(v, slug)
+	if doc == nil {
+		apiResponse, err := notFound(method, endpoint, "doc", slug)
+
+		return nil, apiResponse, err
+	}
+
+	return doc, nil, nil
+}
+
+// applyDocParams sets the fields of a doc in a version from the parameters. It returns an error
+// message when the doc's category or parent doc doesn't exist.
+func (s *FakeStore) applyDocParams(v *readme.Version, doc *readme.Doc, params readme.DocParams) string {
+	var category *readme.Category
+	if params.Category != "" {
+		category = s.findCategoryByID(params.Category)
+	} else {
+		category = s.findCategory(v, params.CategorySlug)
+	}
+	if category == nil || !inVersion(category.Version, v) {
+		return "The doc's category couldn't be found."
+	}
+
+	var parent *readme.Doc
+	switch {
+	case params.ParentDoc != "":
+		parent = s.findDocByID(v, params.ParentDoc)
+	case params.ParentDocSlug != "":
+		parent = s.findDoc(v, params.ParentDocSlug)
+	}
+	if parent == nil && (params.ParentDoc != "" || params.ParentDocSlug != "") {
+		return "The doc's parent doc couldn't be found."
+	}
+
+	doc.Category = category.ID
+	doc.ParentDoc = ""
+	if parent != nil {
+		doc.ParentDoc = parent.ID
+	}
+	doc.Title = params.Title
+	doc.Error = params.Error
+
+	if params.Body != "" {
+		doc.Body = params.Body
+	}
+	if params.Excerpt != "" {
+		doc.Excerpt = params.Excerpt
+	}
+	if params.Hidden != nil {
+		doc.Hidden = *params.Hidden
+	}
+	if params.Metadata != nil {
+		doc.Metadata.Description = params.Metadata.Description
+		doc.Metadata.Title = params.Metadata.Title
+	}
+	if params.Order != nil {
+		doc.Order = *params.Order
+	}
+	if params.Type != "" {
+		doc.Type = params.Type
+	}
+
+	return ""
+}
@@ -0,0 +1,78 @@
+package mocks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"  // Register the GIF format for decoding uploaded images.
+	_ "image/jpeg" // Register the JPEG format for decoding uploaded images.
+	_ "image/png"  // Register the PNG format for decoding uploaded images.
+	"net/http"
+	"path/filepath"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// FakeImageService is an in-memory implementation of readme.ImageService backed by a FakeStore.
+//
+// Uploaded images are recorded in the store and can be retrieved with FakeStore.Images().
+type FakeImageService struct {
+	store *FakeStore
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ readme.ImageService = &FakeImageService{}
+
+// NewFakeImageService returns a fake ImageService backed by a store.
+func NewFakeImageService(store *FakeStore) *FakeImageService {
+	return &FakeImageService{store: store}
+}
+
+// Upload records a PNG, JPEG or GIF image and returns its URL and size.
+func (f *FakeImageService) Upload(source []byte, filename ...string) (readme.Image, *readme.APIResponse, error) {
+	return f.UploadWithContext(context.Background(), source, filename...)
+}
+
+// UploadWithContext records an image using the provided context.
+func (f *FakeImageService) UploadWithContext(
+	ctx context.Context,
+	source []byte,
+	filename ...string,
+) (readme.Image, *readme.APIResponse, error) {
+	imageType := http.DetectContentType(source)
+	if imageType != "image/png" && imageType != "image/jpeg" && imageType != "image/gif" {
+		return readme.Image{}, nil, fmt.Errorf("invalid image type: %s", imageType)
+	}
+
+	uploadFilename := "image"
+	if len(filename) > 0 {
+		uploadFilename = filepath.Base(filename[0])
+	}
+
+	if err := f.store.lock(ctx); err != nil {
+		return readme.Image{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.ImageAPIURL + "/image-upload"
+	config, _, err := image.DecodeConfig(bytes.NewReader(source))
+	if err != nil {
+		apiResponse, err := apiError("POST", endpoint, http.StatusBadRequest, "IMAGE_INVALID",
+			"The image couldn't be decoded: "+err.Error())
+
+		return readme.Image{}, apiResponse, fmt.Errorf("unable to upload image: %w", err)
+	}
+
+	uploaded := readme.Image{
+		URL:      "https://files.readme.io/" + f.store.newID()[17:] + "-" + uploadFilename,
+		Filename: uploadFilename,
+		Width:    int64(config.Width),
+		Height:   int64(config.Height),
+		Color:    "#000000",
+	}
+	f.store.images = append(f.store.images, uploaded)
+
+	return uploaded, fakeResponse("POST", endpoint, http.StatusOK,
+		[]any{uploaded.URL, uploaded.Filename, uploaded.Width, uploaded.Height, uploaded.Color}), nil
+}
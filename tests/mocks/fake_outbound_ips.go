@@ -0,0 +1,39 @@
+package mocks
+
+import (
+	"context"
+	"net/http"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// FakeOutboundIPService is an in-memory implementation of readme.OutboundIPService backed by a
+// FakeStore.
+type FakeOutboundIPService struct {
+	store *FakeStore
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ readme.OutboundIPService = &FakeOutboundIPService{}
+
+// NewFakeOutboundIPService returns a fake OutboundIPService backed by a store.
+func NewFakeOutboundIPService(store *FakeStore) *FakeOutboundIPService {
+	return &FakeOutboundIPService{store: store}
+}
+
+// Get returns the outbound IP addresses in the store.
+func (f *FakeOutboundIPService) Get() ([]readme.OutboundIP, *readme.APIResponse, error) {
+	return f.GetWithContext(context.Background())
+}
+
+// GetWithContext returns the outbound IP addresses in the store using the provided context.
+func (f *FakeOutboundIPService) GetWithContext(ctx context.Context) ([]readme.OutboundIP, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return nil, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	ips := append([]readme.OutboundIP(nil), f.store.outboundIPs...)
+
+	return ips, fakeResponse("GET", readme.OutboundIPEndpoint, http.StatusOK, ips), nil
+}
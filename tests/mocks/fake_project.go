@@ -0,0 +1,37 @@
+package mocks
+
+import (
+	"context"
+	"net/http"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// FakeProjectService is an in-memory implementation of readme.ProjectService backed by a
+// FakeStore.
+type FakeProjectService struct {
+	store *FakeStore
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ readme.ProjectService = &FakeProjectService{}
+
+// NewFakeProjectService returns a fake ProjectService backed by a store.
+func NewFakeProjectService(store *FakeStore) *FakeProjectService {
+	return &FakeProjectService{store: store}
+}
+
+// Get returns the project in the store.
+func (f *FakeProjectService) Get() (readme.Project, *readme.APIResponse, error) {
+	return f.GetWithContext(context.Background())
+}
+
+// GetWithContext returns the project in the store using the provided context.
+func (f *FakeProjectService) GetWithContext(ctx context.Context) (readme.Project, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return readme.Project{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	return f.store.project, fakeResponse("GET", readme.ProjectEndpoint, http.StatusOK, f.store.project), nil
+}
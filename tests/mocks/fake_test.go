@@ -0,0 +1,219 @@
+package mocks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/mocks"
+	"github.com/liveoaklabs/readme-api-go-client/tests/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDefinition = `{"openapi": "3.0.0", "info": {"title": "Test API", "version": "1.0"}, "paths": {}}`
+
+func Test_New(t *testing.T) {
+	// Arrange
+	client, mockClient := mocks.New(t)
+	mockClient.OutboundIP.EXPECT().Get().Return(testdata.OutboundIPs, nil, nil)
+
+	// Act
+	ips, _, err := client.OutboundIP.Get()
+
+	// Assert
+	assert.NoError(t, err, "it does not return an error")
+	assert.Equal(t, testdata.OutboundIPs, ips, "it uses the mock outbound IP service")
+}
+
+func Test_NewFake(t *testing.T) {
+	t.Run("when a category is created", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		category := &readme.CategorySaved{}
+		hidden := false
+
+		// Act
+		_, createErr := client.Category.Create(category, readme.CategoryParams{Title: "Getting Started", Type: "guide"})
+		parent, _, parentErr := client.Doc.Create(readme.DocParams{
+			Title:        "Welcome",
+			CategorySlug: category.Slug,
+			Hidden:       &hidden,
+		})
+		child, _, childErr := client.Doc.Create(readme.DocParams{
+			Title:         "Welcome",
+			Category:      category.ID,
+			ParentDocSlug: parent.Slug,
+		})
+		docs, _, docsErr := client.Category.GetDocs(category.Slug)
+		found, _, getErr := client.Doc.Get("id:" + parent.ID)
+
+		// Assert
+		assert.NoError(t, errors.Join(createErr, parentErr, childErr, docsErr, getErr), "it does not return an error")
+		assert.Equal(t, "getting-started", category.Slug, "it returns the created category")
+		assert.Equal(t, "welcome-1", child.Slug, "it generates a unique doc slug")
+		assert.True(t, child.Hidden, "it hides docs by default")
+		assert.Equal(t, []readme.CategoryDocs{{
+			ID:    parent.ID,
+			Order: 999,
+			Slug:  "welcome",
+			Title: "Welcome",
+			Children: []readme.CategoryDocs{{
+				Hidden:   true,
+				ID:       child.ID,
+				Order:    999,
+				Slug:     "welcome-1",
+				Title:    "Welcome",
+				Children: []readme.CategoryDocs{},
+			}},
+		}}, docs, "it returns the category's docs")
+		assert.Equal(t, parent, found, "it finds a doc by ID")
+	})
+
+	t.Run("when the store is loaded with testdata", func(t *testing.T) {
+		// Arrange
+		client, store := mocks.NewFake(t)
+		store.LoadTestdata()
+
+		// Act
+		categories, _, categoriesErr := client.Category.GetAll()
+		doc, _, docErr := client.Doc.Get(testdata.Docs[0].Slug)
+		versions, _, versionsErr := client.Version.GetAll()
+		changelogs, _, changelogsErr := client.Changelog.GetAll()
+		ips, _, ipsErr := client.OutboundIP.Get()
+		project, _, projectErr := client.Project.Get()
+		spec, _, specErr := client.APISpecification.Get(testdata.APISpecifications[0].ID)
+
+		// Assert
+		assert.NoError(t,
+			errors.Join(categoriesErr, docErr, versionsErr, changelogsErr, ipsErr, projectErr, specErr),
+			"it does not return an error")
+		assert.Equal(t, testdata.Categories, categories, "it returns the category fixtures")
+		assert.Equal(t, testdata.Docs[0], doc, "it returns the doc fixtures")
+		assert.Equal(t, testdata.VersionSummary, versions, "it returns the version fixtures")
+		assert.Equal(t, testdata.Changelogs, changelogs, "it returns the changelog fixtures")
+		assert.Equal(t, testdata.OutboundIPs, ips, "it returns the outbound IP fixtures")
+		assert.Equal(t, testdata.Project, project, "it returns the project fixture")
+		assert.Equal(t, testdata.APISpecifications[0].Title, spec.Title, "it returns the API specification fixtures")
+	})
+
+	t.Run("when items are updated and deleted", func(t *testing.T) {
+		// Arrange
+		client, store := mocks.NewFake(t)
+		store.LoadTestdata()
+		category := testdata.Categories[0]
+
+		// Act
+		updated, _, updateErr := client.Category.Update(category.Slug,
+			readme.CategoryParams{Title: "Guides", Type: "guide"})
+		deleted, _, deleteErr := client.Category.Delete(updated.Slug)
+		_, _, getErr := client.Category.Get(updated.Slug)
+		_, _, docErr := client.Doc.Get(testdata.Docs[0].Slug)
+
+		// Assert
+		assert.NoError(t, errors.Join(updateErr, deleteErr), "it does not return an error")
+		assert.Equal(t, "guides", updated.Slug, "it regenerates the slug from the new title")
+		assert.True(t, deleted, "it deletes the category")
+		assert.ErrorIs(t, getErr, readme.ErrNotFound, "it doesn't find the deleted category")
+		assert.ErrorIs(t, docErr, readme.ErrNotFound, "it deletes the category's docs")
+	})
+
+	t.Run("when a version is forked", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		category := &readme.CategorySaved{}
+		_, _ = client.Category.Create(category, readme.CategoryParams{Title: "Guides", Type: "guide"})
+		stable := true
+
+		// Act
+		version, _, createErr := client.Version.Create(readme.VersionParams{
+			Version:  "2.0",
+			From:     mocks.DefaultFakeVersion,
+			IsStable: &stable,
+		})
+		forked, _, getErr := client.Category.Get(category.Slug, readme.RequestOptions{Version: "2.0"})
+		_, _, deleteErr := client.Version.Delete(version.Version)
+		removed, _, removeErr := client.Version.Delete(mocks.DefaultFakeVersion)
+		_, _, missingErr := client.Category.Get(category.Slug, readme.RequestOptions{Version: "3.0"})
+
+		// Assert
+		assert.NoError(t, errors.Join(createErr, getErr, removeErr), "it does not return an error")
+		assert.True(t, version.IsStable, "it makes the version stable")
+		assert.Equal(t, []string{forked.ID}, version.Categories, "it copies the categories")
+		assert.NotEqual(t, category.ID, forked.ID, "it gives copied categories a new ID")
+		assert.ErrorIs(t, deleteErr, readme.ErrValidation, "it doesn't delete the stable version")
+		assert.True(t, removed, "it deletes the previous stable version")
+		assert.ErrorIs(t, missingErr, readme.ErrNotFound, "it returns an error for an unknown version")
+	})
+
+	t.Run("when an API specification is created from the registry", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		registry, _, registryErr := client.APIRegistry.Create(testDefinition)
+
+		// Act
+		saved, _, createErr := client.APISpecification.Create("uuid:" + registry.RegistryUUID)
+		category, _, categoryErr := client.Category.Get("test-api")
+		definition, _, getErr := client.APIRegistry.Get(registry.RegistryUUID)
+		_, _, invalidErr := client.APISpecification.Create(`{"info": {}}`)
+
+		// Assert
+		assert.NoError(t, errors.Join(registryErr, createErr, categoryErr, getErr), "it does not return an error")
+		assert.Equal(t, "Test API", saved.Title, "it returns the API specification")
+		assert.Equal(t, "reference", category.Type, "it creates a reference category")
+		assert.JSONEq(t, testDefinition, definition, "it returns the registry definition")
+		assert.ErrorIs(t, invalidErr, readme.ErrValidation, "it returns an error for an invalid definition")
+	})
+
+	t.Run("when an API specification is created with an existing reference category", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		_, categoryErr := client.Category.Create(&readme.CategorySaved{},
+			readme.CategoryParams{Title: "Test API", Type: "reference"})
+
+		// Act
+		_, _, createErr := client.APISpecification.Create(testDefinition)
+		categories, _, getErr := client.Category.GetAll()
+		specs, _, specsErr := client.APISpecification.GetAll()
+
+		// Assert
+		assert.NoError(t, errors.Join(categoryErr, createErr, getErr, specsErr), "it does not return an error")
+		assert.Len(t, categories, 1, "it doesn't create another category")
+		assert.Equal(t, "test-api", specs[0].Category.Slug, "it adds the API specification to the category")
+	})
+
+	t.Run("when items are requested in pages", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		for range 5 {
+			_, _, _ = client.CustomPage.Create(readme.CustomPageParams{Title: "Page"})
+		}
+
+		// Act
+		var sizes []int
+		for page, err := range client.CustomPage.Pages(context.Background(), readme.RequestOptions{PerPage: 2}) {
+			assert.NoError(t, err, "it does not return an error")
+			sizes = append(sizes, len(page.Items))
+		}
+		all, _, err := client.CustomPage.GetAll(readme.RequestOptions{PerPage: 2})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, []int{2, 2, 1}, sizes, "it splits the items into pages")
+		assert.Len(t, all, 5, "it returns every item")
+		assert.Equal(t, "page-4", all[4].Slug, "it returns the items in order")
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+		// Arrange
+		client, _ := mocks.NewFake(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// Act
+		_, _, err := client.Project.GetWithContext(ctx)
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled, "it returns the context's error")
+	})
+}
@@ -0,0 +1,363 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// FakeVersionService is an in-memory implementation of readme.VersionService backed by a
+// FakeStore.
+type FakeVersionService struct {
+	store *FakeStore
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ readme.VersionService = &FakeVersionService{}
+
+// NewFakeVersionService returns a fake VersionService backed by a store.
+func NewFakeVersionService(store *FakeStore) *FakeVersionService {
+	return &FakeVersionService{store: store}
+}
+
+// GetVersion returns the name of a version provided as an ID prefixed with "id:", or the version as
+// provided when it isn't an ID.
+func (f *FakeVersionService) GetVersion(version string) (string, error) {
+	return f.GetVersionWithContext(context.Background(), version)
+}
+
+// GetVersionWithContext returns the name of a version using the provided context.
+func (f *FakeVersionService) GetVersionWithContext(ctx context.Context, version string) (string, error) {
+	isID, id := readme.ParseID(version)
+	if !isID {
+		return version, nil
+	}
+
+	if err := f.store.lock(ctx); err != nil {
+		return "", err
+	}
+	defer f.store.mu.Unlock()
+
+	for _, v := range f.store.versions {
+		if v.ID == id {
+			return v.Version, nil
+		}
+	}
+
+	return "", fmt.Errorf("no match for version ID %s: %w", id, readme.ErrNotFound)
+}
+
+// GetAll returns a summary of every version.
+func (f *FakeVersionService) GetAll() ([]readme.VersionSummary, *readme.APIResponse, error) {
+	return f.GetAllWithContext(context.Background())
+}
+
+// GetAllWithContext returns a summary of every version using the provided context.
+func (f *FakeVersionService) GetAllWithContext(
+	ctx context.Context,
+) ([]readme.VersionSummary, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return nil, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	summaries := make([]readme.VersionSummary, 0, len(f.store.versions))
+	for _, v := range f.store.versions {
+		summaries = append(summaries, readme.VersionSummary{
+			Codename:     v.Codename,
+			CreatedAt:    v.CreatedAt,
+			ForkedFrom:   v.ForkedFrom,
+			ID:           v.ID,
+			IsBeta:       v.IsBeta,
+			IsDeprecated: v.IsDeprecated,
+			IsHidden:     v.IsHidden,
+			IsStable:     v.IsStable,
+			Version:      v.Version,
+			VersionClean: v.VersionClean,
+		})
+	}
+
+	return summaries, fakeResponse("GET", readme.VersionEndpoint, http.StatusOK, summaries), nil
+}
+
+// Get returns a version by its name, or by its ID prefixed with "id:".
+func (f *FakeVersionService) Get(version string) (readme.Version, *readme.APIResponse, error) {
+	return f.GetWithContext(context.Background(), version)
+}
+
+// GetWithContext returns a version by its name, or by its ID prefixed with "id:", using the
+// provided context.
+func (f *FakeVersionService) GetWithContext(
+	ctx context.Context,
+	version string,
+) (readme.Version, *readme.APIResponse, error) {
+	version, err := f.GetVersionWithContext(ctx, version)
+	if err != nil {
+		return readme.Version{}, nil, err
+	}
+
+	if err := f.store.lock(ctx); err != nil {
+		return readme.Version{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.VersionEndpoint + "/" + version
+	v, apiResponse, err := f.store.requestVersion("GET", endpoint, readme.RequestOptions{Version: version})
+	if err != nil {
+		return readme.Version{}, apiResponse, err
+	}
+
+	found := f.store.versionResponse(v)
+
+	return found, fakeResponse("GET", endpoint, http.StatusOK, found), nil
+}
+
+// Create creates a version forked from another version, copying its categories, docs and API
+// specifications.
+func (f *FakeVersionService) Create(params readme.VersionParams) (readme.Version, *readme.APIResponse, error) {
+	return f.CreateWithContext(context.Background(), params)
+}
+
+// CreateWithContext creates a version using the provided context.
+func (f *FakeVersionService) CreateWithContext(
+	ctx context.Context,
+	params readme.VersionParams,
+) (readme.Version, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return readme.Version{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.VersionEndpoint
+
+	var code, message string
+	fork := f.store.findVersion(params.From)
+	switch {
+	case params.Version == "":
+		code, message = "VERSION_EMPTY", "A version name is required."
+	case f.store.findVersion(params.Version) != nil:
+		code, message = "VERSION_DUPLICATE", fmt.Sprintf("The version '%s' already exists.", params.Version)
+	case params.From == "":
+		code, message = "VERSION_FORK_EMPTY", "The version to fork from is required."
+	case fork == nil:
+		code, message = "VERSION_FORK_NOTFOUND", fmt.Sprintf("The version '%s' couldn't be found.", params.From)
+	}
+	if code != "" {
+		apiResponse, err := apiError("POST", endpoint, http.StatusBadRequest, code, message)
+
+		return readme.Version{}, apiResponse, err
+	}
+
+	v := &readme.Version{
+		Codename:     params.Codename,
+		CreatedAt:    now(),
+		ForkedFrom:   fork.ID,
+		ID:           f.store.newID(),
+		Project:      fakeProjectID,
+		ReleaseDate:  now(),
+		Version:      params.Version,
+		VersionClean: params.Version,
+	}
+	f.store.versions = append(f.store.versions, v)
+	f.store.applyVersionParams(v, params)
+	f.store.forkVersion(fork, v)
+
+	created := f.store.versionResponse(v)
+
+	return created, fakeResponse("POST", endpoint, http.StatusOK, created), nil
+}
+
+// Update updates a version. The stable version can't be demoted, but another version can be made
+// stable.
+func (f *FakeVersionService) Update(
+	version string,
+	params readme.VersionParams,
+) (readme.Version, *readme.APIResponse, error) {
+	return f.UpdateWithContext(context.Background(), version, params)
+}
+
+// UpdateWithContext updates a version using the provided context.
+func (f *FakeVersionService) UpdateWithContext(
+	ctx context.Context,
+	version string,
+	params readme.VersionParams,
+) (readme.Version, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return readme.Version{}, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.VersionEndpoint + "/" + version
+	v, apiResponse, err := f.store.requestVersion("PUT", endpoint, readme.RequestOptions{Version: version})
+	if err != nil {
+		return readme.Version{}, apiResponse, err
+	}
+
+	var code, message string
+	switch {
+	case params.Version != "" && params.Version != v.Version && f.store.findVersion(params.Version) != nil:
+		code, message = "VERSION_DUPLICATE", fmt.Sprintf("The version '%s' already exists.", params.Version)
+	case v.IsStable && params.IsStable != nil && !*params.IsStable:
+		code, message = "VERSION_CANT_DEMOTE_STABLE", "The stable version can't be demoted."
+	}
+	if code != "" {
+		apiResponse, err := apiError("PUT", endpoint, http.StatusBadRequest, code, message)
+
+		return readme.Version{}, apiResponse, err
+	}
+
+	if params.Version != "" && params.Version != v.Version {
+		f.store.renameVersion(v, params.Version)
+	}
+	if params.Codename != "" {
+		v.Codename = params.Codename
+	}
+	f.store.applyVersionParams(v, params)
+
+	updated := f.store.versionResponse(v)
+
+	return updated, fakeResponse("PUT", endpoint, http.StatusOK, updated), nil
+}
+
+// Delete deletes a version with its categories, docs and API specifications. The stable version
+// can't be deleted.
+func (f *FakeVersionService) Delete(version string) (bool, *readme.APIResponse, error) {
+	return f.DeleteWithContext(context.Background(), version)
+}
+
+// DeleteWithContext deletes a version using the provided context.
+func (f *FakeVersionService) DeleteWithContext(ctx context.Context, version string) (bool, *readme.APIResponse, error) {
+	if err := f.store.lock(ctx); err != nil {
+		return false, nil, err
+	}
+	defer f.store.mu.Unlock()
+
+	endpoint := readme.VersionEndpoint + "/" + version
+	v, apiResponse, err := f.store.requestVersion("DELETE", endpoint, readme.RequestOptions{Version: version})
+	if err != nil {
+		return false, apiResponse, err
+	}
+
+	if v.IsStable {
+		apiResponse, err := apiError("DELETE", endpoint, http.StatusBadRequest, "VERSION_CANT_REMOVE_STABLE",
+			"The stable version can't be removed.")
+
+		return false, apiResponse, err
+	}
+
+	f.store.versions = slices.DeleteFunc(f.store.versions, func(found *readme.Version) bool {
+		return found == v
+	})
+	f.store.categories = slices.DeleteFunc(f.store.categories, func(category *readme.Category) bool {
+		return inVersion(category.Version, v)
+	})
+	f.store.docs = slices.DeleteFunc(f.store.docs, func(doc *readme.Doc) bool {
+		return inVersion(doc.Version, v)
+	})
+	f.store.specs = slices.DeleteFunc(f.store.specs, func(spec *readme.APISpecification) bool {
+		return inVersion(spec.Version, v)
+	})
+
+	return true, fakeResponse("DELETE", endpoint, http.StatusOK, map[string]bool{"removed": true}), nil
+}
+
+// versionResponse returns a version with the IDs of its categories.
+func (s *FakeStore) versionResponse(v *readme.Version) readme.Version {
+	response := *v
+	response.Categories = []string{}
+	for _, category := range s.versionCategories(v) {
+		response.Categories = append(response.Categories, category.ID)
+	}
+
+	return response
+}
+
+// applyVersionParams sets the flags of a version from the parameters. Making a version stable
+// demotes the previous stable version.
+func (s *FakeStore) applyVersionParams(v *readme.Version, params readme.VersionParams) {
+	if params.IsBeta != nil {
+		v.IsBeta = *params.IsBeta
+	}
+	if params.IsDeprecated != nil {
+		v.IsDeprecated = *params.IsDeprecated
+	}
+	if params.IsHidden != nil {
+		v.IsHidden = *params.IsHidden
+	}
+	if params.IsStable != nil && *params.IsStable {
+		for _, other := range s.versions {
+			other.IsStable = other == v
+		}
+	}
+}
+
+// renameVersion renames a version, moving the items that reference it by name.
+func (s *FakeStore) renameVersion(v *readme.Version, name string) {
+	for _, category := range s.categories {
+		if category.Version == v.Version {
+			category.Version = v.ID
+		}
+	}
+	for _, doc := range s.docs {
+		if doc.Version == v.Version {
+			doc.Version = v.ID
+		}
+	}
+	for _, spec := range s.specs {
+		if spec.Version == v.Version {
+			spec.Version = v.ID
+		}
+	}
+
+	v.Version = name
+	v.VersionClean = name
+}
+
+// forkVersion copies the categories, docs and API specifications of a version into a new version
+// with new IDs.
+func (s *FakeStore) forkVersion(from, to *readme.Version) {
+	categoryIDs := map[string]string{}
+	for _, category := range s.versionCategories(from) {
+		forked := *category
+		forked.ID = s.newID()
+		forked.Version = to.ID
+		categoryIDs[category.ID] = forked.ID
+		s.categories = append(s.categories, &forked)
+	}
+
+	docIDs := map[string]string{}
+	var docs []*readme.Doc
+	for _, doc := range s.docs {
+		if !inVersion(doc.Version, from) {
+			continue
+		}
+
+		forked := *doc
+		forked.ID = s.newID()
+		forked.Category = categoryIDs[doc.Category]
+		forked.Version = to.ID
+		docIDs[doc.ID] = forked.ID
+		docs = append(docs, &forked)
+	}
+	for _, doc := range docs {
+		if doc.ParentDoc != "" {
+			doc.ParentDoc = docIDs[doc.ParentDoc]
+		}
+	}
+	s.docs = append(s.docs, docs...)
+
+	for _, spec := range s.specs {
+		if !inVersion(spec.Version, from) {
+			continue
+		}
+
+		forked := *spec
+		forked.ID = s.newID()
+		forked.Category.ID = categoryIDs[spec.Category.ID]
+		forked.Version = to.ID
+		s.specs = append(s.specs, &forked)
+	}
+}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	readme "github.com/liveoaklabs/readme-api-go-client/readme"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -100,6 +102,87 @@ func (_c *MockAPIRegistryService_Create_Call) RunAndReturn(run func(string, ...s
 	return _c
 }
 
+// CreateWithContext provides a mock function with given fields: ctx, definition, version
+func (_m *MockAPIRegistryService) CreateWithContext(ctx context.Context, definition string, version ...string) (readme.APIRegistrySaved, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(version))
+	for _i := range version {
+		_va[_i] = version[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, definition)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithContext")
+	}
+
+	var r0 readme.APIRegistrySaved
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) (readme.APIRegistrySaved, *readme.APIResponse, error)); ok {
+		return rf(ctx, definition, version...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) readme.APIRegistrySaved); ok {
+		r0 = rf(ctx, definition, version...)
+	} else {
+		r0 = ret.Get(0).(readme.APIRegistrySaved)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...string) *readme.APIResponse); ok {
+		r1 = rf(ctx, definition, version...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, ...string) error); ok {
+		r2 = rf(ctx, definition, version...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAPIRegistryService_CreateWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithContext'
+type MockAPIRegistryService_CreateWithContext_Call struct {
+	*mock.Call
+}
+
+// CreateWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - definition string
+//   - version ...string
+func (_e *MockAPIRegistryService_Expecter) CreateWithContext(ctx interface{}, definition interface{}, version ...interface{}) *MockAPIRegistryService_CreateWithContext_Call {
+	return &MockAPIRegistryService_CreateWithContext_Call{Call: _e.mock.On("CreateWithContext",
+		append([]interface{}{ctx, definition}, version...)...)}
+}
+
+func (_c *MockAPIRegistryService_CreateWithContext_Call) Run(run func(ctx context.Context, definition string, version ...string)) *MockAPIRegistryService_CreateWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAPIRegistryService_CreateWithContext_Call) Return(_a0 readme.APIRegistrySaved, _a1 *readme.APIResponse, _a2 error) *MockAPIRegistryService_CreateWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAPIRegistryService_CreateWithContext_Call) RunAndReturn(run func(context.Context, string, ...string) (readme.APIRegistrySaved, *readme.APIResponse, error)) *MockAPIRegistryService_CreateWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: uuid
 func (_m *MockAPIRegistryService) Get(uuid string) (string, *readme.APIResponse, error) {
 	ret := _m.Called(uuid)
@@ -165,6 +248,72 @@ func (_c *MockAPIRegistryService_Get_Call) RunAndReturn(run func(string) (string
 	return _c
 }
 
+// GetWithContext provides a mock function with given fields: ctx, uuid
+func (_m *MockAPIRegistryService) GetWithContext(ctx context.Context, uuid string) (string, *readme.APIResponse, error) {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithContext")
+	}
+
+	var r0 string
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, *readme.APIResponse, error)); ok {
+		return rf(ctx, uuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *readme.APIResponse); ok {
+		r1 = rf(ctx, uuid)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, uuid)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAPIRegistryService_GetWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithContext'
+type MockAPIRegistryService_GetWithContext_Call struct {
+	*mock.Call
+}
+
+// GetWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *MockAPIRegistryService_Expecter) GetWithContext(ctx interface{}, uuid interface{}) *MockAPIRegistryService_GetWithContext_Call {
+	return &MockAPIRegistryService_GetWithContext_Call{Call: _e.mock.On("GetWithContext", ctx, uuid)}
+}
+
+func (_c *MockAPIRegistryService_GetWithContext_Call) Run(run func(ctx context.Context, uuid string)) *MockAPIRegistryService_GetWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPIRegistryService_GetWithContext_Call) Return(_a0 string, _a1 *readme.APIResponse, _a2 error) *MockAPIRegistryService_GetWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAPIRegistryService_GetWithContext_Call) RunAndReturn(run func(context.Context, string) (string, *readme.APIResponse, error)) *MockAPIRegistryService_GetWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockAPIRegistryService creates a new instance of MockAPIRegistryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockAPIRegistryService(t interface {
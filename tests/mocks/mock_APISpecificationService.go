@@ -3,8 +3,12 @@
 package mocks
 
 import (
-	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+	context "context"
+	iter "iter"
+
 	mock "github.com/stretchr/testify/mock"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
 )
 
 // MockAPISpecificationService is an autogenerated mock type for the APISpecificationService type
@@ -20,6 +24,69 @@ func (_m *MockAPISpecificationService) EXPECT() *MockAPISpecificationService_Exp
 	return &MockAPISpecificationService_Expecter{mock: &_m.Mock}
 }
 
+// All provides a mock function with given fields: ctx, options
+func (_m *MockAPISpecificationService) All(ctx context.Context, options ...readme.RequestOptions) iter.Seq2[readme.APISpecification, error] {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for All")
+	}
+
+	var r0 iter.Seq2[readme.APISpecification, error]
+	if rf, ok := ret.Get(0).(func(context.Context, ...readme.RequestOptions) iter.Seq2[readme.APISpecification, error]); ok {
+		r0 = rf(ctx, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[readme.APISpecification, error])
+		}
+	}
+
+	return r0
+}
+
+// MockAPISpecificationService_All_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'All'
+type MockAPISpecificationService_All_Call struct {
+	*mock.Call
+}
+
+// All is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options ...readme.RequestOptions
+func (_e *MockAPISpecificationService_Expecter) All(ctx interface{}, options ...interface{}) *MockAPISpecificationService_All_Call {
+	return &MockAPISpecificationService_All_Call{Call: _e.mock.On("All",
+		append([]interface{}{ctx}, options...)...)}
+}
+
+func (_c *MockAPISpecificationService_All_Call) Run(run func(ctx context.Context, options ...readme.RequestOptions)) *MockAPISpecificationService_All_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAPISpecificationService_All_Call) Return(_a0 iter.Seq2[readme.APISpecification, error]) *MockAPISpecificationService_All_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPISpecificationService_All_Call) RunAndReturn(run func(context.Context, ...readme.RequestOptions) iter.Seq2[readme.APISpecification, error]) *MockAPISpecificationService_All_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: definition, options
 func (_m *MockAPISpecificationService) Create(definition string, options ...readme.RequestOptions) (readme.APISpecificationSaved, *readme.APIResponse, error) {
 	_va := make([]interface{}, len(options))
@@ -100,6 +167,87 @@ func (_c *MockAPISpecificationService_Create_Call) RunAndReturn(run func(string,
 	return _c
 }
 
+// CreateWithContext provides a mock function with given fields: ctx, definition, options
+func (_m *MockAPISpecificationService) CreateWithContext(ctx context.Context, definition string, options ...readme.RequestOptions) (readme.APISpecificationSaved, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, definition)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithContext")
+	}
+
+	var r0 readme.APISpecificationSaved
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) (readme.APISpecificationSaved, *readme.APIResponse, error)); ok {
+		return rf(ctx, definition, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) readme.APISpecificationSaved); ok {
+		r0 = rf(ctx, definition, options...)
+	} else {
+		r0 = ret.Get(0).(readme.APISpecificationSaved)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...readme.RequestOptions) *readme.APIResponse); ok {
+		r1 = rf(ctx, definition, options...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, ...readme.RequestOptions) error); ok {
+		r2 = rf(ctx, definition, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAPISpecificationService_CreateWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithContext'
+type MockAPISpecificationService_CreateWithContext_Call struct {
+	*mock.Call
+}
+
+// CreateWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - definition string
+//   - options ...readme.RequestOptions
+func (_e *MockAPISpecificationService_Expecter) CreateWithContext(ctx interface{}, definition interface{}, options ...interface{}) *MockAPISpecificationService_CreateWithContext_Call {
+	return &MockAPISpecificationService_CreateWithContext_Call{Call: _e.mock.On("CreateWithContext",
+		append([]interface{}{ctx, definition}, options...)...)}
+}
+
+func (_c *MockAPISpecificationService_CreateWithContext_Call) Run(run func(ctx context.Context, definition string, options ...readme.RequestOptions)) *MockAPISpecificationService_CreateWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAPISpecificationService_CreateWithContext_Call) Return(_a0 readme.APISpecificationSaved, _a1 *readme.APIResponse, _a2 error) *MockAPISpecificationService_CreateWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAPISpecificationService_CreateWithContext_Call) RunAndReturn(run func(context.Context, string, ...readme.RequestOptions) (readme.APISpecificationSaved, *readme.APIResponse, error)) *MockAPISpecificationService_CreateWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: specID
 func (_m *MockAPISpecificationService) Delete(specID string) (bool, *readme.APIResponse, error) {
 	ret := _m.Called(specID)
@@ -165,6 +313,72 @@ func (_c *MockAPISpecificationService_Delete_Call) RunAndReturn(run func(string)
 	return _c
 }
 
+// DeleteWithContext provides a mock function with given fields: ctx, specID
+func (_m *MockAPISpecificationService) DeleteWithContext(ctx context.Context, specID string) (bool, *readme.APIResponse, error) {
+	ret := _m.Called(ctx, specID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWithContext")
+	}
+
+	var r0 bool
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, *readme.APIResponse, error)); ok {
+		return rf(ctx, specID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, specID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *readme.APIResponse); ok {
+		r1 = rf(ctx, specID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, specID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAPISpecificationService_DeleteWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWithContext'
+type MockAPISpecificationService_DeleteWithContext_Call struct {
+	*mock.Call
+}
+
+// DeleteWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - specID string
+func (_e *MockAPISpecificationService_Expecter) DeleteWithContext(ctx interface{}, specID interface{}) *MockAPISpecificationService_DeleteWithContext_Call {
+	return &MockAPISpecificationService_DeleteWithContext_Call{Call: _e.mock.On("DeleteWithContext", ctx, specID)}
+}
+
+func (_c *MockAPISpecificationService_DeleteWithContext_Call) Run(run func(ctx context.Context, specID string)) *MockAPISpecificationService_DeleteWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPISpecificationService_DeleteWithContext_Call) Return(_a0 bool, _a1 *readme.APIResponse, _a2 error) *MockAPISpecificationService_DeleteWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAPISpecificationService_DeleteWithContext_Call) RunAndReturn(run func(context.Context, string) (bool, *readme.APIResponse, error)) *MockAPISpecificationService_DeleteWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: specID, options
 func (_m *MockAPISpecificationService) Get(specID string, options ...readme.RequestOptions) (readme.APISpecification, *readme.APIResponse, error) {
 	_va := make([]interface{}, len(options))
@@ -325,6 +539,232 @@ func (_c *MockAPISpecificationService_GetAll_Call) RunAndReturn(run func(...read
 	return _c
 }
 
+// GetAllWithContext provides a mock function with given fields: ctx, options
+func (_m *MockAPISpecificationService) GetAllWithContext(ctx context.Context, options ...readme.RequestOptions) ([]readme.APISpecification, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllWithContext")
+	}
+
+	var r0 []readme.APISpecification
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...readme.RequestOptions) ([]readme.APISpecification, *readme.APIResponse, error)); ok {
+		return rf(ctx, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...readme.RequestOptions) []readme.APISpecification); ok {
+		r0 = rf(ctx, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]readme.APISpecification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...readme.RequestOptions) *readme.APIResponse); ok {
+		r1 = rf(ctx, options...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, ...readme.RequestOptions) error); ok {
+		r2 = rf(ctx, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAPISpecificationService_GetAllWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllWithContext'
+type MockAPISpecificationService_GetAllWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAllWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options ...readme.RequestOptions
+func (_e *MockAPISpecificationService_Expecter) GetAllWithContext(ctx interface{}, options ...interface{}) *MockAPISpecificationService_GetAllWithContext_Call {
+	return &MockAPISpecificationService_GetAllWithContext_Call{Call: _e.mock.On("GetAllWithContext",
+		append([]interface{}{ctx}, options...)...)}
+}
+
+func (_c *MockAPISpecificationService_GetAllWithContext_Call) Run(run func(ctx context.Context, options ...readme.RequestOptions)) *MockAPISpecificationService_GetAllWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAPISpecificationService_GetAllWithContext_Call) Return(_a0 []readme.APISpecification, _a1 *readme.APIResponse, _a2 error) *MockAPISpecificationService_GetAllWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAPISpecificationService_GetAllWithContext_Call) RunAndReturn(run func(context.Context, ...readme.RequestOptions) ([]readme.APISpecification, *readme.APIResponse, error)) *MockAPISpecificationService_GetAllWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWithContext provides a mock function with given fields: ctx, specID, options
+func (_m *MockAPISpecificationService) GetWithContext(ctx context.Context, specID string, options ...readme.RequestOptions) (readme.APISpecification, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, specID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithContext")
+	}
+
+	var r0 readme.APISpecification
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) (readme.APISpecification, *readme.APIResponse, error)); ok {
+		return rf(ctx, specID, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) readme.APISpecification); ok {
+		r0 = rf(ctx, specID, options...)
+	} else {
+		r0 = ret.Get(0).(readme.APISpecification)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...readme.RequestOptions) *readme.APIResponse); ok {
+		r1 = rf(ctx, specID, options...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, ...readme.RequestOptions) error); ok {
+		r2 = rf(ctx, specID, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAPISpecificationService_GetWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithContext'
+type MockAPISpecificationService_GetWithContext_Call struct {
+	*mock.Call
+}
+
+// GetWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - specID string
+//   - options ...readme.RequestOptions
+func (_e *MockAPISpecificationService_Expecter) GetWithContext(ctx interface{}, specID interface{}, options ...interface{}) *MockAPISpecificationService_GetWithContext_Call {
+	return &MockAPISpecificationService_GetWithContext_Call{Call: _e.mock.On("GetWithContext",
+		append([]interface{}{ctx, specID}, options...)...)}
+}
+
+func (_c *MockAPISpecificationService_GetWithContext_Call) Run(run func(ctx context.Context, specID string, options ...readme.RequestOptions)) *MockAPISpecificationService_GetWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAPISpecificationService_GetWithContext_Call) Return(_a0 readme.APISpecification, _a1 *readme.APIResponse, _a2 error) *MockAPISpecificationService_GetWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAPISpecificationService_GetWithContext_Call) RunAndReturn(run func(context.Context, string, ...readme.RequestOptions) (readme.APISpecification, *readme.APIResponse, error)) *MockAPISpecificationService_GetWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pages provides a mock function with given fields: ctx, options
+func (_m *MockAPISpecificationService) Pages(ctx context.Context, options ...readme.RequestOptions) iter.Seq2[readme.Page[readme.APISpecification], error] {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pages")
+	}
+
+	var r0 iter.Seq2[readme.Page[readme.APISpecification], error]
+	if rf, ok := ret.Get(0).(func(context.Context, ...readme.RequestOptions) iter.Seq2[readme.Page[readme.APISpecification], error]); ok {
+		r0 = rf(ctx, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[readme.Page[readme.APISpecification], error])
+		}
+	}
+
+	return r0
+}
+
+// MockAPISpecificationService_Pages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pages'
+type MockAPISpecificationService_Pages_Call struct {
+	*mock.Call
+}
+
+// Pages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options ...readme.RequestOptions
+func (_e *MockAPISpecificationService_Expecter) Pages(ctx interface{}, options ...interface{}) *MockAPISpecificationService_Pages_Call {
+	return &MockAPISpecificationService_Pages_Call{Call: _e.mock.On("Pages",
+		append([]interface{}{ctx}, options...)...)}
+}
+
+func (_c *MockAPISpecificationService_Pages_Call) Run(run func(ctx context.Context, options ...readme.RequestOptions)) *MockAPISpecificationService_Pages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAPISpecificationService_Pages_Call) Return(_a0 iter.Seq2[readme.Page[readme.APISpecification], error]) *MockAPISpecificationService_Pages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPISpecificationService_Pages_Call) RunAndReturn(run func(context.Context, ...readme.RequestOptions) iter.Seq2[readme.Page[readme.APISpecification], error]) *MockAPISpecificationService_Pages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: specID, definition
 func (_m *MockAPISpecificationService) Update(specID string, definition string) (readme.APISpecificationSaved, *readme.APIResponse, error) {
 	ret := _m.Called(specID, definition)
@@ -391,6 +831,73 @@ func (_c *MockAPISpecificationService_Update_Call) RunAndReturn(run func(string,
 	return _c
 }
 
+// UpdateWithContext provides a mock function with given fields: ctx, specID, definition
+func (_m *MockAPISpecificationService) UpdateWithContext(ctx context.Context, specID string, definition string) (readme.APISpecificationSaved, *readme.APIResponse, error) {
+	ret := _m.Called(ctx, specID, definition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWithContext")
+	}
+
+	var r0 readme.APISpecificationSaved
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (readme.APISpecificationSaved, *readme.APIResponse, error)); ok {
+		return rf(ctx, specID, definition)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) readme.APISpecificationSaved); ok {
+		r0 = rf(ctx, specID, definition)
+	} else {
+		r0 = ret.Get(0).(readme.APISpecificationSaved)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) *readme.APIResponse); ok {
+		r1 = rf(ctx, specID, definition)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, specID, definition)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAPISpecificationService_UpdateWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWithContext'
+type MockAPISpecificationService_UpdateWithContext_Call struct {
+	*mock.Call
+}
+
+// UpdateWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - specID string
+//   - definition string
+func (_e *MockAPISpecificationService_Expecter) UpdateWithContext(ctx interface{}, specID interface{}, definition interface{}) *MockAPISpecificationService_UpdateWithContext_Call {
+	return &MockAPISpecificationService_UpdateWithContext_Call{Call: _e.mock.On("UpdateWithContext", ctx, specID, definition)}
+}
+
+func (_c *MockAPISpecificationService_UpdateWithContext_Call) Run(run func(ctx context.Context, specID string, definition string)) *MockAPISpecificationService_UpdateWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPISpecificationService_UpdateWithContext_Call) Return(_a0 readme.APISpecificationSaved, _a1 *readme.APIResponse, _a2 error) *MockAPISpecificationService_UpdateWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAPISpecificationService_UpdateWithContext_Call) RunAndReturn(run func(context.Context, string, string) (readme.APISpecificationSaved, *readme.APIResponse, error)) *MockAPISpecificationService_UpdateWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UploadDefinition provides a mock function with given fields: method, content, url, version, response
 func (_m *MockAPISpecificationService) UploadDefinition(method string, content string, url string, version string, response interface{}) (interface{}, *readme.APIResponse, error) {
 	ret := _m.Called(method, content, url, version, response)
@@ -462,6 +969,78 @@ func (_c *MockAPISpecificationService_UploadDefinition_Call) RunAndReturn(run fu
 	return _c
 }
 
+// UploadDefinitionWithContext provides a mock function with given fields: ctx, method, content, url, version, response
+func (_m *MockAPISpecificationService) UploadDefinitionWithContext(ctx context.Context, method string, content string, url string, version string, response interface{}) (interface{}, *readme.APIResponse, error) {
+	ret := _m.Called(ctx, method, content, url, version, response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadDefinitionWithContext")
+	}
+
+	var r0 interface{}
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, interface{}) (interface{}, *readme.APIResponse, error)); ok {
+		return rf(ctx, method, content, url, version, response)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, interface{}) interface{}); ok {
+		r0 = rf(ctx, method, content, url, version, response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, interface{}) *readme.APIResponse); ok {
+		r1 = rf(ctx, method, content, url, version, response)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string, string, interface{}) error); ok {
+		r2 = rf(ctx, method, content, url, version, response)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAPISpecificationService_UploadDefinitionWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadDefinitionWithContext'
+type MockAPISpecificationService_UploadDefinitionWithContext_Call struct {
+	*mock.Call
+}
+
+// UploadDefinitionWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - method string
+//   - content string
+//   - url string
+//   - version string
+//   - response interface{}
+func (_e *MockAPISpecificationService_Expecter) UploadDefinitionWithContext(ctx interface{}, method interface{}, content interface{}, url interface{}, version interface{}, response interface{}) *MockAPISpecificationService_UploadDefinitionWithContext_Call {
+	return &MockAPISpecificationService_UploadDefinitionWithContext_Call{Call: _e.mock.On("UploadDefinitionWithContext", ctx, method, content, url, version, response)}
+}
+
+func (_c *MockAPISpecificationService_UploadDefinitionWithContext_Call) Run(run func(ctx context.Context, method string, content string, url string, version string, response interface{})) *MockAPISpecificationService_UploadDefinitionWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockAPISpecificationService_UploadDefinitionWithContext_Call) Return(_a0 interface{}, _a1 *readme.APIResponse, _a2 error) *MockAPISpecificationService_UploadDefinitionWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAPISpecificationService_UploadDefinitionWithContext_Call) RunAndReturn(run func(context.Context, string, string, string, string, interface{}) (interface{}, *readme.APIResponse, error)) *MockAPISpecificationService_UploadDefinitionWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockAPISpecificationService creates a new instance of MockAPISpecificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockAPISpecificationService(t interface {
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	readme "github.com/liveoaklabs/readme-api-go-client/readme"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -85,7 +87,73 @@ func (_c *MockApplyService_Apply_Call) RunAndReturn(run func(readme.Application)
 	return _c
 }
 
-// Get provides a mock function with given fields:
+// ApplyWithContext provides a mock function with given fields: ctx, application
+func (_m *MockApplyService) ApplyWithContext(ctx context.Context, application readme.Application) (readme.ApplyResponse, *readme.APIResponse, error) {
+	ret := _m.Called(ctx, application)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyWithContext")
+	}
+
+	var r0 readme.ApplyResponse
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, readme.Application) (readme.ApplyResponse, *readme.APIResponse, error)); ok {
+		return rf(ctx, application)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, readme.Application) readme.ApplyResponse); ok {
+		r0 = rf(ctx, application)
+	} else {
+		r0 = ret.Get(0).(readme.ApplyResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, readme.Application) *readme.APIResponse); ok {
+		r1 = rf(ctx, application)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, readme.Application) error); ok {
+		r2 = rf(ctx, application)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockApplyService_ApplyWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyWithContext'
+type MockApplyService_ApplyWithContext_Call struct {
+	*mock.Call
+}
+
+// ApplyWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - application readme.Application
+func (_e *MockApplyService_Expecter) ApplyWithContext(ctx interface{}, application interface{}) *MockApplyService_ApplyWithContext_Call {
+	return &MockApplyService_ApplyWithContext_Call{Call: _e.mock.On("ApplyWithContext", ctx, application)}
+}
+
+func (_c *MockApplyService_ApplyWithContext_Call) Run(run func(ctx context.Context, application readme.Application)) *MockApplyService_ApplyWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(readme.Application))
+	})
+	return _c
+}
+
+func (_c *MockApplyService_ApplyWithContext_Call) Return(_a0 readme.ApplyResponse, _a1 *readme.APIResponse, _a2 error) *MockApplyService_ApplyWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockApplyService_ApplyWithContext_Call) RunAndReturn(run func(context.Context, readme.Application) (readme.ApplyResponse, *readme.APIResponse, error)) *MockApplyService_ApplyWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with no fields
 func (_m *MockApplyService) Get() ([]readme.OpenRole, *readme.APIResponse, error) {
 	ret := _m.Called()
 
@@ -151,6 +219,73 @@ func (_c *MockApplyService_Get_Call) RunAndReturn(run func() ([]readme.OpenRole,
 	return _c
 }
 
+// GetWithContext provides a mock function with given fields: ctx
+func (_m *MockApplyService) GetWithContext(ctx context.Context) ([]readme.OpenRole, *readme.APIResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithContext")
+	}
+
+	var r0 []readme.OpenRole
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]readme.OpenRole, *readme.APIResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []readme.OpenRole); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]readme.OpenRole)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) *readme.APIResponse); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockApplyService_GetWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithContext'
+type MockApplyService_GetWithContext_Call struct {
+	*mock.Call
+}
+
+// GetWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockApplyService_Expecter) GetWithContext(ctx interface{}) *MockApplyService_GetWithContext_Call {
+	return &MockApplyService_GetWithContext_Call{Call: _e.mock.On("GetWithContext", ctx)}
+}
+
+func (_c *MockApplyService_GetWithContext_Call) Run(run func(ctx context.Context)) *MockApplyService_GetWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockApplyService_GetWithContext_Call) Return(_a0 []readme.OpenRole, _a1 *readme.APIResponse, _a2 error) *MockApplyService_GetWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockApplyService_GetWithContext_Call) RunAndReturn(run func(context.Context) ([]readme.OpenRole, *readme.APIResponse, error)) *MockApplyService_GetWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockApplyService creates a new instance of MockApplyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockApplyService(t interface {
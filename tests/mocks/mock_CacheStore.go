@@ -0,0 +1,160 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCacheStore is an autogenerated mock type for the CacheStore type
+type MockCacheStore struct {
+	mock.Mock
+}
+
+type MockCacheStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCacheStore) EXPECT() *MockCacheStore_Expecter {
+	return &MockCacheStore_Expecter{mock: &_m.Mock}
+}
+
+// DeletePrefix provides a mock function with given fields: prefix
+func (_m *MockCacheStore) DeletePrefix(prefix string) {
+	_m.Called(prefix)
+}
+
+// MockCacheStore_DeletePrefix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePrefix'
+type MockCacheStore_DeletePrefix_Call struct {
+	*mock.Call
+}
+
+// DeletePrefix is a helper method to define mock.On call
+//   - prefix string
+func (_e *MockCacheStore_Expecter) DeletePrefix(prefix interface{}) *MockCacheStore_DeletePrefix_Call {
+	return &MockCacheStore_DeletePrefix_Call{Call: _e.mock.On("DeletePrefix", prefix)}
+}
+
+func (_c *MockCacheStore_DeletePrefix_Call) Run(run func(prefix string)) *MockCacheStore_DeletePrefix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCacheStore_DeletePrefix_Call) Return() *MockCacheStore_DeletePrefix_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCacheStore_DeletePrefix_Call) RunAndReturn(run func(string)) *MockCacheStore_DeletePrefix_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: key
+func (_m *MockCacheStore) Get(key string) (*readme.CachedResponse, bool) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *readme.CachedResponse
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (*readme.CachedResponse, bool)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) *readme.CachedResponse); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*readme.CachedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockCacheStore_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockCacheStore_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - key string
+func (_e *MockCacheStore_Expecter) Get(key interface{}) *MockCacheStore_Get_Call {
+	return &MockCacheStore_Get_Call{Call: _e.mock.On("Get", key)}
+}
+
+func (_c *MockCacheStore_Get_Call) Run(run func(key string)) *MockCacheStore_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCacheStore_Get_Call) Return(_a0 *readme.CachedResponse, _a1 bool) *MockCacheStore_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCacheStore_Get_Call) RunAndReturn(run func(string) (*readme.CachedResponse, bool)) *MockCacheStore_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function with given fields: key, response
+func (_m *MockCacheStore) Set(key string, response *readme.CachedResponse) {
+	_m.Called(key, response)
+}
+
+// MockCacheStore_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type MockCacheStore_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - key string
+//   - response *readme.CachedResponse
+func (_e *MockCacheStore_Expecter) Set(key interface{}, response interface{}) *MockCacheStore_Set_Call {
+	return &MockCacheStore_Set_Call{Call: _e.mock.On("Set", key, response)}
+}
+
+func (_c *MockCacheStore_Set_Call) Run(run func(key string, response *readme.CachedResponse)) *MockCacheStore_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*readme.CachedResponse))
+	})
+	return _c
+}
+
+func (_c *MockCacheStore_Set_Call) Return() *MockCacheStore_Set_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCacheStore_Set_Call) RunAndReturn(run func(string, *readme.CachedResponse)) *MockCacheStore_Set_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockCacheStore creates a new instance of MockCacheStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCacheStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCacheStore {
+	mock := &MockCacheStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
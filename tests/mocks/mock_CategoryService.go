@@ -3,8 +3,12 @@
 package mocks
 
 import (
-	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+	context "context"
+	iter "iter"
+
 	mock "github.com/stretchr/testify/mock"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
 )
 
 // MockCategoryService is an autogenerated mock type for the CategoryService type
@@ -20,6 +24,69 @@ func (_m *MockCategoryService) EXPECT() *MockCategoryService_Expecter {
 	return &MockCategoryService_Expecter{mock: &_m.Mock}
 }
 
+// All provides a mock function with given fields: ctx, options
+func (_m *MockCategoryService) All(ctx context.Context, options ...readme.RequestOptions) iter.Seq2[readme.Category, error] {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for All")
+	}
+
+	var r0 iter.Seq2[readme.Category, error]
+	if rf, ok := ret.Get(0).(func(context.Context, ...readme.RequestOptions) iter.Seq2[readme.Category, error]); ok {
+		r0 = rf(ctx, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[readme.Category, error])
+		}
+	}
+
+	return r0
+}
+
+// MockCategoryService_All_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'All'
+type MockCategoryService_All_Call struct {
+	*mock.Call
+}
+
+// All is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options ...readme.RequestOptions
+func (_e *MockCategoryService_Expecter) All(ctx interface{}, options ...interface{}) *MockCategoryService_All_Call {
+	return &MockCategoryService_All_Call{Call: _e.mock.On("All",
+		append([]interface{}{ctx}, options...)...)}
+}
+
+func (_c *MockCategoryService_All_Call) Run(run func(ctx context.Context, options ...readme.RequestOptions)) *MockCategoryService_All_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_All_Call) Return(_a0 iter.Seq2[readme.Category, error]) *MockCategoryService_All_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCategoryService_All_Call) RunAndReturn(run func(context.Context, ...readme.RequestOptions) iter.Seq2[readme.Category, error]) *MockCategoryService_All_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: response, params, options
 func (_m *MockCategoryService) Create(response interface{}, params readme.CategoryParams, options ...readme.RequestOptions) (*readme.APIResponse, error) {
 	_va := make([]interface{}, len(options))
@@ -94,6 +161,81 @@ func (_c *MockCategoryService_Create_Call) RunAndReturn(run func(interface{}, re
 	return _c
 }
 
+// CreateWithContext provides a mock function with given fields: ctx, response, params, options
+func (_m *MockCategoryService) CreateWithContext(ctx context.Context, response interface{}, params readme.CategoryParams, options ...readme.RequestOptions) (*readme.APIResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, response, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithContext")
+	}
+
+	var r0 *readme.APIResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, readme.CategoryParams, ...readme.RequestOptions) (*readme.APIResponse, error)); ok {
+		return rf(ctx, response, params, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, readme.CategoryParams, ...readme.RequestOptions) *readme.APIResponse); ok {
+		r0 = rf(ctx, response, params, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, readme.CategoryParams, ...readme.RequestOptions) error); ok {
+		r1 = rf(ctx, response, params, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCategoryService_CreateWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithContext'
+type MockCategoryService_CreateWithContext_Call struct {
+	*mock.Call
+}
+
+// CreateWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - response interface{}
+//   - params readme.CategoryParams
+//   - options ...readme.RequestOptions
+func (_e *MockCategoryService_Expecter) CreateWithContext(ctx interface{}, response interface{}, params interface{}, options ...interface{}) *MockCategoryService_CreateWithContext_Call {
+	return &MockCategoryService_CreateWithContext_Call{Call: _e.mock.On("CreateWithContext",
+		append([]interface{}{ctx, response, params}, options...)...)}
+}
+
+func (_c *MockCategoryService_CreateWithContext_Call) Run(run func(ctx context.Context, response interface{}, params readme.CategoryParams, options ...readme.RequestOptions)) *MockCategoryService_CreateWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(readme.CategoryParams), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_CreateWithContext_Call) Return(_a0 *readme.APIResponse, _a1 error) *MockCategoryService_CreateWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCategoryService_CreateWithContext_Call) RunAndReturn(run func(context.Context, interface{}, readme.CategoryParams, ...readme.RequestOptions) (*readme.APIResponse, error)) *MockCategoryService_CreateWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: slug, options
 func (_m *MockCategoryService) Delete(slug string, options ...readme.RequestOptions) (bool, *readme.APIResponse, error) {
 	_va := make([]interface{}, len(options))
@@ -174,6 +316,87 @@ func (_c *MockCategoryService_Delete_Call) RunAndReturn(run func(string, ...read
 	return _c
 }
 
+// DeleteWithContext provides a mock function with given fields: ctx, slug, options
+func (_m *MockCategoryService) DeleteWithContext(ctx context.Context, slug string, options ...readme.RequestOptions) (bool, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, slug)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWithContext")
+	}
+
+	var r0 bool
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) (bool, *readme.APIResponse, error)); ok {
+		return rf(ctx, slug, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) bool); ok {
+		r0 = rf(ctx, slug, options...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...readme.RequestOptions) *readme.APIResponse); ok {
+		r1 = rf(ctx, slug, options...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, ...readme.RequestOptions) error); ok {
+		r2 = rf(ctx, slug, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCategoryService_DeleteWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWithContext'
+type MockCategoryService_DeleteWithContext_Call struct {
+	*mock.Call
+}
+
+// DeleteWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - options ...readme.RequestOptions
+func (_e *MockCategoryService_Expecter) DeleteWithContext(ctx interface{}, slug interface{}, options ...interface{}) *MockCategoryService_DeleteWithContext_Call {
+	return &MockCategoryService_DeleteWithContext_Call{Call: _e.mock.On("DeleteWithContext",
+		append([]interface{}{ctx, slug}, options...)...)}
+}
+
+func (_c *MockCategoryService_DeleteWithContext_Call) Run(run func(ctx context.Context, slug string, options ...readme.RequestOptions)) *MockCategoryService_DeleteWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_DeleteWithContext_Call) Return(_a0 bool, _a1 *readme.APIResponse, _a2 error) *MockCategoryService_DeleteWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCategoryService_DeleteWithContext_Call) RunAndReturn(run func(context.Context, string, ...readme.RequestOptions) (bool, *readme.APIResponse, error)) *MockCategoryService_DeleteWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: category, options
 func (_m *MockCategoryService) Get(category string, options ...readme.RequestOptions) (readme.Category, *readme.APIResponse, error) {
 	_va := make([]interface{}, len(options))
@@ -334,6 +557,88 @@ func (_c *MockCategoryService_GetAll_Call) RunAndReturn(run func(...readme.Reque
 	return _c
 }
 
+// GetAllWithContext provides a mock function with given fields: ctx, options
+func (_m *MockCategoryService) GetAllWithContext(ctx context.Context, options ...readme.RequestOptions) ([]readme.Category, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllWithContext")
+	}
+
+	var r0 []readme.Category
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...readme.RequestOptions) ([]readme.Category, *readme.APIResponse, error)); ok {
+		return rf(ctx, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...readme.RequestOptions) []readme.Category); ok {
+		r0 = rf(ctx, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]readme.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...readme.RequestOptions) *readme.APIResponse); ok {
+		r1 = rf(ctx, options...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, ...readme.RequestOptions) error); ok {
+		r2 = rf(ctx, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCategoryService_GetAllWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllWithContext'
+type MockCategoryService_GetAllWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAllWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options ...readme.RequestOptions
+func (_e *MockCategoryService_Expecter) GetAllWithContext(ctx interface{}, options ...interface{}) *MockCategoryService_GetAllWithContext_Call {
+	return &MockCategoryService_GetAllWithContext_Call{Call: _e.mock.On("GetAllWithContext",
+		append([]interface{}{ctx}, options...)...)}
+}
+
+func (_c *MockCategoryService_GetAllWithContext_Call) Run(run func(ctx context.Context, options ...readme.RequestOptions)) *MockCategoryService_GetAllWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_GetAllWithContext_Call) Return(_a0 []readme.Category, _a1 *readme.APIResponse, _a2 error) *MockCategoryService_GetAllWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCategoryService_GetAllWithContext_Call) RunAndReturn(run func(context.Context, ...readme.RequestOptions) ([]readme.Category, *readme.APIResponse, error)) *MockCategoryService_GetAllWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDocs provides a mock function with given fields: slug, options
 func (_m *MockCategoryService) GetDocs(slug string, options ...readme.RequestOptions) ([]readme.CategoryDocs, *readme.APIResponse, error) {
 	_va := make([]interface{}, len(options))
@@ -416,6 +721,233 @@ func (_c *MockCategoryService_GetDocs_Call) RunAndReturn(run func(string, ...rea
 	return _c
 }
 
+// GetDocsWithContext provides a mock function with given fields: ctx, slug, options
+func (_m *MockCategoryService) GetDocsWithContext(ctx context.Context, slug string, options ...readme.RequestOptions) ([]readme.CategoryDocs, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, slug)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDocsWithContext")
+	}
+
+	var r0 []readme.CategoryDocs
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) ([]readme.CategoryDocs, *readme.APIResponse, error)); ok {
+		return rf(ctx, slug, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) []readme.CategoryDocs); ok {
+		r0 = rf(ctx, slug, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]readme.CategoryDocs)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...readme.RequestOptions) *readme.APIResponse); ok {
+		r1 = rf(ctx, slug, options...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, ...readme.RequestOptions) error); ok {
+		r2 = rf(ctx, slug, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCategoryService_GetDocsWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDocsWithContext'
+type MockCategoryService_GetDocsWithContext_Call struct {
+	*mock.Call
+}
+
+// GetDocsWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - options ...readme.RequestOptions
+func (_e *MockCategoryService_Expecter) GetDocsWithContext(ctx interface{}, slug interface{}, options ...interface{}) *MockCategoryService_GetDocsWithContext_Call {
+	return &MockCategoryService_GetDocsWithContext_Call{Call: _e.mock.On("GetDocsWithContext",
+		append([]interface{}{ctx, slug}, options...)...)}
+}
+
+func (_c *MockCategoryService_GetDocsWithContext_Call) Run(run func(ctx context.Context, slug string, options ...readme.RequestOptions)) *MockCategoryService_GetDocsWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_GetDocsWithContext_Call) Return(_a0 []readme.CategoryDocs, _a1 *readme.APIResponse, _a2 error) *MockCategoryService_GetDocsWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCategoryService_GetDocsWithContext_Call) RunAndReturn(run func(context.Context, string, ...readme.RequestOptions) ([]readme.CategoryDocs, *readme.APIResponse, error)) *MockCategoryService_GetDocsWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWithContext provides a mock function with given fields: ctx, category, options
+func (_m *MockCategoryService) GetWithContext(ctx context.Context, category string, options ...readme.RequestOptions) (readme.Category, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, category)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithContext")
+	}
+
+	var r0 readme.Category
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) (readme.Category, *readme.APIResponse, error)); ok {
+		return rf(ctx, category, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) readme.Category); ok {
+		r0 = rf(ctx, category, options...)
+	} else {
+		r0 = ret.Get(0).(readme.Category)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...readme.RequestOptions) *readme.APIResponse); ok {
+		r1 = rf(ctx, category, options...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, ...readme.RequestOptions) error); ok {
+		r2 = rf(ctx, category, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCategoryService_GetWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithContext'
+type MockCategoryService_GetWithContext_Call struct {
+	*mock.Call
+}
+
+// GetWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category string
+//   - options ...readme.RequestOptions
+func (_e *MockCategoryService_Expecter) GetWithContext(ctx interface{}, category interface{}, options ...interface{}) *MockCategoryService_GetWithContext_Call {
+	return &MockCategoryService_GetWithContext_Call{Call: _e.mock.On("GetWithContext",
+		append([]interface{}{ctx, category}, options...)...)}
+}
+
+func (_c *MockCategoryService_GetWithContext_Call) Run(run func(ctx context.Context, category string, options ...readme.RequestOptions)) *MockCategoryService_GetWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_GetWithContext_Call) Return(_a0 readme.Category, _a1 *readme.APIResponse, _a2 error) *MockCategoryService_GetWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCategoryService_GetWithContext_Call) RunAndReturn(run func(context.Context, string, ...readme.RequestOptions) (readme.Category, *readme.APIResponse, error)) *MockCategoryService_GetWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pages provides a mock function with given fields: ctx, options
+func (_m *MockCategoryService) Pages(ctx context.Context, options ...readme.RequestOptions) iter.Seq2[readme.Page[readme.Category], error] {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pages")
+	}
+
+	var r0 iter.Seq2[readme.Page[readme.Category], error]
+	if rf, ok := ret.Get(0).(func(context.Context, ...readme.RequestOptions) iter.Seq2[readme.Page[readme.Category], error]); ok {
+		r0 = rf(ctx, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[readme.Page[readme.Category], error])
+		}
+	}
+
+	return r0
+}
+
+// MockCategoryService_Pages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pages'
+type MockCategoryService_Pages_Call struct {
+	*mock.Call
+}
+
+// Pages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options ...readme.RequestOptions
+func (_e *MockCategoryService_Expecter) Pages(ctx interface{}, options ...interface{}) *MockCategoryService_Pages_Call {
+	return &MockCategoryService_Pages_Call{Call: _e.mock.On("Pages",
+		append([]interface{}{ctx}, options...)...)}
+}
+
+func (_c *MockCategoryService_Pages_Call) Run(run func(ctx context.Context, options ...readme.RequestOptions)) *MockCategoryService_Pages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_Pages_Call) Return(_a0 iter.Seq2[readme.Page[readme.Category], error]) *MockCategoryService_Pages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCategoryService_Pages_Call) RunAndReturn(run func(context.Context, ...readme.RequestOptions) iter.Seq2[readme.Page[readme.Category], error]) *MockCategoryService_Pages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: slug, params, options
 func (_m *MockCategoryService) Update(slug string, params readme.CategoryParams, options ...readme.RequestOptions) (readme.Category, *readme.APIResponse, error) {
 	_va := make([]interface{}, len(options))
@@ -497,6 +1029,88 @@ func (_c *MockCategoryService_Update_Call) RunAndReturn(run func(string, readme.
 	return _c
 }
 
+// UpdateWithContext provides a mock function with given fields: ctx, slug, params, options
+func (_m *MockCategoryService) UpdateWithContext(ctx context.Context, slug string, params readme.CategoryParams, options ...readme.RequestOptions) (readme.Category, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, slug, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWithContext")
+	}
+
+	var r0 readme.Category
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, readme.CategoryParams, ...readme.RequestOptions) (readme.Category, *readme.APIResponse, error)); ok {
+		return rf(ctx, slug, params, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, readme.CategoryParams, ...readme.RequestOptions) readme.Category); ok {
+		r0 = rf(ctx, slug, params, options...)
+	} else {
+		r0 = ret.Get(0).(readme.Category)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, readme.CategoryParams, ...readme.RequestOptions) *readme.APIResponse); ok {
+		r1 = rf(ctx, slug, params, options...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, readme.CategoryParams, ...readme.RequestOptions) error); ok {
+		r2 = rf(ctx, slug, params, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCategoryService_UpdateWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWithContext'
+type MockCategoryService_UpdateWithContext_Call struct {
+	*mock.Call
+}
+
+// UpdateWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - params readme.CategoryParams
+//   - options ...readme.RequestOptions
+func (_e *MockCategoryService_Expecter) UpdateWithContext(ctx interface{}, slug interface{}, params interface{}, options ...interface{}) *MockCategoryService_UpdateWithContext_Call {
+	return &MockCategoryService_UpdateWithContext_Call{Call: _e.mock.On("UpdateWithContext",
+		append([]interface{}{ctx, slug, params}, options...)...)}
+}
+
+func (_c *MockCategoryService_UpdateWithContext_Call) Run(run func(ctx context.Context, slug string, params readme.CategoryParams, options ...readme.RequestOptions)) *MockCategoryService_UpdateWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(readme.CategoryParams), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockCategoryService_UpdateWithContext_Call) Return(_a0 readme.Category, _a1 *readme.APIResponse, _a2 error) *MockCategoryService_UpdateWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCategoryService_UpdateWithContext_Call) RunAndReturn(run func(context.Context, string, readme.CategoryParams, ...readme.RequestOptions) (readme.Category, *readme.APIResponse, error)) *MockCategoryService_UpdateWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockCategoryService creates a new instance of MockCategoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCategoryService(t interface {
@@ -3,8 +3,12 @@
 package mocks
 
 import (
-	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+	context "context"
+	iter "iter"
+
 	mock "github.com/stretchr/testify/mock"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
 )
 
 // MockCustomPageService is an autogenerated mock type for the CustomPageService type
@@ -20,6 +24,69 @@ func (_m *MockCustomPageService) EXPECT() *MockCustomPageService_Expecter {
 	return &MockCustomPageService_Expecter{mock: &_m.Mock}
 }
 
+// All provides a mock function with given fields: ctx, options
+func (_m *MockCustomPageService) All(ctx context.Context, options ...readme.RequestOptions) iter.Seq2[readme.CustomPage, error] {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for All")
+	}
+
+	var r0 iter.Seq2[readme.CustomPage, error]
+	if rf, ok := ret.Get(0).(func(context.Context, ...readme.RequestOptions) iter.Seq2[readme.CustomPage, error]); ok {
+		r0 = rf(ctx, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[readme.CustomPage, error])
+		}
+	}
+
+	return r0
+}
+
+// MockCustomPageService_All_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'All'
+type MockCustomPageService_All_Call struct {
+	*mock.Call
+}
+
+// All is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options ...readme.RequestOptions
+func (_e *MockCustomPageService_Expecter) All(ctx interface{}, options ...interface{}) *MockCustomPageService_All_Call {
+	return &MockCustomPageService_All_Call{Call: _e.mock.On("All",
+		append([]interface{}{ctx}, options...)...)}
+}
+
+func (_c *MockCustomPageService_All_Call) Run(run func(ctx context.Context, options ...readme.RequestOptions)) *MockCustomPageService_All_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockCustomPageService_All_Call) Return(_a0 iter.Seq2[readme.CustomPage, error]) *MockCustomPageService_All_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCustomPageService_All_Call) RunAndReturn(run func(context.Context, ...readme.RequestOptions) iter.Seq2[readme.CustomPage, error]) *MockCustomPageService_All_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: params
 func (_m *MockCustomPageService) Create(params readme.CustomPageParams) (readme.CustomPage, *readme.APIResponse, error) {
 	ret := _m.Called(params)
@@ -85,6 +152,72 @@ func (_c *MockCustomPageService_Create_Call) RunAndReturn(run func(readme.Custom
 	return _c
 }
 
+// CreateWithContext provides a mock function with given fields: ctx, params
+func (_m *MockCustomPageService) CreateWithContext(ctx context.Context, params readme.CustomPageParams) (readme.CustomPage, *readme.APIResponse, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithContext")
+	}
+
+	var r0 readme.CustomPage
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, readme.CustomPageParams) (readme.CustomPage, *readme.APIResponse, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, readme.CustomPageParams) readme.CustomPage); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(readme.CustomPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, readme.CustomPageParams) *readme.APIResponse); ok {
+		r1 = rf(ctx, params)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, readme.CustomPageParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCustomPageService_CreateWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithContext'
+type MockCustomPageService_CreateWithContext_Call struct {
+	*mock.Call
+}
+
+// CreateWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params readme.CustomPageParams
+func (_e *MockCustomPageService_Expecter) CreateWithContext(ctx interface{}, params interface{}) *MockCustomPageService_CreateWithContext_Call {
+	return &MockCustomPageService_CreateWithContext_Call{Call: _e.mock.On("CreateWithContext", ctx, params)}
+}
+
+func (_c *MockCustomPageService_CreateWithContext_Call) Run(run func(ctx context.Context, params readme.CustomPageParams)) *MockCustomPageService_CreateWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(readme.CustomPageParams))
+	})
+	return _c
+}
+
+func (_c *MockCustomPageService_CreateWithContext_Call) Return(_a0 readme.CustomPage, _a1 *readme.APIResponse, _a2 error) *MockCustomPageService_CreateWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCustomPageService_CreateWithContext_Call) RunAndReturn(run func(context.Context, readme.CustomPageParams) (readme.CustomPage, *readme.APIResponse, error)) *MockCustomPageService_CreateWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: slug
 func (_m *MockCustomPageService) Delete(slug string) (bool, *readme.APIResponse, error) {
 	ret := _m.Called(slug)
@@ -150,6 +283,72 @@ func (_c *MockCustomPageService_Delete_Call) RunAndReturn(run func(string) (bool
 	return _c
 }
 
+// DeleteWithContext provides a mock function with given fields: ctx, slug
+func (_m *MockCustomPageService) DeleteWithContext(ctx context.Context, slug string) (bool, *readme.APIResponse, error) {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWithContext")
+	}
+
+	var r0 bool
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, *readme.APIResponse, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *readme.APIResponse); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, slug)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCustomPageService_DeleteWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWithContext'
+type MockCustomPageService_DeleteWithContext_Call struct {
+	*mock.Call
+}
+
+// DeleteWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockCustomPageService_Expecter) DeleteWithContext(ctx interface{}, slug interface{}) *MockCustomPageService_DeleteWithContext_Call {
+	return &MockCustomPageService_DeleteWithContext_Call{Call: _e.mock.On("DeleteWithContext", ctx, slug)}
+}
+
+func (_c *MockCustomPageService_DeleteWithContext_Call) Run(run func(ctx context.Context, slug string)) *MockCustomPageService_DeleteWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCustomPageService_DeleteWithContext_Call) Return(_a0 bool, _a1 *readme.APIResponse, _a2 error) *MockCustomPageService_DeleteWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCustomPageService_DeleteWithContext_Call) RunAndReturn(run func(context.Context, string) (bool, *readme.APIResponse, error)) *MockCustomPageService_DeleteWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: slug
 func (_m *MockCustomPageService) Get(slug string) (readme.CustomPage, *readme.APIResponse, error) {
 	ret := _m.Called(slug)
@@ -295,6 +494,217 @@ func (_c *MockCustomPageService_GetAll_Call) RunAndReturn(run func(...readme.Req
 	return _c
 }
 
+// GetAllWithContext provides a mock function with given fields: ctx, options
+func (_m *MockCustomPageService) GetAllWithContext(ctx context.Context, options ...readme.RequestOptions) ([]readme.CustomPage, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllWithContext")
+	}
+
+	var r0 []readme.CustomPage
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...readme.RequestOptions) ([]readme.CustomPage, *readme.APIResponse, error)); ok {
+		return rf(ctx, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...readme.RequestOptions) []readme.CustomPage); ok {
+		r0 = rf(ctx, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]readme.CustomPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...readme.RequestOptions) *readme.APIResponse); ok {
+		r1 = rf(ctx, options...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, ...readme.RequestOptions) error); ok {
+		r2 = rf(ctx, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCustomPageService_GetAllWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllWithContext'
+type MockCustomPageService_GetAllWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAllWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options ...readme.RequestOptions
+func (_e *MockCustomPageService_Expecter) GetAllWithContext(ctx interface{}, options ...interface{}) *MockCustomPageService_GetAllWithContext_Call {
+	return &MockCustomPageService_GetAllWithContext_Call{Call: _e.mock.On("GetAllWithContext",
+		append([]interface{}{ctx}, options...)...)}
+}
+
+func (_c *MockCustomPageService_GetAllWithContext_Call) Run(run func(ctx context.Context, options ...readme.RequestOptions)) *MockCustomPageService_GetAllWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockCustomPageService_GetAllWithContext_Call) Return(_a0 []readme.CustomPage, _a1 *readme.APIResponse, _a2 error) *MockCustomPageService_GetAllWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCustomPageService_GetAllWithContext_Call) RunAndReturn(run func(context.Context, ...readme.RequestOptions) ([]readme.CustomPage, *readme.APIResponse, error)) *MockCustomPageService_GetAllWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWithContext provides a mock function with given fields: ctx, slug
+func (_m *MockCustomPageService) GetWithContext(ctx context.Context, slug string) (readme.CustomPage, *readme.APIResponse, error) {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithContext")
+	}
+
+	var r0 readme.CustomPage
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (readme.CustomPage, *readme.APIResponse, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) readme.CustomPage); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Get(0).(readme.CustomPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *readme.APIResponse); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, slug)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCustomPageService_GetWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithContext'
+type MockCustomPageService_GetWithContext_Call struct {
+	*mock.Call
+}
+
+// GetWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockCustomPageService_Expecter) GetWithContext(ctx interface{}, slug interface{}) *MockCustomPageService_GetWithContext_Call {
+	return &MockCustomPageService_GetWithContext_Call{Call: _e.mock.On("GetWithContext", ctx, slug)}
+}
+
+func (_c *MockCustomPageService_GetWithContext_Call) Run(run func(ctx context.Context, slug string)) *MockCustomPageService_GetWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCustomPageService_GetWithContext_Call) Return(_a0 readme.CustomPage, _a1 *readme.APIResponse, _a2 error) *MockCustomPageService_GetWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCustomPageService_GetWithContext_Call) RunAndReturn(run func(context.Context, string) (readme.CustomPage, *readme.APIResponse, error)) *MockCustomPageService_GetWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pages provides a mock function with given fields: ctx, options
+func (_m *MockCustomPageService) Pages(ctx context.Context, options ...readme.RequestOptions) iter.Seq2[readme.Page[readme.CustomPage], error] {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pages")
+	}
+
+	var r0 iter.Seq2[readme.Page[readme.CustomPage], error]
+	if rf, ok := ret.Get(0).(func(context.Context, ...readme.RequestOptions) iter.Seq2[readme.Page[readme.CustomPage], error]); ok {
+		r0 = rf(ctx, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[readme.Page[readme.CustomPage], error])
+		}
+	}
+
+	return r0
+}
+
+// MockCustomPageService_Pages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pages'
+type MockCustomPageService_Pages_Call struct {
+	*mock.Call
+}
+
+// Pages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options ...readme.RequestOptions
+func (_e *MockCustomPageService_Expecter) Pages(ctx interface{}, options ...interface{}) *MockCustomPageService_Pages_Call {
+	return &MockCustomPageService_Pages_Call{Call: _e.mock.On("Pages",
+		append([]interface{}{ctx}, options...)...)}
+}
+
+func (_c *MockCustomPageService_Pages_Call) Run(run func(ctx context.Context, options ...readme.RequestOptions)) *MockCustomPageService_Pages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockCustomPageService_Pages_Call) Return(_a0 iter.Seq2[readme.Page[readme.CustomPage], error]) *MockCustomPageService_Pages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCustomPageService_Pages_Call) RunAndReturn(run func(context.Context, ...readme.RequestOptions) iter.Seq2[readme.Page[readme.CustomPage], error]) *MockCustomPageService_Pages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: slug, params
 func (_m *MockCustomPageService) Update(slug string, params readme.CustomPageParams) (readme.CustomPage, *readme.APIResponse, error) {
 	ret := _m.Called(slug, params)
@@ -361,6 +771,73 @@ func (_c *MockCustomPageService_Update_Call) RunAndReturn(run func(string, readm
 	return _c
 }
 
+// UpdateWithContext provides a mock function with given fields: ctx, slug, params
+func (_m *MockCustomPageService) UpdateWithContext(ctx context.Context, slug string, params readme.CustomPageParams) (readme.CustomPage, *readme.APIResponse, error) {
+	ret := _m.Called(ctx, slug, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWithContext")
+	}
+
+	var r0 readme.CustomPage
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, readme.CustomPageParams) (readme.CustomPage, *readme.APIResponse, error)); ok {
+		return rf(ctx, slug, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, readme.CustomPageParams) readme.CustomPage); ok {
+		r0 = rf(ctx, slug, params)
+	} else {
+		r0 = ret.Get(0).(readme.CustomPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, readme.CustomPageParams) *readme.APIResponse); ok {
+		r1 = rf(ctx, slug, params)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, readme.CustomPageParams) error); ok {
+		r2 = rf(ctx, slug, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCustomPageService_UpdateWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWithContext'
+type MockCustomPageService_UpdateWithContext_Call struct {
+	*mock.Call
+}
+
+// UpdateWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - params readme.CustomPageParams
+func (_e *MockCustomPageService_Expecter) UpdateWithContext(ctx interface{}, slug interface{}, params interface{}) *MockCustomPageService_UpdateWithContext_Call {
+	return &MockCustomPageService_UpdateWithContext_Call{Call: _e.mock.On("UpdateWithContext", ctx, slug, params)}
+}
+
+func (_c *MockCustomPageService_UpdateWithContext_Call) Run(run func(ctx context.Context, slug string, params readme.CustomPageParams)) *MockCustomPageService_UpdateWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(readme.CustomPageParams))
+	})
+	return _c
+}
+
+func (_c *MockCustomPageService_UpdateWithContext_Call) Return(_a0 readme.CustomPage, _a1 *readme.APIResponse, _a2 error) *MockCustomPageService_UpdateWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCustomPageService_UpdateWithContext_Call) RunAndReturn(run func(context.Context, string, readme.CustomPageParams) (readme.CustomPage, *readme.APIResponse, error)) *MockCustomPageService_UpdateWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockCustomPageService creates a new instance of MockCustomPageService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCustomPageService(t interface {
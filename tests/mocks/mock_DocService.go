@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	readme "github.com/liveoaklabs/readme-api-go-client/readme"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -100,6 +102,87 @@ func (_c *MockDocService_Create_Call) RunAndReturn(run func(readme.DocParams, ..
 	return _c
 }
 
+// CreateWithContext provides a mock function with given fields: ctx, params, options
+func (_m *MockDocService) CreateWithContext(ctx context.Context, params readme.DocParams, options ...readme.RequestOptions) (readme.Doc, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithContext")
+	}
+
+	var r0 readme.Doc
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, readme.DocParams, ...readme.RequestOptions) (readme.Doc, *readme.APIResponse, error)); ok {
+		return rf(ctx, params, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, readme.DocParams, ...readme.RequestOptions) readme.Doc); ok {
+		r0 = rf(ctx, params, options...)
+	} else {
+		r0 = ret.Get(0).(readme.Doc)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, readme.DocParams, ...readme.RequestOptions) *readme.APIResponse); ok {
+		r1 = rf(ctx, params, options...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, readme.DocParams, ...readme.RequestOptions) error); ok {
+		r2 = rf(ctx, params, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDocService_CreateWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithContext'
+type MockDocService_CreateWithContext_Call struct {
+	*mock.Call
+}
+
+// CreateWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params readme.DocParams
+//   - options ...readme.RequestOptions
+func (_e *MockDocService_Expecter) CreateWithContext(ctx interface{}, params interface{}, options ...interface{}) *MockDocService_CreateWithContext_Call {
+	return &MockDocService_CreateWithContext_Call{Call: _e.mock.On("CreateWithContext",
+		append([]interface{}{ctx, params}, options...)...)}
+}
+
+func (_c *MockDocService_CreateWithContext_Call) Run(run func(ctx context.Context, params readme.DocParams, options ...readme.RequestOptions)) *MockDocService_CreateWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(readme.DocParams), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDocService_CreateWithContext_Call) Return(_a0 readme.Doc, _a1 *readme.APIResponse, _a2 error) *MockDocService_CreateWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockDocService_CreateWithContext_Call) RunAndReturn(run func(context.Context, readme.DocParams, ...readme.RequestOptions) (readme.Doc, *readme.APIResponse, error)) *MockDocService_CreateWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: slug, options
 func (_m *MockDocService) Delete(slug string, options ...readme.RequestOptions) (bool, *readme.APIResponse, error) {
 	_va := make([]interface{}, len(options))
@@ -180,6 +263,87 @@ func (_c *MockDocService_Delete_Call) RunAndReturn(run func(string, ...readme.Re
 	return _c
 }
 
+// DeleteWithContext provides a mock function with given fields: ctx, slug, options
+func (_m *MockDocService) DeleteWithContext(ctx context.Context, slug string, options ...readme.RequestOptions) (bool, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, slug)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWithContext")
+	}
+
+	var r0 bool
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) (bool, *readme.APIResponse, error)); ok {
+		return rf(ctx, slug, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) bool); ok {
+		r0 = rf(ctx, slug, options...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...readme.RequestOptions) *readme.APIResponse); ok {
+		r1 = rf(ctx, slug, options...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, ...readme.RequestOptions) error); ok {
+		r2 = rf(ctx, slug, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDocService_DeleteWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWithContext'
+type MockDocService_DeleteWithContext_Call struct {
+	*mock.Call
+}
+
+// DeleteWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - options ...readme.RequestOptions
+func (_e *MockDocService_Expecter) DeleteWithContext(ctx interface{}, slug interface{}, options ...interface{}) *MockDocService_DeleteWithContext_Call {
+	return &MockDocService_DeleteWithContext_Call{Call: _e.mock.On("DeleteWithContext",
+		append([]interface{}{ctx, slug}, options...)...)}
+}
+
+func (_c *MockDocService_DeleteWithContext_Call) Run(run func(ctx context.Context, slug string, options ...readme.RequestOptions)) *MockDocService_DeleteWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDocService_DeleteWithContext_Call) Return(_a0 bool, _a1 *readme.APIResponse, _a2 error) *MockDocService_DeleteWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockDocService_DeleteWithContext_Call) RunAndReturn(run func(context.Context, string, ...readme.RequestOptions) (bool, *readme.APIResponse, error)) *MockDocService_DeleteWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: doc, options
 func (_m *MockDocService) Get(doc string, options ...readme.RequestOptions) (readme.Doc, *readme.APIResponse, error) {
 	_va := make([]interface{}, len(options))
@@ -260,6 +424,87 @@ func (_c *MockDocService_Get_Call) RunAndReturn(run func(string, ...readme.Reque
 	return _c
 }
 
+// GetWithContext provides a mock function with given fields: ctx, doc, options
+func (_m *MockDocService) GetWithContext(ctx context.Context, doc string, options ...readme.RequestOptions) (readme.Doc, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, doc)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithContext")
+	}
+
+	var r0 readme.Doc
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) (readme.Doc, *readme.APIResponse, error)); ok {
+		return rf(ctx, doc, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) readme.Doc); ok {
+		r0 = rf(ctx, doc, options...)
+	} else {
+		r0 = ret.Get(0).(readme.Doc)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...readme.RequestOptions) *readme.APIResponse); ok {
+		r1 = rf(ctx, doc, options...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, ...readme.RequestOptions) error); ok {
+		r2 = rf(ctx, doc, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDocService_GetWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithContext'
+type MockDocService_GetWithContext_Call struct {
+	*mock.Call
+}
+
+// GetWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - doc string
+//   - options ...readme.RequestOptions
+func (_e *MockDocService_Expecter) GetWithContext(ctx interface{}, doc interface{}, options ...interface{}) *MockDocService_GetWithContext_Call {
+	return &MockDocService_GetWithContext_Call{Call: _e.mock.On("GetWithContext",
+		append([]interface{}{ctx, doc}, options...)...)}
+}
+
+func (_c *MockDocService_GetWithContext_Call) Run(run func(ctx context.Context, doc string, options ...readme.RequestOptions)) *MockDocService_GetWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDocService_GetWithContext_Call) Return(_a0 readme.Doc, _a1 *readme.APIResponse, _a2 error) *MockDocService_GetWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockDocService_GetWithContext_Call) RunAndReturn(run func(context.Context, string, ...readme.RequestOptions) (readme.Doc, *readme.APIResponse, error)) *MockDocService_GetWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Search provides a mock function with given fields: query, options
 func (_m *MockDocService) Search(query string, options ...readme.RequestOptions) ([]readme.DocSearchResult, *readme.APIResponse, error) {
 	_va := make([]interface{}, len(options))
@@ -342,6 +587,89 @@ func (_c *MockDocService_Search_Call) RunAndReturn(run func(string, ...readme.Re
 	return _c
 }
 
+// SearchWithContext provides a mock function with given fields: ctx, query, options
+func (_m *MockDocService) SearchWithContext(ctx context.Context, query string, options ...readme.RequestOptions) ([]readme.DocSearchResult, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, query)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchWithContext")
+	}
+
+	var r0 []readme.DocSearchResult
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) ([]readme.DocSearchResult, *readme.APIResponse, error)); ok {
+		return rf(ctx, query, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...readme.RequestOptions) []readme.DocSearchResult); ok {
+		r0 = rf(ctx, query, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]readme.DocSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...readme.RequestOptions) *readme.APIResponse); ok {
+		r1 = rf(ctx, query, options...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, ...readme.RequestOptions) error); ok {
+		r2 = rf(ctx, query, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDocService_SearchWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchWithContext'
+type MockDocService_SearchWithContext_Call struct {
+	*mock.Call
+}
+
+// SearchWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - options ...readme.RequestOptions
+func (_e *MockDocService_Expecter) SearchWithContext(ctx interface{}, query interface{}, options ...interface{}) *MockDocService_SearchWithContext_Call {
+	return &MockDocService_SearchWithContext_Call{Call: _e.mock.On("SearchWithContext",
+		append([]interface{}{ctx, query}, options...)...)}
+}
+
+func (_c *MockDocService_SearchWithContext_Call) Run(run func(ctx context.Context, query string, options ...readme.RequestOptions)) *MockDocService_SearchWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDocService_SearchWithContext_Call) Return(_a0 []readme.DocSearchResult, _a1 *readme.APIResponse, _a2 error) *MockDocService_SearchWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockDocService_SearchWithContext_Call) RunAndReturn(run func(context.Context, string, ...readme.RequestOptions) ([]readme.DocSearchResult, *readme.APIResponse, error)) *MockDocService_SearchWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: slug, params, options
 func (_m *MockDocService) Update(slug string, params readme.DocParams, options ...readme.RequestOptions) (readme.Doc, *readme.APIResponse, error) {
 	_va := make([]interface{}, len(options))
@@ -423,6 +751,88 @@ func (_c *MockDocService_Update_Call) RunAndReturn(run func(string, readme.DocPa
 	return _c
 }
 
+// UpdateWithContext provides a mock function with given fields: ctx, slug, params, options
+func (_m *MockDocService) UpdateWithContext(ctx context.Context, slug string, params readme.DocParams, options ...readme.RequestOptions) (readme.Doc, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, slug, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWithContext")
+	}
+
+	var r0 readme.Doc
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, readme.DocParams, ...readme.RequestOptions) (readme.Doc, *readme.APIResponse, error)); ok {
+		return rf(ctx, slug, params, options...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, readme.DocParams, ...readme.RequestOptions) readme.Doc); ok {
+		r0 = rf(ctx, slug, params, options...)
+	} else {
+		r0 = ret.Get(0).(readme.Doc)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, readme.DocParams, ...readme.RequestOptions) *readme.APIResponse); ok {
+		r1 = rf(ctx, slug, params, options...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, readme.DocParams, ...readme.RequestOptions) error); ok {
+		r2 = rf(ctx, slug, params, options...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDocService_UpdateWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWithContext'
+type MockDocService_UpdateWithContext_Call struct {
+	*mock.Call
+}
+
+// UpdateWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - params readme.DocParams
+//   - options ...readme.RequestOptions
+func (_e *MockDocService_Expecter) UpdateWithContext(ctx interface{}, slug interface{}, params interface{}, options ...interface{}) *MockDocService_UpdateWithContext_Call {
+	return &MockDocService_UpdateWithContext_Call{Call: _e.mock.On("UpdateWithContext",
+		append([]interface{}{ctx, slug, params}, options...)...)}
+}
+
+func (_c *MockDocService_UpdateWithContext_Call) Run(run func(ctx context.Context, slug string, params readme.DocParams, options ...readme.RequestOptions)) *MockDocService_UpdateWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]readme.RequestOptions, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(readme.RequestOptions)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(readme.DocParams), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDocService_UpdateWithContext_Call) Return(_a0 readme.Doc, _a1 *readme.APIResponse, _a2 error) *MockDocService_UpdateWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockDocService_UpdateWithContext_Call) RunAndReturn(run func(context.Context, string, readme.DocParams, ...readme.RequestOptions) (readme.Doc, *readme.APIResponse, error)) *MockDocService_UpdateWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockDocService creates a new instance of MockDocService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockDocService(t interface {
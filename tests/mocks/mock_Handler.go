@@ -0,0 +1,99 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// MockHandler is an autogenerated mock type for the Handler type
+type MockHandler struct {
+	mock.Mock
+}
+
+type MockHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockHandler) EXPECT() *MockHandler_Expecter {
+	return &MockHandler_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: ctx, request, httpRequest
+func (_m *MockHandler) Execute(ctx context.Context, request *readme.APIRequest, httpRequest *http.Request) (*readme.APIResponse, error) {
+	ret := _m.Called(ctx, request, httpRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *readme.APIResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *readme.APIRequest, *http.Request) (*readme.APIResponse, error)); ok {
+		return rf(ctx, request, httpRequest)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *readme.APIRequest, *http.Request) *readme.APIResponse); ok {
+		r0 = rf(ctx, request, httpRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *readme.APIRequest, *http.Request) error); ok {
+		r1 = rf(ctx, request, httpRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockHandler_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockHandler_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *readme.APIRequest
+//   - httpRequest *http.Request
+func (_e *MockHandler_Expecter) Execute(ctx interface{}, request interface{}, httpRequest interface{}) *MockHandler_Execute_Call {
+	return &MockHandler_Execute_Call{Call: _e.mock.On("Execute", ctx, request, httpRequest)}
+}
+
+func (_c *MockHandler_Execute_Call) Run(run func(ctx context.Context, request *readme.APIRequest, httpRequest *http.Request)) *MockHandler_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*readme.APIRequest), args[2].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *MockHandler_Execute_Call) Return(_a0 *readme.APIResponse, _a1 error) *MockHandler_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockHandler_Execute_Call) RunAndReturn(run func(context.Context, *readme.APIRequest, *http.Request) (*readme.APIResponse, error)) *MockHandler_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockHandler creates a new instance of MockHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockHandler {
+	mock := &MockHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	readme "github.com/liveoaklabs/readme-api-go-client/readme"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -100,6 +102,87 @@ func (_c *MockImageService_Upload_Call) RunAndReturn(run func([]byte, ...string)
 	return _c
 }
 
+// UploadWithContext provides a mock function with given fields: ctx, source, filename
+func (_m *MockImageService) UploadWithContext(ctx context.Context, source []byte, filename ...string) (readme.Image, *readme.APIResponse, error) {
+	_va := make([]interface{}, len(filename))
+	for _i := range filename {
+		_va[_i] = filename[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, source)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadWithContext")
+	}
+
+	var r0 readme.Image
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, ...string) (readme.Image, *readme.APIResponse, error)); ok {
+		return rf(ctx, source, filename...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, ...string) readme.Image); ok {
+		r0 = rf(ctx, source, filename...)
+	} else {
+		r0 = ret.Get(0).(readme.Image)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, ...string) *readme.APIResponse); ok {
+		r1 = rf(ctx, source, filename...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []byte, ...string) error); ok {
+		r2 = rf(ctx, source, filename...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockImageService_UploadWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadWithContext'
+type MockImageService_UploadWithContext_Call struct {
+	*mock.Call
+}
+
+// UploadWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source []byte
+//   - filename ...string
+func (_e *MockImageService_Expecter) UploadWithContext(ctx interface{}, source interface{}, filename ...interface{}) *MockImageService_UploadWithContext_Call {
+	return &MockImageService_UploadWithContext_Call{Call: _e.mock.On("UploadWithContext",
+		append([]interface{}{ctx, source}, filename...)...)}
+}
+
+func (_c *MockImageService_UploadWithContext_Call) Run(run func(ctx context.Context, source []byte, filename ...string)) *MockImageService_UploadWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), args[1].([]byte), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockImageService_UploadWithContext_Call) Return(_a0 readme.Image, _a1 *readme.APIResponse, _a2 error) *MockImageService_UploadWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockImageService_UploadWithContext_Call) RunAndReturn(run func(context.Context, []byte, ...string) (readme.Image, *readme.APIResponse, error)) *MockImageService_UploadWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockImageService creates a new instance of MockImageService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockImageService(t interface {
@@ -0,0 +1,71 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockMetricsRecorder is an autogenerated mock type for the MetricsRecorder type
+type MockMetricsRecorder struct {
+	mock.Mock
+}
+
+type MockMetricsRecorder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMetricsRecorder) EXPECT() *MockMetricsRecorder_Expecter {
+	return &MockMetricsRecorder_Expecter{mock: &_m.Mock}
+}
+
+// RecordRequest provides a mock function with given fields: ctx, metrics
+func (_m *MockMetricsRecorder) RecordRequest(ctx context.Context, metrics readme.RequestMetrics) {
+	_m.Called(ctx, metrics)
+}
+
+// MockMetricsRecorder_RecordRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordRequest'
+type MockMetricsRecorder_RecordRequest_Call struct {
+	*mock.Call
+}
+
+// RecordRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - metrics readme.RequestMetrics
+func (_e *MockMetricsRecorder_Expecter) RecordRequest(ctx interface{}, metrics interface{}) *MockMetricsRecorder_RecordRequest_Call {
+	return &MockMetricsRecorder_RecordRequest_Call{Call: _e.mock.On("RecordRequest", ctx, metrics)}
+}
+
+func (_c *MockMetricsRecorder_RecordRequest_Call) Run(run func(ctx context.Context, metrics readme.RequestMetrics)) *MockMetricsRecorder_RecordRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(readme.RequestMetrics))
+	})
+	return _c
+}
+
+func (_c *MockMetricsRecorder_RecordRequest_Call) Return() *MockMetricsRecorder_RecordRequest_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMetricsRecorder_RecordRequest_Call) RunAndReturn(run func(context.Context, readme.RequestMetrics)) *MockMetricsRecorder_RecordRequest_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockMetricsRecorder creates a new instance of MockMetricsRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMetricsRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMetricsRecorder {
+	mock := &MockMetricsRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
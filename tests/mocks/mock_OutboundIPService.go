@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	readme "github.com/liveoaklabs/readme-api-go-client/readme"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -20,7 +22,7 @@ func (_m *MockOutboundIPService) EXPECT() *MockOutboundIPService_Expecter {
 	return &MockOutboundIPService_Expecter{mock: &_m.Mock}
 }
 
-// Get provides a mock function with given fields:
+// Get provides a mock function with no fields
 func (_m *MockOutboundIPService) Get() ([]readme.OutboundIP, *readme.APIResponse, error) {
 	ret := _m.Called()
 
@@ -86,6 +88,73 @@ func (_c *MockOutboundIPService_Get_Call) RunAndReturn(run func() ([]readme.Outb
 	return _c
 }
 
+// GetWithContext provides a mock function with given fields: ctx
+func (_m *MockOutboundIPService) GetWithContext(ctx context.Context) ([]readme.OutboundIP, *readme.APIResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithContext")
+	}
+
+	var r0 []readme.OutboundIP
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]readme.OutboundIP, *readme.APIResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []readme.OutboundIP); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]readme.OutboundIP)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) *readme.APIResponse); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockOutboundIPService_GetWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithContext'
+type MockOutboundIPService_GetWithContext_Call struct {
+	*mock.Call
+}
+
+// GetWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockOutboundIPService_Expecter) GetWithContext(ctx interface{}) *MockOutboundIPService_GetWithContext_Call {
+	return &MockOutboundIPService_GetWithContext_Call{Call: _e.mock.On("GetWithContext", ctx)}
+}
+
+func (_c *MockOutboundIPService_GetWithContext_Call) Run(run func(ctx context.Context)) *MockOutboundIPService_GetWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockOutboundIPService_GetWithContext_Call) Return(_a0 []readme.OutboundIP, _a1 *readme.APIResponse, _a2 error) *MockOutboundIPService_GetWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockOutboundIPService_GetWithContext_Call) RunAndReturn(run func(context.Context) ([]readme.OutboundIP, *readme.APIResponse, error)) *MockOutboundIPService_GetWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockOutboundIPService creates a new instance of MockOutboundIPService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockOutboundIPService(t interface {
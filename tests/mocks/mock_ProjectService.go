@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	readme "github.com/liveoaklabs/readme-api-go-client/readme"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -20,7 +22,7 @@ func (_m *MockProjectService) EXPECT() *MockProjectService_Expecter {
 	return &MockProjectService_Expecter{mock: &_m.Mock}
 }
 
-// Get provides a mock function with given fields:
+// Get provides a mock function with no fields
 func (_m *MockProjectService) Get() (readme.Project, *readme.APIResponse, error) {
 	ret := _m.Called()
 
@@ -84,6 +86,71 @@ func (_c *MockProjectService_Get_Call) RunAndReturn(run func() (readme.Project,
 	return _c
 }
 
+// GetWithContext provides a mock function with given fields: ctx
+func (_m *MockProjectService) GetWithContext(ctx context.Context) (readme.Project, *readme.APIResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithContext")
+	}
+
+	var r0 readme.Project
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context) (readme.Project, *readme.APIResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) readme.Project); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(readme.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) *readme.APIResponse); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockProjectService_GetWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithContext'
+type MockProjectService_GetWithContext_Call struct {
+	*mock.Call
+}
+
+// GetWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProjectService_Expecter) GetWithContext(ctx interface{}) *MockProjectService_GetWithContext_Call {
+	return &MockProjectService_GetWithContext_Call{Call: _e.mock.On("GetWithContext", ctx)}
+}
+
+func (_c *MockProjectService_GetWithContext_Call) Run(run func(ctx context.Context)) *MockProjectService_GetWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockProjectService_GetWithContext_Call) Return(_a0 readme.Project, _a1 *readme.APIResponse, _a2 error) *MockProjectService_GetWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockProjectService_GetWithContext_Call) RunAndReturn(run func(context.Context) (readme.Project, *readme.APIResponse, error)) *MockProjectService_GetWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockProjectService creates a new instance of MockProjectService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockProjectService(t interface {
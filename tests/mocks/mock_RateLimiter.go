@@ -0,0 +1,116 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRateLimiter is an autogenerated mock type for the RateLimiter type
+type MockRateLimiter struct {
+	mock.Mock
+}
+
+type MockRateLimiter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRateLimiter) EXPECT() *MockRateLimiter_Expecter {
+	return &MockRateLimiter_Expecter{mock: &_m.Mock}
+}
+
+// Observe provides a mock function with given fields: response
+func (_m *MockRateLimiter) Observe(response *http.Response) {
+	_m.Called(response)
+}
+
+// MockRateLimiter_Observe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Observe'
+type MockRateLimiter_Observe_Call struct {
+	*mock.Call
+}
+
+// Observe is a helper method to define mock.On call
+//   - response *http.Response
+func (_e *MockRateLimiter_Expecter) Observe(response interface{}) *MockRateLimiter_Observe_Call {
+	return &MockRateLimiter_Observe_Call{Call: _e.mock.On("Observe", response)}
+}
+
+func (_c *MockRateLimiter_Observe_Call) Run(run func(response *http.Response)) *MockRateLimiter_Observe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Response))
+	})
+	return _c
+}
+
+func (_c *MockRateLimiter_Observe_Call) Return() *MockRateLimiter_Observe_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockRateLimiter_Observe_Call) RunAndReturn(run func(*http.Response)) *MockRateLimiter_Observe_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Wait provides a mock function with given fields: ctx
+func (_m *MockRateLimiter) Wait(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wait")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRateLimiter_Wait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wait'
+type MockRateLimiter_Wait_Call struct {
+	*mock.Call
+}
+
+// Wait is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRateLimiter_Expecter) Wait(ctx interface{}) *MockRateLimiter_Wait_Call {
+	return &MockRateLimiter_Wait_Call{Call: _e.mock.On("Wait", ctx)}
+}
+
+func (_c *MockRateLimiter_Wait_Call) Run(run func(ctx context.Context)) *MockRateLimiter_Wait_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRateLimiter_Wait_Call) Return(_a0 error) *MockRateLimiter_Wait_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRateLimiter_Wait_Call) RunAndReturn(run func(context.Context) error) *MockRateLimiter_Wait_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRateLimiter creates a new instance of MockRateLimiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRateLimiter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRateLimiter {
+	mock := &MockRateLimiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
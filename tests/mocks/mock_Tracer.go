@@ -0,0 +1,134 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+
+	readme "github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// MockTracer is an autogenerated mock type for the Tracer type
+type MockTracer struct {
+	mock.Mock
+}
+
+type MockTracer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTracer) EXPECT() *MockTracer_Expecter {
+	return &MockTracer_Expecter{mock: &_m.Mock}
+}
+
+// Inject provides a mock function with given fields: ctx, header
+func (_m *MockTracer) Inject(ctx context.Context, header http.Header) {
+	_m.Called(ctx, header)
+}
+
+// MockTracer_Inject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Inject'
+type MockTracer_Inject_Call struct {
+	*mock.Call
+}
+
+// Inject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - header http.Header
+func (_e *MockTracer_Expecter) Inject(ctx interface{}, header interface{}) *MockTracer_Inject_Call {
+	return &MockTracer_Inject_Call{Call: _e.mock.On("Inject", ctx, header)}
+}
+
+func (_c *MockTracer_Inject_Call) Run(run func(ctx context.Context, header http.Header)) *MockTracer_Inject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(http.Header))
+	})
+	return _c
+}
+
+func (_c *MockTracer_Inject_Call) Return() *MockTracer_Inject_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockTracer_Inject_Call) RunAndReturn(run func(context.Context, http.Header)) *MockTracer_Inject_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx, start
+func (_m *MockTracer) Start(ctx context.Context, start readme.SpanStart) (context.Context, readme.Span) {
+	ret := _m.Called(ctx, start)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 context.Context
+	var r1 readme.Span
+	if rf, ok := ret.Get(0).(func(context.Context, readme.SpanStart) (context.Context, readme.Span)); ok {
+		return rf(ctx, start)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, readme.SpanStart) context.Context); ok {
+		r0 = rf(ctx, start)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, readme.SpanStart) readme.Span); ok {
+		r1 = rf(ctx, start)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(readme.Span)
+		}
+	}
+
+	return r0, r1
+}
+
+// MockTracer_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MockTracer_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+//   - start readme.SpanStart
+func (_e *MockTracer_Expecter) Start(ctx interface{}, start interface{}) *MockTracer_Start_Call {
+	return &MockTracer_Start_Call{Call: _e.mock.On("Start", ctx, start)}
+}
+
+func (_c *MockTracer_Start_Call) Run(run func(ctx context.Context, start readme.SpanStart)) *MockTracer_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(readme.SpanStart))
+	})
+	return _c
+}
+
+func (_c *MockTracer_Start_Call) Return(_a0 context.Context, _a1 readme.Span) *MockTracer_Start_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTracer_Start_Call) RunAndReturn(run func(context.Context, readme.SpanStart) (context.Context, readme.Span)) *MockTracer_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTracer creates a new instance of MockTracer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTracer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTracer {
+	mock := &MockTracer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
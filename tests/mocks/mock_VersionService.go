@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	readme "github.com/liveoaklabs/readme-api-go-client/readme"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -85,6 +87,72 @@ func (_c *MockVersionService_Create_Call) RunAndReturn(run func(readme.VersionPa
 	return _c
 }
 
+// CreateWithContext provides a mock function with given fields: ctx, params
+func (_m *MockVersionService) CreateWithContext(ctx context.Context, params readme.VersionParams) (readme.Version, *readme.APIResponse, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithContext")
+	}
+
+	var r0 readme.Version
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, readme.VersionParams) (readme.Version, *readme.APIResponse, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, readme.VersionParams) readme.Version); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(readme.Version)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, readme.VersionParams) *readme.APIResponse); ok {
+		r1 = rf(ctx, params)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, readme.VersionParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockVersionService_CreateWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithContext'
+type MockVersionService_CreateWithContext_Call struct {
+	*mock.Call
+}
+
+// CreateWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params readme.VersionParams
+func (_e *MockVersionService_Expecter) CreateWithContext(ctx interface{}, params interface{}) *MockVersionService_CreateWithContext_Call {
+	return &MockVersionService_CreateWithContext_Call{Call: _e.mock.On("CreateWithContext", ctx, params)}
+}
+
+func (_c *MockVersionService_CreateWithContext_Call) Run(run func(ctx context.Context, params readme.VersionParams)) *MockVersionService_CreateWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(readme.VersionParams))
+	})
+	return _c
+}
+
+func (_c *MockVersionService_CreateWithContext_Call) Return(_a0 readme.Version, _a1 *readme.APIResponse, _a2 error) *MockVersionService_CreateWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockVersionService_CreateWithContext_Call) RunAndReturn(run func(context.Context, readme.VersionParams) (readme.Version, *readme.APIResponse, error)) *MockVersionService_CreateWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: version
 func (_m *MockVersionService) Delete(version string) (bool, *readme.APIResponse, error) {
 	ret := _m.Called(version)
@@ -150,6 +218,72 @@ func (_c *MockVersionService_Delete_Call) RunAndReturn(run func(string) (bool, *
 	return _c
 }
 
+// DeleteWithContext provides a mock function with given fields: ctx, version
+func (_m *MockVersionService) DeleteWithContext(ctx context.Context, version string) (bool, *readme.APIResponse, error) {
+	ret := _m.Called(ctx, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWithContext")
+	}
+
+	var r0 bool
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, *readme.APIResponse, error)); ok {
+		return rf(ctx, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, version)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *readme.APIResponse); ok {
+		r1 = rf(ctx, version)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, version)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockVersionService_DeleteWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWithContext'
+type MockVersionService_DeleteWithContext_Call struct {
+	*mock.Call
+}
+
+// DeleteWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - version string
+func (_e *MockVersionService_Expecter) DeleteWithContext(ctx interface{}, version interface{}) *MockVersionService_DeleteWithContext_Call {
+	return &MockVersionService_DeleteWithContext_Call{Call: _e.mock.On("DeleteWithContext", ctx, version)}
+}
+
+func (_c *MockVersionService_DeleteWithContext_Call) Run(run func(ctx context.Context, version string)) *MockVersionService_DeleteWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockVersionService_DeleteWithContext_Call) Return(_a0 bool, _a1 *readme.APIResponse, _a2 error) *MockVersionService_DeleteWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockVersionService_DeleteWithContext_Call) RunAndReturn(run func(context.Context, string) (bool, *readme.APIResponse, error)) *MockVersionService_DeleteWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: version
 func (_m *MockVersionService) Get(version string) (readme.Version, *readme.APIResponse, error) {
 	ret := _m.Called(version)
@@ -215,7 +349,7 @@ func (_c *MockVersionService_Get_Call) RunAndReturn(run func(string) (readme.Ver
 	return _c
 }
 
-// GetAll provides a mock function with given fields:
+// GetAll provides a mock function with no fields
 func (_m *MockVersionService) GetAll() ([]readme.VersionSummary, *readme.APIResponse, error) {
 	ret := _m.Called()
 
@@ -281,6 +415,73 @@ func (_c *MockVersionService_GetAll_Call) RunAndReturn(run func() ([]readme.Vers
 	return _c
 }
 
+// GetAllWithContext provides a mock function with given fields: ctx
+func (_m *MockVersionService) GetAllWithContext(ctx context.Context) ([]readme.VersionSummary, *readme.APIResponse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllWithContext")
+	}
+
+	var r0 []readme.VersionSummary
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]readme.VersionSummary, *readme.APIResponse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []readme.VersionSummary); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]readme.VersionSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) *readme.APIResponse); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockVersionService_GetAllWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllWithContext'
+type MockVersionService_GetAllWithContext_Call struct {
+	*mock.Call
+}
+
+// GetAllWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockVersionService_Expecter) GetAllWithContext(ctx interface{}) *MockVersionService_GetAllWithContext_Call {
+	return &MockVersionService_GetAllWithContext_Call{Call: _e.mock.On("GetAllWithContext", ctx)}
+}
+
+func (_c *MockVersionService_GetAllWithContext_Call) Run(run func(ctx context.Context)) *MockVersionService_GetAllWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockVersionService_GetAllWithContext_Call) Return(_a0 []readme.VersionSummary, _a1 *readme.APIResponse, _a2 error) *MockVersionService_GetAllWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockVersionService_GetAllWithContext_Call) RunAndReturn(run func(context.Context) ([]readme.VersionSummary, *readme.APIResponse, error)) *MockVersionService_GetAllWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetVersion provides a mock function with given fields: version
 func (_m *MockVersionService) GetVersion(version string) (string, error) {
 	ret := _m.Called(version)
@@ -337,6 +538,129 @@ func (_c *MockVersionService_GetVersion_Call) RunAndReturn(run func(string) (str
 	return _c
 }
 
+// GetVersionWithContext provides a mock function with given fields: ctx, version
+func (_m *MockVersionService) GetVersionWithContext(ctx context.Context, version string) (string, error) {
+	ret := _m.Called(ctx, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVersionWithContext")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, version)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVersionService_GetVersionWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVersionWithContext'
+type MockVersionService_GetVersionWithContext_Call struct {
+	*mock.Call
+}
+
+// GetVersionWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - version string
+func (_e *MockVersionService_Expecter) GetVersionWithContext(ctx interface{}, version interface{}) *MockVersionService_GetVersionWithContext_Call {
+	return &MockVersionService_GetVersionWithContext_Call{Call: _e.mock.On("GetVersionWithContext", ctx, version)}
+}
+
+func (_c *MockVersionService_GetVersionWithContext_Call) Run(run func(ctx context.Context, version string)) *MockVersionService_GetVersionWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockVersionService_GetVersionWithContext_Call) Return(_a0 string, _a1 error) *MockVersionService_GetVersionWithContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVersionService_GetVersionWithContext_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockVersionService_GetVersionWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWithContext provides a mock function with given fields: ctx, version
+func (_m *MockVersionService) GetWithContext(ctx context.Context, version string) (readme.Version, *readme.APIResponse, error) {
+	ret := _m.Called(ctx, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithContext")
+	}
+
+	var r0 readme.Version
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (readme.Version, *readme.APIResponse, error)); ok {
+		return rf(ctx, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) readme.Version); ok {
+		r0 = rf(ctx, version)
+	} else {
+		r0 = ret.Get(0).(readme.Version)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *readme.APIResponse); ok {
+		r1 = rf(ctx, version)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, version)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockVersionService_GetWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithContext'
+type MockVersionService_GetWithContext_Call struct {
+	*mock.Call
+}
+
+// GetWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - version string
+func (_e *MockVersionService_Expecter) GetWithContext(ctx interface{}, version interface{}) *MockVersionService_GetWithContext_Call {
+	return &MockVersionService_GetWithContext_Call{Call: _e.mock.On("GetWithContext", ctx, version)}
+}
+
+func (_c *MockVersionService_GetWithContext_Call) Run(run func(ctx context.Context, version string)) *MockVersionService_GetWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockVersionService_GetWithContext_Call) Return(_a0 readme.Version, _a1 *readme.APIResponse, _a2 error) *MockVersionService_GetWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockVersionService_GetWithContext_Call) RunAndReturn(run func(context.Context, string) (readme.Version, *readme.APIResponse, error)) *MockVersionService_GetWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: version, params
 func (_m *MockVersionService) Update(version string, params readme.VersionParams) (readme.Version, *readme.APIResponse, error) {
 	ret := _m.Called(version, params)
@@ -403,6 +727,73 @@ func (_c *MockVersionService_Update_Call) RunAndReturn(run func(string, readme.V
 	return _c
 }
 
+// UpdateWithContext provides a mock function with given fields: ctx, version, params
+func (_m *MockVersionService) UpdateWithContext(ctx context.Context, version string, params readme.VersionParams) (readme.Version, *readme.APIResponse, error) {
+	ret := _m.Called(ctx, version, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWithContext")
+	}
+
+	var r0 readme.Version
+	var r1 *readme.APIResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, readme.VersionParams) (readme.Version, *readme.APIResponse, error)); ok {
+		return rf(ctx, version, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, readme.VersionParams) readme.Version); ok {
+		r0 = rf(ctx, version, params)
+	} else {
+		r0 = ret.Get(0).(readme.Version)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, readme.VersionParams) *readme.APIResponse); ok {
+		r1 = rf(ctx, version, params)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*readme.APIResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, readme.VersionParams) error); ok {
+		r2 = rf(ctx, version, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockVersionService_UpdateWithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWithContext'
+type MockVersionService_UpdateWithContext_Call struct {
+	*mock.Call
+}
+
+// UpdateWithContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - version string
+//   - params readme.VersionParams
+func (_e *MockVersionService_Expecter) UpdateWithContext(ctx interface{}, version interface{}, params interface{}) *MockVersionService_UpdateWithContext_Call {
+	return &MockVersionService_UpdateWithContext_Call{Call: _e.mock.On("UpdateWithContext", ctx, version, params)}
+}
+
+func (_c *MockVersionService_UpdateWithContext_Call) Run(run func(ctx context.Context, version string, params readme.VersionParams)) *MockVersionService_UpdateWithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(readme.VersionParams))
+	})
+	return _c
+}
+
+func (_c *MockVersionService_UpdateWithContext_Call) Return(_a0 readme.Version, _a1 *readme.APIResponse, _a2 error) *MockVersionService_UpdateWithContext_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockVersionService_UpdateWithContext_Call) RunAndReturn(run func(context.Context, string, readme.VersionParams) (readme.Version, *readme.APIResponse, error)) *MockVersionService_UpdateWithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockVersionService creates a new instance of MockVersionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockVersionService(t interface {
@@ -1,5 +1,6 @@
 // Package mocks provides a mock implementation of the readme.Client and its
-// interfaces.
+// interfaces, and in-memory fake implementations of its services that share
+// a store.
 package mocks
 
 import (
@@ -19,6 +20,7 @@ type MockClient struct {
 	CustomPage       *MockCustomPageService
 	Doc              *MockDocService
 	Image            *MockImageService
+	OutboundIP       *MockOutboundIPService
 	Project          *MockProjectService
 	Version          *MockVersionService
 }
@@ -32,7 +34,7 @@ type MockClient struct {
 // that is used in tests, and the second is a mock client that can be used to
 // assert expectations on the client.
 func New(t *testing.T) (*readme.Client, *MockClient) {
-	client, err := readme.NewClient("test", "http://api.example.com/v1")
+	client, err := readme.NewClient("test", readme.WithAPIURL("http://api.example.com/v1"))
 	if err != nil {
 		t.Fatalf("Failed to create client: %s", err)
 	}
@@ -46,6 +48,7 @@ func New(t *testing.T) (*readme.Client, *MockClient) {
 		CustomPage:       NewMockCustomPageService(t),
 		Doc:              NewMockDocService(t),
 		Image:            NewMockImageService(t),
+		OutboundIP:       NewMockOutboundIPService(t),
 		Project:          NewMockProjectService(t),
 		Version:          NewMockVersionService(t),
 	}
@@ -58,6 +61,7 @@ func New(t *testing.T) (*readme.Client, *MockClient) {
 	client.CustomPage = mockClient.CustomPage
 	client.Doc = mockClient.Doc
 	client.Image = mockClient.Image
+	client.OutboundIP = mockClient.OutboundIP
 	client.Project = mockClient.Project
 	client.Version = mockClient.Version
 
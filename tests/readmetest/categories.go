@@ -0,0 +1,235 @@
+package readmetest
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// findCategory returns the category with a slug in a version, or nil if it doesn't exist.
+func (v *version) findCategory(slug string) *readme.Category {
+	for _, category := range v.categories {
+		if category.Slug == slug {
+			return category
+		}
+	}
+
+	return nil
+}
+
+// findCategoryByID returns the category with an ID in a version, or nil if it doesn't exist.
+func (v *version) findCategoryByID(id string) *readme.Category {
+	for _, category := range v.categories {
+		if category.ID == id {
+			return category
+		}
+	}
+
+	return nil
+}
+
+// categorySlugExists reports whether a category slug is used in a version.
+func (v *version) categorySlugExists(slug string) bool {
+	return v.findCategory(slug) != nil
+}
+
+// validCategory responds with an error when the category parameters are invalid.
+func validCategory(w http.ResponseWriter, r *http.Request, params readme.CategoryParams) bool {
+	if params.Title == "" {
+		writeError(w, r, http.StatusBadRequest, "CATEGORY_INVALID", "The category title is required.",
+			"Set the title of the category.")
+
+		return false
+	}
+	if params.Type != "" && params.Type != "guide" && params.Type != "reference" {
+		writeError(w, r, http.StatusBadRequest, "CATEGORY_INVALID",
+			"The category type must be 'guide' or 'reference'.", "Set a valid type.")
+
+		return false
+	}
+
+	return true
+}
+
+// listCategories responds with a page of the version's categories.
+func (s *Server) listCategories(w http.ResponseWriter, r *http.Request, v *version) {
+	categories := make([]readme.Category, 0, len(v.categories))
+	for _, category := range v.categories {
+		categories = append(categories, *category)
+	}
+
+	paginate(w, r, readme.CategoryEndpoint, categories)
+}
+
+// getCategory responds with a category.
+func (s *Server) getCategory(w http.ResponseWriter, r *http.Request, v *version) {
+	category := v.findCategory(r.PathValue("slug"))
+	if category == nil {
+		writeNotFound(w, r, "category", r.PathValue("slug"))
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, category)
+}
+
+// getCategoryDocs responds with the docs in a category, with their child docs.
+func (s *Server) getCategoryDocs(w http.ResponseWriter, r *http.Request, v *version) {
+	category := v.findCategory(r.PathValue("slug"))
+	if category == nil {
+		writeNotFound(w, r, "category", r.PathValue("slug"))
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, v.categoryDocs(category.ID, ""))
+}
+
+// categoryDocs returns the docs in a category with a parent doc, in order, with their child docs.
+func (v *version) categoryDocs(categoryID, parentID string) []readme.CategoryDocs {
+	docs := []readme.CategoryDocs{}
+	for _, doc := range v.docs {
+		if doc.Category != categoryID || doc.ParentDoc != parentID {
+			continue
+		}
+
+		docs = append(docs, readme.CategoryDocs{
+			Children: v.categoryDocs(categoryID, doc.ID),
+			Hidden:   doc.Hidden,
+			ID:       doc.ID,
+			Order:    doc.Order,
+			Slug:     doc.Slug,
+			Title:    doc.Title,
+		})
+	}
+	slices.SortStableFunc(docs, func(a, b readme.CategoryDocs) int {
+		return a.Order - b.Order
+	})
+
+	return docs
+}
+
+// createCategory creates a category. The response includes the version, which includes its
+// categories when the request sets the x-readme-version header.
+func (s *Server) createCategory(w http.ResponseWriter, r *http.Request, v *version) {
+	params := readme.CategoryParams{}
+	if !decode(w, r, "category", &params) || !validCategory(w, r, params) {
+		return
+	}
+	if params.Type == "" {
+		params.Type = "guide"
+	}
+
+	category := &readme.Category{
+		CategoryType: "guide",
+		CreatedAt:    now(),
+		ID:           s.newID(),
+		Order:        len(v.categories),
+		Project:      projectID,
+		Reference:    params.Type == "reference",
+		Slug:         Slugify(params.Title, v.categorySlugExists),
+		Title:        params.Title,
+		Type:         params.Type,
+		Version:      v.ID,
+	}
+	v.categories = append(v.categories, category)
+
+	if r.Header.Get("x-readme-version") == "" {
+		writeJSON(w, http.StatusCreated, readme.CategorySaved{
+			CreatedAt: category.CreatedAt,
+			ID:        category.ID,
+			Order:     category.Order,
+			Project:   category.Project,
+			Reference: category.Reference,
+			Slug:      category.Slug,
+			Title:     category.Title,
+			Type:      category.Type,
+			Version:   v.response(),
+		})
+
+		return
+	}
+
+	forkedFrom := readme.CategoryVersionForkedFrom{ID: v.ForkedFrom}
+	for _, from := range s.versions {
+		if from.ID == v.ForkedFrom {
+			forkedFrom.Version = from.Version.Version
+		}
+	}
+	categories := make([]readme.Category, 0, len(v.categories))
+	for _, existing := range v.categories {
+		categories = append(categories, *existing)
+	}
+
+	writeJSON(w, http.StatusCreated, readme.CategoryVersionSaved{
+		CreatedAt: category.CreatedAt,
+		ID:        category.ID,
+		Order:     category.Order,
+		Project:   category.Project,
+		Reference: category.Reference,
+		Slug:      category.Slug,
+		Title:     category.Title,
+		Type:      category.Type,
+		Version: readme.CategoryVersion{
+			Categories:   categories,
+			Codename:     v.Codename,
+			CreatedAt:    v.CreatedAt,
+			ForkedFrom:   forkedFrom,
+			ID:           v.ID,
+			IsBeta:       v.IsBeta,
+			IsDeprecated: v.IsDeprecated,
+			IsHidden:     v.IsHidden,
+			IsStable:     v.IsStable,
+			Project:      v.Project,
+			ReleaseDate:  v.ReleaseDate,
+			Version:      v.Version.Version,
+			VersionClean: v.VersionClean,
+		},
+	})
+}
+
+// updateCategory updates a category. Changing the title changes the slug.
+func (s *Server) updateCategory(w http.ResponseWriter, r *http.Request, v *version) {
+	category := v.findCategory(r.PathValue("slug"))
+	if category == nil {
+		writeNotFound(w, r, "category", r.PathValue("slug"))
+
+		return
+	}
+
+	params := readme.CategoryParams{}
+	if !decode(w, r, "category", &params) || !validCategory(w, r, params) {
+		return
+	}
+
+	if params.Title != category.Title {
+		category.Slug = Slugify(params.Title, func(slug string) bool {
+			return slug != category.Slug && v.categorySlugExists(slug)
+		})
+		category.Title = params.Title
+	}
+	if params.Type != "" {
+		category.Type = params.Type
+		category.Reference = params.Type == "reference"
+	}
+
+	writeJSON(w, http.StatusOK, category)
+}
+
+// deleteCategory deletes a category and its docs.
+func (s *Server) deleteCategory(w http.ResponseWriter, r *http.Request, v *version) {
+	category := v.findCategory(r.PathValue("slug"))
+	if category == nil {
+		writeNotFound(w, r, "category", r.PathValue("slug"))
+
+		return
+	}
+
+	v.categories = remove(v.categories, category)
+	v.docs = slices.DeleteFunc(v.docs, func(doc *readme.Doc) bool {
+		return doc.Category == category.ID
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+}
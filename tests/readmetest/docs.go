@@ -0,0 +1,221 @@
+package readmetest
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// defaultDocOrder is the order of a doc when it isn't set.
+const defaultDocOrder = 999
+
+// findDoc returns the doc with a slug in a version, or nil if it doesn't exist.
+func (v *version) findDoc(slug string) *readme.Doc {
+	for _, doc := range v.docs {
+		if doc.Slug == slug {
+			return doc
+		}
+	}
+
+	return nil
+}
+
+// docSlugExists reports whether a doc slug is used in a version.
+func (v *version) docSlugExists(slug string) bool {
+	return v.findDoc(slug) != nil
+}
+
+// applyDocParams sets the category, parent doc and other fields of a doc from the parameters,
+// responding with an error if the category or parent doc doesn't exist.
+func applyDocParams(w http.ResponseWriter, r *http.Request, v *version, doc *readme.Doc, params readme.DocParams) bool {
+	switch {
+	case params.Category != "":
+		category := v.findCategoryByID(params.Category)
+		if category == nil {
+			writeError(w, r, http.StatusBadRequest, "DOC_INVALID",
+				"The category with the ID '"+params.Category+"' couldn't be found.", "Set an existing category.")
+
+			return false
+		}
+		doc.Category = category.ID
+		doc.IsReference = category.Reference
+	case params.CategorySlug != "":
+		category := v.findCategory(params.CategorySlug)
+		if category == nil {
+			writeError(w, r, http.StatusBadRequest, "DOC_INVALID",
+				"The category '"+params.CategorySlug+"' couldn't be found.", "Set an existing category.")
+
+			return false
+		}
+		doc.Category = category.ID
+		doc.IsReference = category.Reference
+	}
+
+	switch {
+	case params.ParentDoc != "":
+		doc.ParentDoc = params.ParentDoc
+	case params.ParentDocSlug != "":
+		parent := v.findDoc(params.ParentDocSlug)
+		if parent == nil {
+			writeError(w, r, http.StatusBadRequest, "DOC_INVALID",
+				"The parent doc '"+params.ParentDocSlug+"' couldn't be found.", "Set an existing parent doc.")
+
+			return false
+		}
+		doc.ParentDoc = parent.ID
+	}
+
+	if params.Title != "" {
+		doc.Title = params.Title
+	}
+	if params.Body != "" {
+		doc.Body = params.Body
+	}
+	if params.Error.Code != "" {
+		doc.Error = params.Error
+	}
+	if params.Excerpt != "" {
+		doc.Excerpt = params.Excerpt
+	}
+	if params.Metadata != nil {
+		doc.Metadata.Description = params.Metadata.Description
+		doc.Metadata.Title = params.Metadata.Title
+	}
+	if params.Hidden != nil {
+		doc.Hidden = *params.Hidden
+	}
+	if params.Order != nil {
+		doc.Order = *params.Order
+	}
+	if params.Type != "" {
+		doc.Type = params.Type
+	}
+
+	return true
+}
+
+// getDoc responds with a doc.
+func (s *Server) getDoc(w http.ResponseWriter, r *http.Request, v *version) {
+	doc := v.findDoc(r.PathValue("slug"))
+	if doc == nil {
+		writeNotFound(w, r, "doc", r.PathValue("slug"))
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, doc)
+}
+
+// createDoc creates a doc with a slug generated from its title.
+func (s *Server) createDoc(w http.ResponseWriter, r *http.Request, v *version) {
+	params := readme.DocParams{}
+	if !decode(w, r, "doc", &params) {
+		return
+	}
+
+	if params.Title == "" || (params.Category == "" && params.CategorySlug == "") {
+		writeError(w, r, http.StatusBadRequest, "DOC_INVALID", "The doc title and category are required.",
+			"Set the title and the category of the doc.")
+
+		return
+	}
+
+	doc := &readme.Doc{
+		CreatedAt: now(),
+		Hidden:    true,
+		ID:        s.newID(),
+		Order:     defaultDocOrder,
+		Project:   projectID,
+		Revision:  1,
+		Slug:      Slugify(params.Title, v.docSlugExists),
+		Type:      "basic",
+		Updates:   []any{},
+		Version:   v.ID,
+	}
+	if !applyDocParams(w, r, v, doc, params) {
+		return
+	}
+	doc.UpdatedAt = doc.CreatedAt
+	v.docs = append(v.docs, doc)
+
+	writeJSON(w, http.StatusCreated, doc)
+}
+
+// updateDoc updates a doc. The slug doesn't change.
+func (s *Server) updateDoc(w http.ResponseWriter, r *http.Request, v *version) {
+	doc := v.findDoc(r.PathValue("slug"))
+	if doc == nil {
+		writeNotFound(w, r, "doc", r.PathValue("slug"))
+
+		return
+	}
+
+	params := readme.DocParams{}
+	if !decode(w, r, "doc", &params) {
+		return
+	}
+
+	updated := *doc
+	if !applyDocParams(w, r, v, &updated, params) {
+		return
+	}
+	updated.Revision++
+	updated.UpdatedAt = now()
+	*doc = updated
+
+	writeJSON(w, http.StatusOK, doc)
+}
+
+// deleteDoc deletes a doc and its child docs.
+func (s *Server) deleteDoc(w http.ResponseWriter, r *http.Request, v *version) {
+	doc := v.findDoc(r.PathValue("slug"))
+	if doc == nil {
+		writeNotFound(w, r, "doc", r.PathValue("slug"))
+
+		return
+	}
+
+	v.docs = slices.DeleteFunc(v.docs, func(existing *readme.Doc) bool {
+		return existing == doc || existing.ParentDoc == doc.ID
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// searchDocs responds with the visible docs with an ID matching the "search" query parameter, or
+// with a title, slug or body that contains it.
+func (s *Server) searchDocs(w http.ResponseWriter, r *http.Request, v *version) {
+	query := strings.ToLower(r.URL.Query().Get("search"))
+
+	results := readme.DocSearchResults{Results: []readme.DocSearchResult{}}
+	for _, doc := range v.docs {
+		if doc.Hidden {
+			continue
+		}
+
+		if doc.ID != query &&
+			!strings.Contains(strings.ToLower(doc.Title), query) &&
+			!strings.Contains(doc.Slug, query) &&
+			!strings.Contains(strings.ToLower(doc.Body), query) {
+			continue
+		}
+
+		results.Results = append(results.Results, readme.DocSearchResult{
+			IndexName:   "Page",
+			IsReference: doc.IsReference,
+			ObjectID:    doc.ID + "-0",
+			Project:     projectID,
+			ReferenceID: doc.ID,
+			Slug:        doc.Slug,
+			Subdomain:   s.project.SubDomain,
+			Title:       doc.Title,
+			Type:        doc.Type,
+			URL:         "/docs/" + doc.Slug,
+			Version:     v.ID,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, results)
+}
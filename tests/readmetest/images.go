@@ -0,0 +1,72 @@
+package readmetest
+
+import (
+	"bytes"
+	"image"
+	_ "image/gif"  // Register the GIF format for decoding uploaded images.
+	_ "image/jpeg" // Register the JPEG format for decoding uploaded images.
+	_ "image/png"  // Register the PNG format for decoding uploaded images.
+	"io"
+	"net/http"
+	"path/filepath"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// imageColor is the color returned for every uploaded image.
+const imageColor = "#000000"
+
+// uploadImage stores an uploaded image and responds with its URL, filename, size and color.
+func (s *Server) uploadImage(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	file, header, err := r.FormFile("data")
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "IMAGE_EMPTY", "An image wasn't uploaded.",
+			"Upload the image in the 'data' form field.")
+
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "IMAGE_EMPTY", "The image couldn't be read.",
+			"Upload the image again.")
+
+		return
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "IMAGE_INVALID", "The image couldn't be decoded: "+err.Error(),
+			"Upload a PNG, JPEG or GIF image.")
+
+		return
+	}
+
+	filename := r.FormValue("filename")
+	if filename == "" {
+		filename = header.Filename
+	}
+	filename = filepath.Base(filename)
+
+	uploaded := readme.Image{
+		URL:      s.server.URL + "/files/" + s.newID()[17:] + "-" + filename,
+		Filename: filename,
+		Width:    int64(config.Width),
+		Height:   int64(config.Height),
+		Color:    imageColor,
+	}
+	s.images = append(s.images, uploaded)
+
+	writeJSON(w, http.StatusOK,
+		[]any{uploaded.URL, uploaded.Filename, uploaded.Width, uploaded.Height, uploaded.Color})
+}
+
+// Images returns the images that were uploaded to the server.
+func (s *Server) Images() []readme.Image {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]readme.Image(nil), s.images...)
+}
@@ -0,0 +1,245 @@
+package readmetest
+
+import (
+	"net/http"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// findChangelog returns the changelog with a slug, or nil if it doesn't exist.
+func (s *Server) findChangelog(slug string) *readme.Changelog {
+	for _, changelog := range s.changelogs {
+		if changelog.Slug == slug {
+			return changelog
+		}
+	}
+
+	return nil
+}
+
+// applyChangelogParams sets the fields of a changelog that are set in the parameters.
+func applyChangelogParams(changelog *readme.Changelog, params readme.ChangelogParams) {
+	changelog.Body = params.Body
+	changelog.HTML = params.Body
+	changelog.Title = params.Title
+	if params.Hidden != nil {
+		changelog.Hidden = *params.Hidden
+	}
+	if params.Type != "" {
+		changelog.Type = params.Type
+	}
+}
+
+// validChangelog responds with an error when the changelog parameters are invalid.
+func validChangelog(w http.ResponseWriter, r *http.Request, params readme.ChangelogParams) bool {
+	if params.Title == "" || params.Body == "" {
+		writeError(w, r, http.StatusBadRequest, "CHANGELOG_INVALID", "The changelog title and body are required.",
+			"Set the title and body of the changelog.")
+
+		return false
+	}
+
+	return true
+}
+
+// listChangelogs responds with a page of changelogs.
+func (s *Server) listChangelogs(w http.ResponseWriter, r *http.Request) {
+	changelogs := make([]readme.Changelog, 0, len(s.changelogs))
+	for _, changelog := range s.changelogs {
+		changelogs = append(changelogs, *changelog)
+	}
+
+	paginate(w, r, readme.ChangelogEndpoint, changelogs)
+}
+
+// getChangelog responds with a changelog.
+func (s *Server) getChangelog(w http.ResponseWriter, r *http.Request) {
+	changelog := s.findChangelog(r.PathValue("slug"))
+	if changelog == nil {
+		writeNotFound(w, r, "changelog", r.PathValue("slug"))
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, changelog)
+}
+
+// createChangelog creates a changelog with a slug generated from its title.
+func (s *Server) createChangelog(w http.ResponseWriter, r *http.Request) {
+	params := readme.ChangelogParams{}
+	if !decode(w, r, "changelog", &params) || !validChangelog(w, r, params) {
+		return
+	}
+
+	changelog := &readme.Changelog{
+		CreatedAt: now(),
+		Hidden:    true,
+		ID:        s.newID(),
+		Project:   projectID,
+		Revision:  1,
+		Slug: Slugify(params.Title, func(slug string) bool {
+			return s.findChangelog(slug) != nil
+		}),
+		Type: "added",
+	}
+	applyChangelogParams(changelog, params)
+	changelog.UpdatedAt = changelog.CreatedAt
+	s.changelogs = append(s.changelogs, changelog)
+
+	writeJSON(w, http.StatusCreated, changelog)
+}
+
+// updateChangelog updates a changelog. The slug doesn't change.
+func (s *Server) updateChangelog(w http.ResponseWriter, r *http.Request) {
+	changelog := s.findChangelog(r.PathValue("slug"))
+	if changelog == nil {
+		writeNotFound(w, r, "changelog", r.PathValue("slug"))
+
+		return
+	}
+
+	params := readme.ChangelogParams{}
+	if !decode(w, r, "changelog", &params) || !validChangelog(w, r, params) {
+		return
+	}
+
+	applyChangelogParams(changelog, params)
+	changelog.Revision++
+	changelog.UpdatedAt = now()
+
+	writeJSON(w, http.StatusOK, changelog)
+}
+
+// deleteChangelog deletes a changelog.
+func (s *Server) deleteChangelog(w http.ResponseWriter, r *http.Request) {
+	changelog := s.findChangelog(r.PathValue("slug"))
+	if changelog == nil {
+		writeNotFound(w, r, "changelog", r.PathValue("slug"))
+
+		return
+	}
+
+	s.changelogs = remove(s.changelogs, changelog)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findCustomPage returns the custom page with a slug, or nil if it doesn't exist.
+func (s *Server) findCustomPage(slug string) *readme.CustomPage {
+	for _, customPage := range s.customPages {
+		if customPage.Slug == slug {
+			return customPage
+		}
+	}
+
+	return nil
+}
+
+// applyCustomPageParams sets the fields of a custom page that are set in the parameters.
+func applyCustomPageParams(customPage *readme.CustomPage, params readme.CustomPageParams) {
+	customPage.Title = params.Title
+	if params.Body != "" {
+		customPage.Body = params.Body
+	}
+	if params.HTML != "" {
+		customPage.HTML = params.HTML
+	}
+	if params.Hidden != nil {
+		customPage.Hidden = *params.Hidden
+	}
+	if params.HTMLMode != nil {
+		customPage.HTMLMode = *params.HTMLMode
+	}
+}
+
+// validCustomPage responds with an error when the custom page parameters are invalid.
+func validCustomPage(w http.ResponseWriter, r *http.Request, params readme.CustomPageParams) bool {
+	if params.Title == "" {
+		writeError(w, r, http.StatusBadRequest, "CUSTOMPAGE_INVALID", "The custom page title is required.",
+			"Set the title of the custom page.")
+
+		return false
+	}
+
+	return true
+}
+
+// listCustomPages responds with a page of custom pages.
+func (s *Server) listCustomPages(w http.ResponseWriter, r *http.Request) {
+	customPages := make([]readme.CustomPage, 0, len(s.customPages))
+	for _, customPage := range s.customPages {
+		customPages = append(customPages, *customPage)
+	}
+
+	paginate(w, r, readme.CustomPageEndpoint, customPages)
+}
+
+// getCustomPage responds with a custom page.
+func (s *Server) getCustomPage(w http.ResponseWriter, r *http.Request) {
+	customPage := s.findCustomPage(r.PathValue("slug"))
+	if customPage == nil {
+		writeNotFound(w, r, "custompage", r.PathValue("slug"))
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, customPage)
+}
+
+// createCustomPage creates a custom page with a slug generated from its title.
+func (s *Server) createCustomPage(w http.ResponseWriter, r *http.Request) {
+	params := readme.CustomPageParams{}
+	if !decode(w, r, "custompage", &params) || !validCustomPage(w, r, params) {
+		return
+	}
+
+	customPage := &readme.CustomPage{
+		CreatedAt: now(),
+		Hidden:    true,
+		ID:        s.newID(),
+		Revision:  1,
+		Slug: Slugify(params.Title, func(slug string) bool {
+			return s.findCustomPage(slug) != nil
+		}),
+	}
+	applyCustomPageParams(customPage, params)
+	customPage.UpdatedAt = customPage.CreatedAt
+	s.customPages = append(s.customPages, customPage)
+
+	writeJSON(w, http.StatusCreated, customPage)
+}
+
+// updateCustomPage updates a custom page. The slug doesn't change.
+func (s *Server) updateCustomPage(w http.ResponseWriter, r *http.Request) {
+	customPage := s.findCustomPage(r.PathValue("slug"))
+	if customPage == nil {
+		writeNotFound(w, r, "custompage", r.PathValue("slug"))
+
+		return
+	}
+
+	params := readme.CustomPageParams{}
+	if !decode(w, r, "custompage", &params) || !validCustomPage(w, r, params) {
+		return
+	}
+
+	applyCustomPageParams(customPage, params)
+	customPage.Revision++
+	customPage.UpdatedAt = now()
+
+	writeJSON(w, http.StatusOK, customPage)
+}
+
+// deleteCustomPage deletes a custom page.
+func (s *Server) deleteCustomPage(w http.ResponseWriter, r *http.Request) {
+	customPage := s.findCustomPage(r.PathValue("slug"))
+	if customPage == nil {
+		writeNotFound(w, r, "custompage", r.PathValue("slug"))
+
+		return
+	}
+
+	s.customPages = remove(s.customPages, customPage)
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,450 @@
+// Package readmetest provides an in-memory fake of the ReadMe API for tests.
+//
+// Unlike the mocks in the tests/mocks package, the fake server keeps state between requests. It
+// generates slugs and IDs, paginates lists with "link" and "x-total-count" headers, scopes docs,
+// categories and API specifications to the version in the x-readme-version header, and responds
+// with ReadMe-shaped error bodies:
+//
+//	server := readmetest.NewServer()
+//	defer server.Close()
+//
+//	client, _ := server.NewClient()
+//	created := &readme.CategorySaved{}
+//	_, _ = client.Category.Create(created, readme.CategoryParams{Title: "Getting Started", Type: "guide"})
+//	category, _, _ := client.Category.Get("getting-started")
+//
+// The server starts with a single stable version, "1.0", unless another is set with
+// WithStableVersion().
+package readmetest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+const (
+	// apiPath is the path of the API base URL.
+	apiPath = "/api/v1"
+	// imagePath is the path of the image API base URL.
+	imagePath = "/api/images"
+	// defaultPerPage is the number of items in a page when a request doesn't set "perPage".
+	defaultPerPage = 10
+	// maxPerPage is the maximum number of items in a page.
+	maxPerPage = 100
+	// DefaultAPIKey is the API key accepted by the server when one isn't set with WithAPIKey().
+	DefaultAPIKey = "rdme_readmetest"
+	// DefaultStableVersion is the project's stable version when one isn't set with
+	// WithStableVersion().
+	DefaultStableVersion = "1.0"
+)
+
+// errorPoem is the poem included in every error response.
+var errorPoem = []string{
+	"If you're seeing this error,",
+	"Things didn't quite go the way we hoped.",
+	"When we tried to process your request,",
+	"Maybe trying again it'll work—who knows!",
+}
+
+// slugInvalidCharacters matches the characters replaced when generating a slug.
+var slugInvalidCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Server is a fake ReadMe API server. It's safe for concurrent use.
+type Server struct {
+	// URL is the base URL of the API, for use with readme.WithAPIURL().
+	URL string
+	// ImageURL is the base URL of the image API, for use with readme.WithImageAPIURL().
+	ImageURL string
+
+	server *httptest.Server
+
+	mu          sync.Mutex
+	apiKey      string
+	project     readme.Project
+	outboundIPs []readme.OutboundIP
+	lastID      int
+	versions    []*version
+	changelogs  []*readme.Changelog
+	customPages []*readme.CustomPage
+	registries  map[string]string
+	images      []readme.Image
+}
+
+// Option configures a Server.
+type Option func(s *Server)
+
+// WithAPIKey sets the API key the server accepts. Requests with another API key respond with a 401
+// status code.
+func WithAPIKey(apiKey string) Option {
+	return func(s *Server) {
+		s.apiKey = apiKey
+	}
+}
+
+// WithOutboundIPs sets the IP addresses returned by the outbound IPs endpoint.
+func WithOutboundIPs(ipAddresses ...string) Option {
+	return func(s *Server) {
+		s.outboundIPs = make([]readme.OutboundIP, 0, len(ipAddresses))
+		for _, ipAddress := range ipAddresses {
+			s.outboundIPs = append(s.outboundIPs, readme.OutboundIP{IPAddress: ipAddress})
+		}
+	}
+}
+
+// WithProject sets the project returned by the project endpoint.
+func WithProject(project readme.Project) Option {
+	return func(s *Server) {
+		s.project = project
+	}
+}
+
+// WithStableVersion sets the project's initial stable version.
+func WithStableVersion(stable string) Option {
+	return func(s *Server) {
+		s.versions[0].Version.Version = stable
+		s.versions[0].VersionClean = stable
+	}
+}
+
+// NewServer starts and returns a fake ReadMe API server. The server should be closed with Close()
+// when it's no longer needed.
+func NewServer(options ...Option) *Server {
+	s := &Server{
+		apiKey: DefaultAPIKey,
+		project: readme.Project{
+			BaseURL:   "https://readmetest.readme.io",
+			JWTSecret: "readmetest-jwt-secret",
+			Name:      "ReadMe Test",
+			Plan:      "business",
+			SubDomain: "readmetest",
+		},
+		outboundIPs: []readme.OutboundIP{{IPAddress: "127.0.0.1"}},
+		registries:  map[string]string{},
+	}
+	s.versions = []*version{s.newVersion(readme.Version{
+		Version:      DefaultStableVersion,
+		VersionClean: DefaultStableVersion,
+		IsStable:     true,
+	})}
+
+	for _, option := range options {
+		option(s)
+	}
+
+	s.server = httptest.NewServer(s.routes())
+	s.URL = s.server.URL + apiPath
+	s.ImageURL = s.server.URL + imagePath
+
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// NewClient returns a ReadMe API client for the server, authenticated with the server's API key.
+// The options are applied after the API URLs are set.
+func (s *Server) NewClient(options ...readme.ClientOption) (*readme.Client, error) {
+	options = append([]readme.ClientOption{
+		readme.WithAPIURL(s.URL),
+		readme.WithImageAPIURL(s.ImageURL),
+		readme.WithHTTPClient(s.server.Client()),
+	}, options...)
+
+	client, err := readme.NewClient(s.apiKey, options...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create client: %w", err)
+	}
+
+	return client, nil
+}
+
+// handlerFunc handles a request while the server's state is locked.
+type handlerFunc func(w http.ResponseWriter, r *http.Request)
+
+// versionedHandlerFunc handles a request for the version in its x-readme-version header while the
+// server's state is locked.
+type versionedHandlerFunc func(w http.ResponseWriter, r *http.Request, v *version)
+
+// routes returns the server's request router.
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	handle := func(pattern string, handler handlerFunc) {
+		method, path, _ := strings.Cut(pattern, " ")
+		mux.HandleFunc(method+" "+apiPath+path, s.handle(handler, true))
+	}
+	handleVersioned := func(pattern string, handler versionedHandlerFunc) {
+		handle(pattern, s.versioned(handler))
+	}
+
+	handle("GET /{$}", s.getProject)
+	mux.HandleFunc("GET "+apiPath+"/outbound-ips", s.handle(s.getOutboundIPs, false))
+
+	handle("GET /version", s.listVersions)
+	handle("POST /version", s.createVersion)
+	handle("GET /version/{version}", s.getVersion)
+	handle("PUT /version/{version}", s.updateVersion)
+	handle("DELETE /version/{version}", s.deleteVersion)
+
+	handleVersioned("GET /categories", s.listCategories)
+	handleVersioned("POST /categories", s.createCategory)
+	handleVersioned("GET /categories/{slug}", s.getCategory)
+	handleVersioned("PUT /categories/{slug}", s.updateCategory)
+	handleVersioned("DELETE /categories/{slug}", s.deleteCategory)
+	handleVersioned("GET /categories/{slug}/docs", s.getCategoryDocs)
+
+	handleVersioned("POST /docs", s.createDoc)
+	handleVersioned("POST /docs/search", s.searchDocs)
+	handleVersioned("GET /docs/{slug}", s.getDoc)
+	handleVersioned("GET /docs/{slug}/production", s.getDoc)
+	handleVersioned("PUT /docs/{slug}", s.updateDoc)
+	handleVersioned("DELETE /docs/{slug}", s.deleteDoc)
+
+	handle("GET /changelogs", s.listChangelogs)
+	handle("POST /changelogs", s.createChangelog)
+	handle("GET /changelogs/{slug}", s.getChangelog)
+	handle("PUT /changelogs/{slug}", s.updateChangelog)
+	handle("DELETE /changelogs/{slug}", s.deleteChangelog)
+
+	handle("GET /custompages", s.listCustomPages)
+	handle("POST /custompages", s.createCustomPage)
+	handle("GET /custompages/{slug}", s.getCustomPage)
+	handle("PUT /custompages/{slug}", s.updateCustomPage)
+	handle("DELETE /custompages/{slug}", s.deleteCustomPage)
+
+	handleVersioned("GET /api-specification", s.listSpecifications)
+	handleVersioned("POST /api-specification", s.createSpecification)
+	handle("PUT /api-specification/{id}", s.updateSpecification)
+	handle("DELETE /api-specification/{id}", s.deleteSpecification)
+
+	handle("POST /api-registry", s.createRegistry)
+	handle("GET /api-registry/{uuid}", s.getRegistry)
+
+	mux.HandleFunc("POST "+imagePath+"/image-upload", s.handle(s.uploadImage, true))
+
+	mux.HandleFunc("/", s.handle(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, r, http.StatusNotFound, "ENDPOINT_NOTFOUND",
+			fmt.Sprintf("The endpoint %s %s doesn't exist.", r.Method, r.URL.Path), "Check the API reference.")
+	}, false))
+
+	return mux
+}
+
+// handle wraps a handler to set a request ID, lock the server's state and authenticate the request
+// when the endpoint requires authentication.
+func (s *Server) handle(handler handlerFunc, authenticate bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		w.Header().Set(readme.RequestIDHeader, s.newRequestID())
+
+		if !authenticate {
+			handler(w, r)
+
+			return
+		}
+
+		username := apiKey(r)
+		if username == "" {
+			writeError(w, r, http.StatusUnauthorized, "APIKEY_EMPTY", "An API key was not supplied.",
+				"Supply your API key as the username of the HTTP basic authentication header.")
+
+			return
+		}
+		if username != s.apiKey {
+			writeError(w, r, http.StatusUnauthorized, "APIKEY_NOTFOUND",
+				"We couldn't find your API key.", "The API key you passed in is invalid.")
+
+			return
+		}
+
+		handler(w, r)
+	}
+}
+
+// versioned wraps a handler to find the version in the request's x-readme-version header, which
+// defaults to the stable version.
+func (s *Server) versioned(handler versionedHandlerFunc) handlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := r.Header.Get("x-readme-version")
+
+		v := s.stableVersion()
+		if name != "" {
+			v = s.findVersion(name)
+		}
+		if v == nil {
+			writeError(w, r, http.StatusNotFound, "VERSION_NOTFOUND",
+				fmt.Sprintf("The version '%s' couldn't be found.", name),
+				"Make sure the x-readme-version header matches a version of the project.")
+
+			return
+		}
+
+		handler(w, r, v)
+	}
+}
+
+// getProject responds with the project.
+func (s *Server) getProject(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, s.project)
+}
+
+// getOutboundIPs responds with the outbound IP addresses.
+func (s *Server) getOutboundIPs(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, s.outboundIPs)
+}
+
+// newID returns a new unique object ID.
+func (s *Server) newID() string {
+	s.lastID++
+
+	return fmt.Sprintf("%024x", s.lastID)
+}
+
+// newRequestID returns a new unique request ID.
+func (s *Server) newRequestID() string {
+	s.lastID++
+
+	return fmt.Sprintf("%08x-0000-4000-8000-%012x", s.lastID, s.lastID)
+}
+
+// now returns the current time formatted like the timestamps of ReadMe API objects.
+func now() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// Slugify returns a slug for a title like ReadMe does, adding a number to it when the slug already
+// exists. The fakes in the mocks package use it too, so both generate the same slugs.
+func Slugify(title string, exists func(slug string) bool) string {
+	base := strings.Trim(slugInvalidCharacters.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if base == "" {
+		base = "untitled"
+	}
+
+	slug := base
+	for i := 1; exists(slug); i++ {
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+
+	return slug
+}
+
+// decode decodes the JSON body of a request, responding with an error when it's invalid.
+func decode(w http.ResponseWriter, r *http.Request, kind string, value interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(value); err != nil {
+		writeError(w, r, http.StatusBadRequest, strings.ToUpper(kind)+"_INVALID",
+			fmt.Sprintf("The request body isn't a valid %s: %s", kind, err), "Send a JSON request body.")
+
+		return false
+	}
+
+	return true
+}
+
+// writeJSON writes a JSON response.
+func writeJSON(w http.ResponseWriter, statusCode int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// writeError writes a ReadMe API error response. The docs URL references the request ID like a
+// ReadMe Metrics log URL.
+func writeError(w http.ResponseWriter, _ *http.Request, statusCode int, code, message, suggestion string) {
+	requestID := w.Header().Get(readme.RequestIDHeader)
+
+	writeJSON(w, statusCode, readme.APIErrorResponse{
+		Docs:       "https://docs.readme.com/logs/" + requestID,
+		Error:      code,
+		Help:       fmt.Sprintf("If you need help, email support@readme.io and mention log %q.", requestID),
+		Message:    message,
+		Poem:       errorPoem,
+		Suggestion: suggestion,
+	})
+}
+
+// writeNotFound writes a ReadMe API error response for an object that doesn't exist.
+func writeNotFound(w http.ResponseWriter, r *http.Request, kind, slug string) {
+	writeError(w, r, http.StatusNotFound, strings.ToUpper(kind)+"_NOTFOUND",
+		fmt.Sprintf("The %s with the slug '%s' couldn't be found.", strings.ToLower(kind), slug),
+		fmt.Sprintf("Make sure the %s exists in the project and version.", strings.ToLower(kind)))
+}
+
+// paginate writes a page of items with the "link" and "x-total-count" headers, using the "page"
+// and "perPage" query parameters of the request.
+func paginate[T any](w http.ResponseWriter, r *http.Request, endpoint string, items []T) {
+	perPage, perPageErr := queryInt(r, "perPage", defaultPerPage)
+	page, pageErr := queryInt(r, "page", 1)
+	if perPageErr != nil || pageErr != nil || perPage < 1 || page < 1 {
+		writeError(w, r, http.StatusBadRequest, "PAGINATION_INVALID",
+			"The page and perPage parameters must be positive integers.", "Check the pagination parameters.")
+
+		return
+	}
+	perPage = min(perPage, maxPerPage)
+
+	last := max((len(items)+perPage-1)/perPage, 1)
+	pageLink := func(page int) string {
+		return fmt.Sprintf("<%s?perPage=%d&page=%d>", endpoint, perPage, page)
+	}
+
+	next, prev := "<>", "<>"
+	if page < last {
+		next = pageLink(page + 1)
+	}
+	if page > 1 {
+		prev = pageLink(min(page-1, last))
+	}
+	w.Header().Set(readme.PaginationHeader,
+		fmt.Sprintf(`%s; rel="next", %s; rel="prev", %s; rel="last"`, next, prev, pageLink(last)))
+	w.Header().Set(readme.TotalCountHeader, strconv.Itoa(len(items)))
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+	writeJSON(w, http.StatusOK, append([]T{}, items[start:end]...))
+}
+
+// queryInt returns an integer query parameter of a request, or the default value if it isn't set.
+func queryInt(r *http.Request, name string, defaultValue int) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s parameter: %w", name, err)
+	}
+
+	return number, nil
+}
+
+// apiKey returns the API key in the basic authentication header of a request. The API key is the
+// username, and the password is optional.
+func apiKey(r *http.Request) string {
+	encoded, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Basic ")
+	if !ok {
+		return ""
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return ""
+	}
+	username, _, _ := strings.Cut(string(decoded), ":")
+
+	return username
+}
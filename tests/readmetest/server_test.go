@@ -0,0 +1,332 @@
+package readmetest_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"github.com/liveoaklabs/readme-api-go-client/tests/readmetest"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDefinition = `{"openapi": "3.0.0", "info": {"title": "Test API", "version": "1.0"}, "paths": {}}`
+
+// newClient returns a fake server and a client for it.
+func newClient(t *testing.T, options ...readmetest.Option) (*readmetest.Server, *readme.Client) {
+	t.Helper()
+
+	server := readmetest.NewServer(options...)
+	t.Cleanup(server.Close)
+
+	client, err := server.NewClient()
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+
+	return server, client
+}
+
+// createCategory creates a category and returns its slug.
+func createCategory(t *testing.T, client *readme.Client, title string, options ...readme.RequestOptions) string {
+	t.Helper()
+
+	params := readme.CategoryParams{Title: title, Type: "guide"}
+	if len(options) > 0 {
+		created := &readme.CategoryVersionSaved{}
+		if _, err := client.Category.Create(created, params, options...); err != nil {
+			t.Fatalf("unable to create category: %s", err)
+		}
+
+		return created.Slug
+	}
+
+	category := &readme.CategorySaved{}
+	if _, err := client.Category.Create(category, params); err != nil {
+		t.Fatalf("unable to create category: %s", err)
+	}
+
+	return category.Slug
+}
+
+func Test_Server_Categories(t *testing.T) {
+	t.Run("when categories are created", func(t *testing.T) {
+		// Arrange
+		_, client := newClient(t)
+
+		// Act
+		first := createCategory(t, client, "Getting Started")
+		second := createCategory(t, client, "Getting Started")
+		category, _, err := client.Category.Get(second)
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Equal(t, "getting-started", first, "it generates a slug from the title")
+		assert.Equal(t, "getting-started-1", second, "it generates a unique slug")
+		assert.Equal(t, "Getting Started", category.Title, "it returns the category")
+		assert.Equal(t, "guide", category.Type, "it returns the category type")
+	})
+
+	t.Run("when categories are listed", func(t *testing.T) {
+		// Arrange
+		_, client := newClient(t)
+		for i := range 25 {
+			createCategory(t, client, fmt.Sprintf("Category %d", i))
+		}
+
+		// Act
+		categories, apiResponse, err := client.Category.GetAll(readme.RequestOptions{PerPage: 10})
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Len(t, categories, 25, "it returns every category")
+		assert.Len(t, apiResponse.Pages, 3, "it paginates the categories")
+		assert.Equal(t, 25, apiResponse.Pagination.TotalCount, "it returns the total count")
+		assert.Equal(t, "category-24", categories[24].Slug, "it returns the categories in order")
+	})
+
+	t.Run("when a category is updated and deleted", func(t *testing.T) {
+		// Arrange
+		_, client := newClient(t)
+		slug := createCategory(t, client, "Getting Started")
+
+		// Act
+		updated, _, updateErr := client.Category.Update(slug, readme.CategoryParams{Title: "Overview", Type: "guide"})
+		_, _, deleteErr := client.Category.Delete(updated.Slug)
+		_, _, getErr := client.Category.Get(updated.Slug)
+
+		// Assert
+		assert.NoError(t, updateErr, "it does not return an error")
+		assert.NoError(t, deleteErr, "it does not return an error")
+		assert.Equal(t, "overview", updated.Slug, "it changes the slug with the title")
+		assert.ErrorIs(t, getErr, readme.ErrNotFound, "it deletes the category")
+	})
+
+	t.Run("when a category has docs", func(t *testing.T) {
+		// Arrange
+		_, client := newClient(t)
+		slug := createCategory(t, client, "Guides")
+		order := 1
+		parent, _, _ := client.Doc.Create(readme.DocParams{Title: "Parent", CategorySlug: slug, Order: &order})
+		_, _, _ = client.Doc.Create(readme.DocParams{Title: "First", CategorySlug: slug})
+		_, _, _ = client.Doc.Create(readme.DocParams{Title: "Child", CategorySlug: slug, ParentDocSlug: parent.Slug})
+
+		// Act
+		docs, _, err := client.Category.GetDocs(slug)
+
+		// Assert
+		assert.NoError(t, err, "it does not return an error")
+		assert.Len(t, docs, 2, "it returns the top-level docs")
+		assert.Equal(t, "parent", docs[0].Slug, "it orders the docs")
+		assert.Equal(t, "child", docs[0].Children[0].Slug, "it nests the child docs")
+	})
+}
+
+func Test_Server_Docs(t *testing.T) {
+	t.Run("when docs are created, searched and updated", func(t *testing.T) {
+		// Arrange
+		_, client := newClient(t)
+		slug := createCategory(t, client, "Guides")
+		hidden := false
+
+		// Act
+		created, _, createErr := client.Doc.Create(readme.DocParams{
+			Title: "Install the CLI", Body: "Run the installer.", CategorySlug: slug, Hidden: &hidden,
+		})
+		_, _, _ = client.Doc.Create(readme.DocParams{Title: "Draft", Body: "Run it.", CategorySlug: slug})
+		results, _, searchErr := client.Doc.Search("run")
+		byID, _, getErr := client.Doc.Get("id:" + created.ID)
+		updated, _, updateErr := client.Doc.Update(created.Slug, readme.DocParams{Title: "Install", CategorySlug: slug})
+
+		// Assert
+		assert.NoError(t, createErr, "it does not return an error")
+		assert.NoError(t, searchErr, "it does not return an error")
+		assert.NoError(t, getErr, "it does not return an error")
+		assert.NoError(t, updateErr, "it does not return an error")
+		assert.Equal(t, "install-the-cli", created.Slug, "it generates a slug from the title")
+		assert.Len(t, results, 1, "it doesn't return hidden docs in search results")
+		assert.Equal(t, created, byID, "it finds the doc by ID")
+		assert.Equal(t, "install-the-cli", updated.Slug, "it doesn't change the slug")
+		assert.Equal(t, 2, updated.Revision, "it increments the revision")
+	})
+
+	t.Run("when the category doesn't exist", func(t *testing.T) {
+		// Arrange
+		_, client := newClient(t)
+
+		// Act
+		_, _, err := client.Doc.Create(readme.DocParams{Title: "Test", CategorySlug: "missing"})
+
+		// Assert
+		var apiErr *readme.APIError
+		assert.ErrorAs(t, err, &apiErr, "it returns an API error")
+		assert.Equal(t, "DOC_INVALID", apiErr.Response.Error, "it returns a ReadMe error code")
+	})
+}
+
+func Test_Server_Versions(t *testing.T) {
+	t.Run("when a version is forked", func(t *testing.T) {
+		// Arrange
+		_, client := newClient(t)
+		slug := createCategory(t, client, "Guides")
+		doc, _, _ := client.Doc.Create(readme.DocParams{Title: "Test", CategorySlug: slug})
+
+		// Act
+		version, _, createErr := client.Version.Create(readme.VersionParams{Version: "2.0", From: "1.0"})
+		forked, _, getErr := client.Doc.Get(doc.Slug, readme.RequestOptions{Version: "2.0"})
+		createCategory(t, client, "Reference", readme.RequestOptions{Version: "2.0"})
+		original, _, _ := client.Category.GetAll()
+		versions, _, _ := client.Version.GetAll()
+
+		// Assert
+		assert.NoError(t, createErr, "it does not return an error")
+		assert.NoError(t, getErr, "it does not return an error")
+		assert.Len(t, version.Categories, 1, "it forks the categories")
+		assert.Equal(t, doc.Title, forked.Title, "it forks the docs")
+		assert.NotEqual(t, doc.ID, forked.ID, "it assigns new IDs to the forked docs")
+		assert.Len(t, original, 1, "it scopes categories to the version")
+		assert.Len(t, versions, 2, "it lists the versions")
+	})
+
+	t.Run("when the version doesn't exist", func(t *testing.T) {
+		// Arrange
+		_, client := newClient(t)
+
+		// Act
+		_, _, err := client.Category.GetAll(readme.RequestOptions{Version: "9.9"})
+
+		// Assert
+		var apiErr *readme.APIError
+		assert.ErrorAs(t, err, &apiErr, "it returns an API error")
+		assert.Equal(t, "VERSION_NOTFOUND", apiErr.Response.Error, "it returns a ReadMe error code")
+	})
+
+	t.Run("when the stable version is deleted", func(t *testing.T) {
+		// Arrange
+		_, client := newClient(t, readmetest.WithStableVersion("3.0"))
+
+		// Act
+		_, _, err := client.Version.Delete("3.0")
+
+		// Assert
+		assert.ErrorIs(t, err, readme.ErrValidation, "it returns a validation error")
+	})
+}
+
+func Test_Server_Pages(t *testing.T) {
+	// Arrange
+	_, client := newClient(t)
+
+	// Act
+	changelog, _, changelogErr := client.Changelog.Create(readme.ChangelogParams{Title: "Release", Body: "Notes"})
+	_, _, _ = client.Changelog.Create(readme.ChangelogParams{Title: "Release", Body: "More notes"})
+	changelogs, _, _ := client.Changelog.GetAll()
+	customPage, _, customPageErr := client.CustomPage.Create(readme.CustomPageParams{Title: "About", Body: "Us"})
+	updated, _, updateErr := client.CustomPage.Update(customPage.Slug, readme.CustomPageParams{Title: "About Us"})
+	_, _, deleteErr := client.CustomPage.Delete(customPage.Slug)
+	customPages, _, _ := client.CustomPage.GetAll()
+
+	// Assert
+	assert.NoError(t, changelogErr, "it does not return an error")
+	assert.NoError(t, customPageErr, "it does not return an error")
+	assert.NoError(t, updateErr, "it does not return an error")
+	assert.NoError(t, deleteErr, "it does not return an error")
+	assert.Equal(t, "release", changelog.Slug, "it generates a changelog slug")
+	assert.Equal(t, "release-1", changelogs[1].Slug, "it generates a unique changelog slug")
+	assert.Equal(t, "About Us", updated.Title, "it updates the custom page")
+	assert.Empty(t, customPages, "it deletes the custom page")
+}
+
+func Test_Server_APISpecification(t *testing.T) {
+	// Arrange
+	_, client := newClient(t)
+
+	// Act
+	registry, _, registryErr := client.APIRegistry.Create(testDefinition)
+	definition, _, getRegistryErr := client.APIRegistry.Get(registry.RegistryUUID)
+	created, _, createErr := client.APISpecification.Create("uuid:" + registry.RegistryUUID)
+	specs, _, listErr := client.APISpecification.GetAll()
+	category, _, categoryErr := client.Category.Get("test-api")
+	_, _, deleteErr := client.APISpecification.Delete(created.ID)
+	_, _, invalidErr := client.APISpecification.Create(`{"title": "Not a definition"}`)
+
+	// Assert
+	assert.NoError(t, registryErr, "it does not return an error")
+	assert.NoError(t, getRegistryErr, "it does not return an error")
+	assert.NoError(t, createErr, "it does not return an error")
+	assert.NoError(t, listErr, "it does not return an error")
+	assert.NoError(t, categoryErr, "it does not return an error")
+	assert.NoError(t, deleteErr, "it does not return an error")
+	assert.JSONEq(t, testDefinition, definition, "it returns the registered definition")
+	assert.Equal(t, "Test API", created.Title, "it creates the API specification")
+	assert.Len(t, specs, 1, "it lists the API specifications")
+	assert.True(t, category.Reference, "it creates a reference category")
+	assert.ErrorIs(t, invalidErr, readme.ErrValidation, "it validates the definition")
+}
+
+func Test_Server_Images(t *testing.T) {
+	// Arrange
+	server, client := newClient(t)
+	source, _ := base64.RawStdEncoding.DecodeString(
+		"iVBORw0KGgoAAAANSUhEUgAAAAgAAAAIAQMAAAD+wSzIAAAABlBMVEX///+/v7+jQ3Y5AAAADklEQVQI12P4AIX8EAgALgAD/" +
+			"aNpbtEAAAAASUVORK5CYII")
+
+	// Act
+	image, _, err := client.Image.Upload(source, "test.png")
+
+	// Assert
+	assert.NoError(t, err, "it does not return an error")
+	assert.Equal(t, "test.png", image.Filename, "it returns the filename")
+	assert.Equal(t, int64(8), image.Width, "it returns the image width")
+	assert.Equal(t, []readme.Image{image}, server.Images(), "it stores the image")
+}
+
+func Test_Server_Project(t *testing.T) {
+	// Arrange
+	_, client := newClient(t, readmetest.WithOutboundIPs("10.0.0.1", "10.0.0.2"),
+		readmetest.WithProject(readme.Project{Name: "Test Project"}))
+
+	// Act
+	project, _, projectErr := client.Project.Get()
+	ips, _, ipsErr := client.OutboundIP.Get()
+
+	// Assert
+	assert.NoError(t, projectErr, "it does not return an error")
+	assert.NoError(t, ipsErr, "it does not return an error")
+	assert.Equal(t, "Test Project", project.Name, "it returns the project")
+	assert.Equal(t, []readme.OutboundIP{{IPAddress: "10.0.0.1"}, {IPAddress: "10.0.0.2"}}, ips,
+		"it returns the outbound IPs")
+}
+
+func Test_Server_Errors(t *testing.T) {
+	t.Run("when an object doesn't exist", func(t *testing.T) {
+		// Arrange
+		_, client := newClient(t)
+
+		// Act
+		_, apiResponse, err := client.Category.Get("missing")
+
+		// Assert
+		var apiErr *readme.APIError
+		assert.True(t, errors.As(err, &apiErr), "it returns an API error")
+		assert.ErrorIs(t, err, readme.ErrNotFound, "it returns a not found error")
+		assert.Equal(t, "CATEGORY_NOTFOUND", apiErr.Response.Error, "it returns a ReadMe error code")
+		assert.NotEmpty(t, apiErr.Response.Poem, "it returns a ReadMe error body")
+		assert.Equal(t, apiResponse.RequestID, apiResponse.LogID, "it references the request in the docs URL")
+	})
+
+	t.Run("when the API key is invalid", func(t *testing.T) {
+		// Arrange
+		server := readmetest.NewServer(readmetest.WithAPIKey("expected"))
+		defer server.Close()
+		client, _ := readme.NewClient("other", readme.WithAPIURL(server.URL))
+
+		// Act
+		_, _, err := client.Project.Get()
+
+		// Assert
+		assert.ErrorIs(t, err, readme.ErrUnauthorized, "it returns an unauthorized error")
+		assert.ErrorContains(t, err, "APIKEY_NOTFOUND", "it returns a ReadMe error code")
+	})
+}
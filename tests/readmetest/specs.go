@@ -0,0 +1,256 @@
+package readmetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+	"gopkg.in/yaml.v3"
+)
+
+// maxUploadSize is the maximum size of an uploaded API definition or image.
+const maxUploadSize = 10 << 20
+
+// definition is an uploaded API definition.
+type definition struct {
+	// raw is the definition as uploaded.
+	raw string
+	// parsed is the decoded definition.
+	parsed map[string]interface{}
+	// title is the title in the definition's "info" object.
+	title string
+	// kind is the type of the definition, which is "oas" for OpenAPI definitions and "swagger" for
+	// Swagger definitions.
+	kind string
+}
+
+// parseDefinition parses a JSON or YAML API definition.
+func parseDefinition(raw string) (*definition, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, fmt.Errorf("the definition is empty")
+	}
+
+	def := &definition{raw: raw}
+	if err := json.Unmarshal([]byte(raw), &def.parsed); err != nil {
+		if yamlErr := yaml.Unmarshal([]byte(raw), &def.parsed); yamlErr != nil {
+			return nil, fmt.Errorf("the definition isn't valid JSON or YAML: %w", yamlErr)
+		}
+	}
+
+	switch {
+	case def.parsed["openapi"] != nil:
+		def.kind = "oas"
+	case def.parsed["swagger"] != nil:
+		def.kind = "swagger"
+	default:
+		return nil, fmt.Errorf("the definition isn't an OpenAPI or Swagger definition")
+	}
+
+	info, _ := def.parsed["info"].(map[string]interface{})
+	def.title, _ = info["title"].(string)
+	if def.title == "" {
+		return nil, fmt.Errorf("the definition doesn't have a title")
+	}
+
+	return def, nil
+}
+
+// readDefinition reads the API definition uploaded as the "spec" form field of a request, or the
+// API registry in the "registryUUID" field of a JSON request, responding with an error when it's
+// missing or invalid.
+func (s *Server) readDefinition(w http.ResponseWriter, r *http.Request) (*definition, bool) {
+	var raw string
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		body := struct {
+			RegistryUUID string `json:"registryUUID"`
+		}{}
+		if !decode(w, r, "spec", &body) {
+			return nil, false
+		}
+
+		registry, ok := s.registries[body.RegistryUUID]
+		if !ok {
+			writeError(w, r, http.StatusNotFound, "REGISTRY_NOTFOUND",
+				"The API registry '"+body.RegistryUUID+"' couldn't be found.", "Create the API registry first.")
+
+			return nil, false
+		}
+		raw = registry
+	} else {
+		file, _, err := r.FormFile(readme.APISpecificationFormField)
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "SPEC_FILE_EMPTY", "An API definition wasn't uploaded.",
+				"Upload the definition in the '"+readme.APISpecificationFormField+"' form field.")
+
+			return nil, false
+		}
+		defer file.Close()
+
+		data, err := io.ReadAll(io.LimitReader(file, maxUploadSize))
+		if err != nil {
+			writeError(w, r, http.StatusBadRequest, "SPEC_FILE_EMPTY", "The API definition couldn't be read.",
+				"Upload the definition again.")
+
+			return nil, false
+		}
+		raw = string(data)
+	}
+
+	def, err := parseDefinition(raw)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "ERROR_SPEC_INVALID", "The API definition is invalid: "+err.Error(),
+			"Upload a valid OpenAPI or Swagger definition.")
+
+		return nil, false
+	}
+
+	return def, true
+}
+
+// listSpecifications responds with a page of the version's API specifications.
+func (s *Server) listSpecifications(w http.ResponseWriter, r *http.Request, v *version) {
+	specs := make([]readme.APISpecification, 0, len(v.specs))
+	for _, spec := range v.specs {
+		specs = append(specs, *spec)
+	}
+
+	paginate(w, r, readme.APISpecificationEndpoint, specs)
+}
+
+// createSpecification creates an API specification and the reference category for it.
+func (s *Server) createSpecification(w http.ResponseWriter, r *http.Request, v *version) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	def, ok := s.readDefinition(w, r)
+	if !ok {
+		return
+	}
+
+	category := &readme.Category{
+		CategoryType: "reference",
+		CreatedAt:    now(),
+		ID:           s.newID(),
+		Order:        len(v.categories),
+		Project:      projectID,
+		Reference:    true,
+		Slug:         Slugify(def.title, v.categorySlugExists),
+		Title:        def.title,
+		Type:         "reference",
+		Version:      v.ID,
+	}
+	v.categories = append(v.categories, category)
+
+	spec := &readme.APISpecification{
+		Category: readme.CategorySummary{
+			ID:    category.ID,
+			Order: category.Order,
+			Slug:  category.Slug,
+			Title: category.Title,
+			Type:  category.Type,
+		},
+		ID:         s.newID(),
+		LastSynced: now(),
+		Source:     "api",
+		Title:      def.title,
+		Type:       def.kind,
+		Version:    v.ID,
+	}
+	v.specs = append(v.specs, spec)
+
+	writeJSON(w, http.StatusCreated, readme.APISpecificationSaved{ID: spec.ID, Title: spec.Title})
+}
+
+// findSpecification returns the API specification with an ID in any version and its version, or
+// nil if it doesn't exist.
+func (s *Server) findSpecification(id string) (*version, *readme.APISpecification) {
+	for _, v := range s.versions {
+		for _, spec := range v.specs {
+			if spec.ID == id {
+				return v, spec
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// writeSpecificationNotFound writes the error response for an API specification that doesn't exist.
+func writeSpecificationNotFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, r, http.StatusNotFound, "SPEC_NOTFOUND",
+		"The API specification with the ID '"+r.PathValue("id")+"' couldn't be found.",
+		"Make sure the API specification exists.")
+}
+
+// updateSpecification replaces the definition of an API specification in any version.
+func (s *Server) updateSpecification(w http.ResponseWriter, r *http.Request) {
+	_, spec := s.findSpecification(r.PathValue("id"))
+	if spec == nil {
+		writeSpecificationNotFound(w, r)
+
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	def, ok := s.readDefinition(w, r)
+	if !ok {
+		return
+	}
+
+	spec.Title = def.title
+	spec.Type = def.kind
+	spec.LastSynced = now()
+
+	writeJSON(w, http.StatusOK, readme.APISpecificationSaved{ID: spec.ID, Title: spec.Title})
+}
+
+// deleteSpecification deletes an API specification in any version, with its category and docs.
+func (s *Server) deleteSpecification(w http.ResponseWriter, r *http.Request) {
+	v, spec := s.findSpecification(r.PathValue("id"))
+	if spec == nil {
+		writeSpecificationNotFound(w, r)
+
+		return
+	}
+
+	v.specs = remove(v.specs, spec)
+	if category := v.findCategoryByID(spec.Category.ID); category != nil {
+		v.categories = remove(v.categories, category)
+	}
+	v.docs = slices.DeleteFunc(v.docs, func(doc *readme.Doc) bool {
+		return doc.Category == spec.Category.ID
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// createRegistry stores an uploaded API definition in the API registry.
+func (s *Server) createRegistry(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	def, ok := s.readDefinition(w, r)
+	if !ok {
+		return
+	}
+
+	uuid := s.newID()[8:]
+	s.registries[uuid] = def.raw
+
+	writeJSON(w, http.StatusCreated, readme.APIRegistrySaved{Definition: def.parsed, RegistryUUID: uuid})
+}
+
+// getRegistry responds with an API definition in the API registry as JSON.
+func (s *Server) getRegistry(w http.ResponseWriter, r *http.Request) {
+	raw, ok := s.registries[r.PathValue("uuid")]
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "REGISTRY_NOTFOUND",
+			"The API registry '"+r.PathValue("uuid")+"' couldn't be found.", "Create the API registry first.")
+
+		return
+	}
+
+	def, _ := parseDefinition(raw)
+	writeJSON(w, http.StatusOK, def.parsed)
+}
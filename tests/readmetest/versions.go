@@ -0,0 +1,280 @@
+package readmetest
+
+import (
+	"net/http"
+
+	"github.com/liveoaklabs/readme-api-go-client/readme"
+)
+
+// projectID is the ID of the fake project.
+const projectID = "00000000000000000000beef"
+
+// version is a project version and the objects that belong to it.
+type version struct {
+	readme.Version
+
+	categories []*readme.Category
+	docs       []*readme.Doc
+	specs      []*readme.APISpecification
+}
+
+// newVersion returns a version with a new ID.
+func (s *Server) newVersion(params readme.Version) *version {
+	params.ID = s.newID()
+	params.Project = projectID
+	params.CreatedAt = now()
+	params.ReleaseDate = params.CreatedAt
+	if params.VersionClean == "" {
+		params.VersionClean = params.Version
+	}
+
+	return &version{Version: params}
+}
+
+// response returns the version with the IDs of its categories.
+func (v *version) response() readme.Version {
+	response := v.Version
+	response.Categories = make([]string, 0, len(v.categories))
+	for _, category := range v.categories {
+		response.Categories = append(response.Categories, category.ID)
+	}
+
+	return response
+}
+
+// summary returns the summary of the version returned when listing versions.
+func (v *version) summary() readme.VersionSummary {
+	return readme.VersionSummary{
+		Codename:     v.Codename,
+		CreatedAt:    v.CreatedAt,
+		ForkedFrom:   v.ForkedFrom,
+		ID:           v.ID,
+		IsBeta:       v.IsBeta,
+		IsDeprecated: v.IsDeprecated,
+		IsHidden:     v.IsHidden,
+		IsStable:     v.IsStable,
+		Version:      v.Version.Version,
+		VersionClean: v.VersionClean,
+	}
+}
+
+// findVersion returns the version with a version number, or nil if it doesn't exist.
+func (s *Server) findVersion(name string) *version {
+	for _, v := range s.versions {
+		if v.Version.Version == name {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// stableVersion returns the project's stable version.
+func (s *Server) stableVersion() *version {
+	for _, v := range s.versions {
+		if v.IsStable {
+			return v
+		}
+	}
+
+	return s.versions[0]
+}
+
+// setStable makes a version the project's only stable version.
+func (s *Server) setStable(stable *version) {
+	for _, v := range s.versions {
+		v.IsStable = v == stable
+	}
+}
+
+// listVersions responds with a summary of every version.
+func (s *Server) listVersions(w http.ResponseWriter, _ *http.Request) {
+	summaries := make([]readme.VersionSummary, 0, len(s.versions))
+	for _, v := range s.versions {
+		summaries = append(summaries, v.summary())
+	}
+
+	writeJSON(w, http.StatusOK, summaries)
+}
+
+// getVersion responds with a version.
+func (s *Server) getVersion(w http.ResponseWriter, r *http.Request) {
+	v := s.findVersion(r.PathValue("version"))
+	if v == nil {
+		writeNotFound(w, r, "version", r.PathValue("version"))
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, v.response())
+}
+
+// createVersion creates a version by forking the categories, docs and API specifications of the
+// version in the "from" parameter.
+func (s *Server) createVersion(w http.ResponseWriter, r *http.Request) {
+	params := readme.VersionParams{}
+	if !decode(w, r, "version", &params) {
+		return
+	}
+
+	if params.Version == "" || params.From == "" {
+		writeError(w, r, http.StatusBadRequest, "VERSION_EMPTY", "The version and from parameters are required.",
+			"Set the version number and the version to fork.")
+
+		return
+	}
+	if s.findVersion(params.Version) != nil {
+		writeError(w, r, http.StatusBadRequest, "VERSION_DUPLICATE",
+			"The version '"+params.Version+"' already exists.", "Use another version number.")
+
+		return
+	}
+	from := s.findVersion(params.From)
+	if from == nil {
+		writeError(w, r, http.StatusBadRequest, "VERSION_FORK_NOTFOUND",
+			"The version '"+params.From+"' to fork couldn't be found.", "Fork an existing version.")
+
+		return
+	}
+
+	v := s.newVersion(readme.Version{
+		Codename:   params.Codename,
+		ForkedFrom: from.ID,
+		Version:    params.Version,
+	})
+	applyVersionParams(&v.Version, params)
+	s.fork(from, v)
+
+	s.versions = append(s.versions, v)
+	if v.IsStable {
+		s.setStable(v)
+	}
+
+	writeJSON(w, http.StatusOK, v.response())
+}
+
+// updateVersion updates a version, which may change its version number.
+func (s *Server) updateVersion(w http.ResponseWriter, r *http.Request) {
+	v := s.findVersion(r.PathValue("version"))
+	if v == nil {
+		writeNotFound(w, r, "version", r.PathValue("version"))
+
+		return
+	}
+
+	params := readme.VersionParams{}
+	if !decode(w, r, "version", &params) {
+		return
+	}
+
+	if params.Version != "" && params.Version != v.Version.Version {
+		if s.findVersion(params.Version) != nil {
+			writeError(w, r, http.StatusBadRequest, "VERSION_DUPLICATE",
+				"The version '"+params.Version+"' already exists.", "Use another version number.")
+
+			return
+		}
+		v.Version.Version = params.Version
+		v.VersionClean = params.Version
+	}
+	if params.IsStable != nil && !*params.IsStable && v.IsStable {
+		writeError(w, r, http.StatusBadRequest, "VERSION_CANT_DEMOTE_STABLE",
+			"The stable version can't be demoted.", "Make another version stable instead.")
+
+		return
+	}
+
+	if params.Codename != "" {
+		v.Codename = params.Codename
+	}
+	applyVersionParams(&v.Version, params)
+	if v.IsStable {
+		s.setStable(v)
+	}
+
+	writeJSON(w, http.StatusOK, v.response())
+}
+
+// deleteVersion deletes a version and everything in it.
+func (s *Server) deleteVersion(w http.ResponseWriter, r *http.Request) {
+	v := s.findVersion(r.PathValue("version"))
+	if v == nil {
+		writeNotFound(w, r, "version", r.PathValue("version"))
+
+		return
+	}
+
+	if v.IsStable {
+		writeError(w, r, http.StatusBadRequest, "VERSION_CANT_REMOVE_STABLE",
+			"The stable version can't be removed.", "Make another version stable first.")
+
+		return
+	}
+
+	s.versions = remove(s.versions, v)
+
+	writeJSON(w, http.StatusOK, map[string]bool{"removed": true})
+}
+
+// applyVersionParams sets the flags of a version that are set in the parameters.
+func applyVersionParams(v *readme.Version, params readme.VersionParams) {
+	if params.IsBeta != nil {
+		v.IsBeta = *params.IsBeta
+	}
+	if params.IsDeprecated != nil {
+		v.IsDeprecated = *params.IsDeprecated
+	}
+	if params.IsHidden != nil {
+		v.IsHidden = *params.IsHidden
+	}
+	if params.IsStable != nil {
+		v.IsStable = *params.IsStable
+	}
+}
+
+// fork copies the categories, docs and API specifications of a version to a new version with new
+// IDs.
+func (s *Server) fork(from, to *version) {
+	ids := map[string]string{}
+
+	for _, category := range from.categories {
+		forked := *category
+		forked.ID = s.newID()
+		forked.Version = to.ID
+		ids[category.ID] = forked.ID
+		to.categories = append(to.categories, &forked)
+	}
+
+	for _, doc := range from.docs {
+		forked := *doc
+		forked.ID = s.newID()
+		forked.Version = to.ID
+		forked.Category = ids[doc.Category]
+		ids[doc.ID] = forked.ID
+		to.docs = append(to.docs, &forked)
+	}
+	for _, doc := range to.docs {
+		if doc.ParentDoc != "" {
+			doc.ParentDoc = ids[doc.ParentDoc]
+		}
+	}
+
+	for _, spec := range from.specs {
+		forked := *spec
+		forked.ID = s.newID()
+		forked.Version = to.ID
+		forked.Category.ID = ids[spec.Category.ID]
+		to.specs = append(to.specs, &forked)
+	}
+}
+
+// remove returns a slice without an element.
+func remove[T comparable](items []T, item T) []T {
+	for i, existing := range items {
+		if existing == item {
+			return append(items[:i:i], items[i+1:]...)
+		}
+	}
+
+	return items
+}